@@ -20,21 +20,57 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationAuthChangePassword = "/api.auth.v1.Auth/ChangePassword"
+const OperationAuthConfirmTOTP = "/api.auth.v1.Auth/ConfirmTOTP"
+const OperationAuthCreateAPIKey = "/api.auth.v1.Auth/CreateAPIKey"
+const OperationAuthDeleteMyAccount = "/api.auth.v1.Auth/DeleteMyAccount"
+const OperationAuthEnrollTOTP = "/api.auth.v1.Auth/EnrollTOTP"
+const OperationAuthExportMyData = "/api.auth.v1.Auth/ExportMyData"
 const OperationAuthGetProfile = "/api.auth.v1.Auth/GetProfile"
+const OperationAuthListAPIKeys = "/api.auth.v1.Auth/ListAPIKeys"
+const OperationAuthListSessions = "/api.auth.v1.Auth/ListSessions"
 const OperationAuthLogin = "/api.auth.v1.Auth/Login"
 const OperationAuthLogout = "/api.auth.v1.Auth/Logout"
+const OperationAuthOAuthCallback = "/api.auth.v1.Auth/OAuthCallback"
 const OperationAuthRefreshToken = "/api.auth.v1.Auth/RefreshToken"
 const OperationAuthRegister = "/api.auth.v1.Auth/Register"
+const OperationAuthRequestPasswordReset = "/api.auth.v1.Auth/RequestPasswordReset"
+const OperationAuthResendVerification = "/api.auth.v1.Auth/ResendVerification"
+const OperationAuthResetPassword = "/api.auth.v1.Auth/ResetPassword"
+const OperationAuthRevokeAPIKey = "/api.auth.v1.Auth/RevokeAPIKey"
+const OperationAuthRevokeAllOtherSessions = "/api.auth.v1.Auth/RevokeAllOtherSessions"
+const OperationAuthRevokeSession = "/api.auth.v1.Auth/RevokeSession"
+const OperationAuthStartOAuth = "/api.auth.v1.Auth/StartOAuth"
 const OperationAuthUpdateProfile = "/api.auth.v1.Auth/UpdateProfile"
+const OperationAuthVerifyEmail = "/api.auth.v1.Auth/VerifyEmail"
+const OperationAuthVerifyLoginTOTP = "/api.auth.v1.Auth/VerifyLoginTOTP"
 
 type AuthHTTPServer interface {
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPReply, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error)
+	// DeleteMyAccount Delete the current account: deactivated now, purged after the grace period
+	DeleteMyAccount(context.Context, *DeleteMyAccountRequest) (*DeleteMyAccountReply, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
+	// ExportMyData Download a copy of every personal data of the current user
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataReply, error)
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileReply, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysReply, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
 	Login(context.Context, *LoginRequest) (*AuthReply, error)
 	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	OAuthCallback(context.Context, *OAuthCallbackRequest) (*AuthReply, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
 	Register(context.Context, *RegisterRequest) (*AuthReply, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetReply, error)
+	ResendVerification(context.Context, *ResendVerificationRequest) (*ResendVerificationReply, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyReply, error)
+	RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsRequest) (*RevokeAllOtherSessionsReply, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionReply, error)
+	StartOAuth(context.Context, *StartOAuthRequest) (*StartOAuthReply, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileReply, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailReply, error)
+	VerifyLoginTOTP(context.Context, *VerifyLoginTOTPRequest) (*AuthReply, error)
 }
 
 func RegisterAuthHTTPServer(s *http.Server, srv AuthHTTPServer) {
@@ -46,6 +82,23 @@ func RegisterAuthHTTPServer(s *http.Server, srv AuthHTTPServer) {
 	r.PUT("/api/v1/auth/profile", _Auth_UpdateProfile0_HTTP_Handler(srv))
 	r.POST("/api/v1/auth/change-password", _Auth_ChangePassword0_HTTP_Handler(srv))
 	r.POST("/api/v1/auth/logout", _Auth_Logout0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/verify-email", _Auth_VerifyEmail0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/resend-verification", _Auth_ResendVerification0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/forgot-password", _Auth_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/reset-password", _Auth_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/2fa/totp/enroll", _Auth_EnrollTOTP0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/2fa/totp/confirm", _Auth_ConfirmTOTP0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/login/2fa", _Auth_VerifyLoginTOTP0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/sessions", _Auth_ListSessions0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/auth/sessions/{id}", _Auth_RevokeSession0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/sessions/revoke-others", _Auth_RevokeAllOtherSessions0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/oauth/{provider}/start", _Auth_StartOAuth0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/oauth/{provider}/callback", _Auth_OAuthCallback0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/api-keys", _Auth_CreateAPIKey0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/api-keys", _Auth_ListAPIKeys0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/auth/api-keys/{id}", _Auth_RevokeAPIKey0_HTTP_Handler(srv))
+	r.GET("/api/v1/auth/account/export", _Auth_ExportMyData0_HTTP_Handler(srv))
+	r.POST("/api/v1/auth/account/delete", _Auth_DeleteMyAccount0_HTTP_Handler(srv))
 }
 
 func _Auth_Register0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
@@ -199,14 +252,398 @@ func _Auth_Logout0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _Auth_VerifyEmail0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthVerifyEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyEmailReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ResendVerification0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResendVerificationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthResendVerification)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResendVerification(ctx, req.(*ResendVerificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResendVerificationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RequestPasswordReset0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestPasswordResetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ResetPassword0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetPasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_EnrollTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthEnrollTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTOTPReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ConfirmTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthConfirmTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConfirmTOTPReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_VerifyLoginTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyLoginTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthVerifyLoginTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyLoginTOTP(ctx, req.(*VerifyLoginTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ListSessions0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*ListSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RevokeSession0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*RevokeSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeSessionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RevokeAllOtherSessions0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAllOtherSessionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRevokeAllOtherSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAllOtherSessions(ctx, req.(*RevokeAllOtherSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeAllOtherSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_StartOAuth0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StartOAuthRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthStartOAuth)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.StartOAuth(ctx, req.(*StartOAuthRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StartOAuthReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_OAuthCallback0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OAuthCallbackRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthOAuthCallback)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OAuthCallback(ctx, req.(*OAuthCallbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuthReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_CreateAPIKey0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAPIKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthCreateAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ListAPIKeys0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAPIKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthListAPIKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAPIKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RevokeAPIKey0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAPIKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRevokeAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ExportMyData0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportMyDataRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthExportMyData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportMyData(ctx, req.(*ExportMyDataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportMyDataReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_DeleteMyAccount0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteMyAccountRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthDeleteMyAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteMyAccount(ctx, req.(*DeleteMyAccountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteMyAccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthHTTPClient interface {
 	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *ChangePasswordReply, err error)
+	ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest, opts ...http.CallOption) (rsp *ConfirmTOTPReply, err error)
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest, opts ...http.CallOption) (rsp *CreateAPIKeyReply, err error)
+	// DeleteMyAccount Delete the current account: deactivated now, purged after the grace period
+	DeleteMyAccount(ctx context.Context, req *DeleteMyAccountRequest, opts ...http.CallOption) (rsp *DeleteMyAccountReply, err error)
+	EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest, opts ...http.CallOption) (rsp *EnrollTOTPReply, err error)
+	// ExportMyData Download a copy of every personal data of the current user
+	ExportMyData(ctx context.Context, req *ExportMyDataRequest, opts ...http.CallOption) (rsp *ExportMyDataReply, err error)
 	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *GetProfileReply, err error)
+	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest, opts ...http.CallOption) (rsp *ListAPIKeysReply, err error)
+	ListSessions(ctx context.Context, req *ListSessionsRequest, opts ...http.CallOption) (rsp *ListSessionsReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *AuthReply, err error)
 	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	OAuthCallback(ctx context.Context, req *OAuthCallbackRequest, opts ...http.CallOption) (rsp *AuthReply, err error)
 	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *AuthReply, err error)
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *RequestPasswordResetReply, err error)
+	ResendVerification(ctx context.Context, req *ResendVerificationRequest, opts ...http.CallOption) (rsp *ResendVerificationReply, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordReply, err error)
+	RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest, opts ...http.CallOption) (rsp *RevokeAPIKeyReply, err error)
+	RevokeAllOtherSessions(ctx context.Context, req *RevokeAllOtherSessionsRequest, opts ...http.CallOption) (rsp *RevokeAllOtherSessionsReply, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest, opts ...http.CallOption) (rsp *RevokeSessionReply, err error)
+	StartOAuth(ctx context.Context, req *StartOAuthRequest, opts ...http.CallOption) (rsp *StartOAuthReply, err error)
 	UpdateProfile(ctx context.Context, req *UpdateProfileRequest, opts ...http.CallOption) (rsp *UpdateProfileReply, err error)
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest, opts ...http.CallOption) (rsp *VerifyEmailReply, err error)
+	VerifyLoginTOTP(ctx context.Context, req *VerifyLoginTOTPRequest, opts ...http.CallOption) (rsp *AuthReply, err error)
 }
 
 type AuthHTTPClientImpl struct {
@@ -230,6 +667,73 @@ func (c *AuthHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePassw
 	return &out, nil
 }
 
+func (c *AuthHTTPClientImpl) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...http.CallOption) (*ConfirmTOTPReply, error) {
+	var out ConfirmTOTPReply
+	pattern := "/api/v1/auth/2fa/totp/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthConfirmTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...http.CallOption) (*CreateAPIKeyReply, error) {
+	var out CreateAPIKeyReply
+	pattern := "/api/v1/auth/api-keys"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthCreateAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteMyAccount Delete the current account: deactivated now, purged after the grace period
+func (c *AuthHTTPClientImpl) DeleteMyAccount(ctx context.Context, in *DeleteMyAccountRequest, opts ...http.CallOption) (*DeleteMyAccountReply, error) {
+	var out DeleteMyAccountReply
+	pattern := "/api/v1/auth/account/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthDeleteMyAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...http.CallOption) (*EnrollTOTPReply, error) {
+	var out EnrollTOTPReply
+	pattern := "/api/v1/auth/2fa/totp/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthEnrollTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportMyData Download a copy of every personal data of the current user
+func (c *AuthHTTPClientImpl) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...http.CallOption) (*ExportMyDataReply, error) {
+	var out ExportMyDataReply
+	pattern := "/api/v1/auth/account/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthExportMyData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...http.CallOption) (*GetProfileReply, error) {
 	var out GetProfileReply
 	pattern := "/api/v1/auth/profile"
@@ -243,6 +747,32 @@ func (c *AuthHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileReque
 	return &out, nil
 }
 
+func (c *AuthHTTPClientImpl) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...http.CallOption) (*ListAPIKeysReply, error) {
+	var out ListAPIKeysReply
+	pattern := "/api/v1/auth/api-keys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthListAPIKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...http.CallOption) (*ListSessionsReply, error) {
+	var out ListSessionsReply
+	pattern := "/api/v1/auth/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*AuthReply, error) {
 	var out AuthReply
 	pattern := "/api/v1/auth/login"
@@ -269,6 +799,19 @@ func (c *AuthHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts
 	return &out, nil
 }
 
+func (c *AuthHTTPClientImpl) OAuthCallback(ctx context.Context, in *OAuthCallbackRequest, opts ...http.CallOption) (*AuthReply, error) {
+	var out AuthReply
+	pattern := "/api/v1/auth/oauth/{provider}/callback"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthOAuthCallback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenReply, error) {
 	var out RefreshTokenReply
 	pattern := "/api/v1/auth/refresh"
@@ -295,6 +838,97 @@ func (c *AuthHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest,
 	return &out, nil
 }
 
+func (c *AuthHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*RequestPasswordResetReply, error) {
+	var out RequestPasswordResetReply
+	pattern := "/api/v1/auth/forgot-password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...http.CallOption) (*ResendVerificationReply, error) {
+	var out ResendVerificationReply
+	pattern := "/api/v1/auth/resend-verification"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthResendVerification))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordReply, error) {
+	var out ResetPasswordReply
+	pattern := "/api/v1/auth/reset-password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...http.CallOption) (*RevokeAPIKeyReply, error) {
+	var out RevokeAPIKeyReply
+	pattern := "/api/v1/auth/api-keys/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthRevokeAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsRequest, opts ...http.CallOption) (*RevokeAllOtherSessionsReply, error) {
+	var out RevokeAllOtherSessionsReply
+	pattern := "/api/v1/auth/sessions/revoke-others"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRevokeAllOtherSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...http.CallOption) (*RevokeSessionReply, error) {
+	var out RevokeSessionReply
+	pattern := "/api/v1/auth/sessions/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) StartOAuth(ctx context.Context, in *StartOAuthRequest, opts ...http.CallOption) (*StartOAuthReply, error) {
+	var out StartOAuthReply
+	pattern := "/api/v1/auth/oauth/{provider}/start"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthStartOAuth))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthHTTPClientImpl) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...http.CallOption) (*UpdateProfileReply, error) {
 	var out UpdateProfileReply
 	pattern := "/api/v1/auth/profile"
@@ -307,3 +941,29 @@ func (c *AuthHTTPClientImpl) UpdateProfile(ctx context.Context, in *UpdateProfil
 	}
 	return &out, nil
 }
+
+func (c *AuthHTTPClientImpl) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...http.CallOption) (*VerifyEmailReply, error) {
+	var out VerifyEmailReply
+	pattern := "/api/v1/auth/verify-email"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthVerifyEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) VerifyLoginTOTP(ctx context.Context, in *VerifyLoginTOTPRequest, opts ...http.CallOption) (*AuthReply, error) {
+	var out AuthReply
+	pattern := "/api/v1/auth/login/2fa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthVerifyLoginTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
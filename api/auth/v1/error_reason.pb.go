@@ -38,18 +38,47 @@ const (
 	ErrorReason_JWT_TOKEN_NOT_ACTIVE ErrorReason = 13
 	ErrorReason_JWT_TOKEN_MALFORMED  ErrorReason = 14
 	ErrorReason_JWT_CLAIMS_INVALID   ErrorReason = 15
+	ErrorReason_REFRESH_TOKEN_REUSED ErrorReason = 16
+	ErrorReason_SESSION_REVOKED      ErrorReason = 17
+	ErrorReason_SESSION_NOT_FOUND    ErrorReason = 18
 	// User Authentication Errors
-	ErrorReason_INVALID_CREDENTIALS  ErrorReason = 20
-	ErrorReason_USER_ALREADY_EXISTS  ErrorReason = 21
-	ErrorReason_EMAIL_ALREADY_EXISTS ErrorReason = 22
-	ErrorReason_PHONE_ALREADY_EXISTS ErrorReason = 23
-	ErrorReason_WEAK_PASSWORD        ErrorReason = 24
-	ErrorReason_INVALID_EMAIL_FORMAT ErrorReason = 25
-	ErrorReason_INVALID_PHONE_FORMAT ErrorReason = 26
+	ErrorReason_INVALID_CREDENTIALS        ErrorReason = 20
+	ErrorReason_USER_ALREADY_EXISTS        ErrorReason = 21
+	ErrorReason_EMAIL_ALREADY_EXISTS       ErrorReason = 22
+	ErrorReason_PHONE_ALREADY_EXISTS       ErrorReason = 23
+	ErrorReason_WEAK_PASSWORD              ErrorReason = 24
+	ErrorReason_INVALID_EMAIL_FORMAT       ErrorReason = 25
+	ErrorReason_INVALID_PHONE_FORMAT       ErrorReason = 26
+	ErrorReason_EMAIL_NOT_VERIFIED         ErrorReason = 27
+	ErrorReason_EMAIL_ALREADY_VERIFIED     ErrorReason = 28
+	ErrorReason_VERIFICATION_TOKEN_INVALID ErrorReason = 29
 	// Authorization Errors
-	ErrorReason_UNAUTHORIZED      ErrorReason = 30
-	ErrorReason_FORBIDDEN         ErrorReason = 31
-	ErrorReason_PERMISSION_DENIED ErrorReason = 32
+	ErrorReason_UNAUTHORIZED       ErrorReason = 30
+	ErrorReason_FORBIDDEN          ErrorReason = 31
+	ErrorReason_PERMISSION_DENIED  ErrorReason = 32
+	ErrorReason_INVALID_ROLE       ErrorReason = 33
+	ErrorReason_CANNOT_MODIFY_SELF ErrorReason = 34
+	ErrorReason_CANNOT_IMPERSONATE ErrorReason = 35
+	// Account Recovery Errors
+	ErrorReason_PASSWORD_RESET_TOKEN_INVALID ErrorReason = 40
+	// Two-Factor Authentication Errors
+	ErrorReason_TOTP_ALREADY_ENABLED    ErrorReason = 50
+	ErrorReason_TOTP_NOT_ENROLLED       ErrorReason = 51
+	ErrorReason_INVALID_TOTP_CODE       ErrorReason = 52
+	ErrorReason_LOGIN_CHALLENGE_INVALID ErrorReason = 53
+	// Brute-force Protection Errors
+	ErrorReason_TOO_MANY_LOGIN_ATTEMPTS          ErrorReason = 60
+	ErrorReason_TOO_MANY_PASSWORD_RESET_REQUESTS ErrorReason = 61
+	// Social Login Errors
+	ErrorReason_OAUTH_PROVIDER_NOT_FOUND ErrorReason = 70
+	ErrorReason_OAUTH_STATE_INVALID      ErrorReason = 71
+	ErrorReason_OAUTH_EXCHANGE_FAILED    ErrorReason = 72
+	ErrorReason_OAUTH_EMAIL_NOT_VERIFIED ErrorReason = 73
+	ErrorReason_OAUTH_ACCOUNT_CONFLICT   ErrorReason = 74
+	// API Key Errors
+	ErrorReason_API_KEY_NOT_FOUND     ErrorReason = 80
+	ErrorReason_INVALID_API_KEY_SCOPE ErrorReason = 81
+	ErrorReason_TOO_MANY_API_KEYS     ErrorReason = 82
 )
 
 // Enum value maps for ErrorReason.
@@ -67,6 +96,9 @@ var (
 		13: "JWT_TOKEN_NOT_ACTIVE",
 		14: "JWT_TOKEN_MALFORMED",
 		15: "JWT_CLAIMS_INVALID",
+		16: "REFRESH_TOKEN_REUSED",
+		17: "SESSION_REVOKED",
+		18: "SESSION_NOT_FOUND",
 		20: "INVALID_CREDENTIALS",
 		21: "USER_ALREADY_EXISTS",
 		22: "EMAIL_ALREADY_EXISTS",
@@ -74,33 +106,78 @@ var (
 		24: "WEAK_PASSWORD",
 		25: "INVALID_EMAIL_FORMAT",
 		26: "INVALID_PHONE_FORMAT",
+		27: "EMAIL_NOT_VERIFIED",
+		28: "EMAIL_ALREADY_VERIFIED",
+		29: "VERIFICATION_TOKEN_INVALID",
 		30: "UNAUTHORIZED",
 		31: "FORBIDDEN",
 		32: "PERMISSION_DENIED",
+		33: "INVALID_ROLE",
+		34: "CANNOT_MODIFY_SELF",
+		35: "CANNOT_IMPERSONATE",
+		40: "PASSWORD_RESET_TOKEN_INVALID",
+		50: "TOTP_ALREADY_ENABLED",
+		51: "TOTP_NOT_ENROLLED",
+		52: "INVALID_TOTP_CODE",
+		53: "LOGIN_CHALLENGE_INVALID",
+		60: "TOO_MANY_LOGIN_ATTEMPTS",
+		61: "TOO_MANY_PASSWORD_RESET_REQUESTS",
+		70: "OAUTH_PROVIDER_NOT_FOUND",
+		71: "OAUTH_STATE_INVALID",
+		72: "OAUTH_EXCHANGE_FAILED",
+		73: "OAUTH_EMAIL_NOT_VERIFIED",
+		74: "OAUTH_ACCOUNT_CONFLICT",
+		80: "API_KEY_NOT_FOUND",
+		81: "INVALID_API_KEY_SCOPE",
+		82: "TOO_MANY_API_KEYS",
 	}
 	ErrorReason_value = map[string]int32{
-		"ERROR_REASON_UNSPECIFIED": 0,
-		"SYSTEM_ERROR":             1,
-		"AUTH_ERROR":               2,
-		"DATA_REQUEST_INVALID":     3,
-		"USER_NOT_FOUND":           4,
-		"NOTIFICATION_NOT_FOUND":   5,
-		"JWT_TOKEN_MISSING":        10,
-		"JWT_TOKEN_INVALID":        11,
-		"JWT_TOKEN_EXPIRED":        12,
-		"JWT_TOKEN_NOT_ACTIVE":     13,
-		"JWT_TOKEN_MALFORMED":      14,
-		"JWT_CLAIMS_INVALID":       15,
-		"INVALID_CREDENTIALS":      20,
-		"USER_ALREADY_EXISTS":      21,
-		"EMAIL_ALREADY_EXISTS":     22,
-		"PHONE_ALREADY_EXISTS":     23,
-		"WEAK_PASSWORD":            24,
-		"INVALID_EMAIL_FORMAT":     25,
-		"INVALID_PHONE_FORMAT":     26,
-		"UNAUTHORIZED":             30,
-		"FORBIDDEN":                31,
-		"PERMISSION_DENIED":        32,
+		"ERROR_REASON_UNSPECIFIED":         0,
+		"SYSTEM_ERROR":                     1,
+		"AUTH_ERROR":                       2,
+		"DATA_REQUEST_INVALID":             3,
+		"USER_NOT_FOUND":                   4,
+		"NOTIFICATION_NOT_FOUND":           5,
+		"JWT_TOKEN_MISSING":                10,
+		"JWT_TOKEN_INVALID":                11,
+		"JWT_TOKEN_EXPIRED":                12,
+		"JWT_TOKEN_NOT_ACTIVE":             13,
+		"JWT_TOKEN_MALFORMED":              14,
+		"JWT_CLAIMS_INVALID":               15,
+		"REFRESH_TOKEN_REUSED":             16,
+		"SESSION_REVOKED":                  17,
+		"SESSION_NOT_FOUND":                18,
+		"INVALID_CREDENTIALS":              20,
+		"USER_ALREADY_EXISTS":              21,
+		"EMAIL_ALREADY_EXISTS":             22,
+		"PHONE_ALREADY_EXISTS":             23,
+		"WEAK_PASSWORD":                    24,
+		"INVALID_EMAIL_FORMAT":             25,
+		"INVALID_PHONE_FORMAT":             26,
+		"EMAIL_NOT_VERIFIED":               27,
+		"EMAIL_ALREADY_VERIFIED":           28,
+		"VERIFICATION_TOKEN_INVALID":       29,
+		"UNAUTHORIZED":                     30,
+		"FORBIDDEN":                        31,
+		"PERMISSION_DENIED":                32,
+		"INVALID_ROLE":                     33,
+		"CANNOT_MODIFY_SELF":               34,
+		"CANNOT_IMPERSONATE":               35,
+		"PASSWORD_RESET_TOKEN_INVALID":     40,
+		"TOTP_ALREADY_ENABLED":             50,
+		"TOTP_NOT_ENROLLED":                51,
+		"INVALID_TOTP_CODE":                52,
+		"LOGIN_CHALLENGE_INVALID":          53,
+		"TOO_MANY_LOGIN_ATTEMPTS":          60,
+		"TOO_MANY_PASSWORD_RESET_REQUESTS": 61,
+		"OAUTH_PROVIDER_NOT_FOUND":         70,
+		"OAUTH_STATE_INVALID":              71,
+		"OAUTH_EXCHANGE_FAILED":            72,
+		"OAUTH_EMAIL_NOT_VERIFIED":         73,
+		"OAUTH_ACCOUNT_CONFLICT":           74,
+		"API_KEY_NOT_FOUND":                80,
+		"INVALID_API_KEY_SCOPE":            81,
+		"TOO_MANY_API_KEYS":                82,
 	}
 )
 
@@ -135,7 +212,7 @@ var File_auth_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_auth_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x1aauth/v1/error_reason.proto\x12\vapi.auth.v1\x1a\x13errors/errors.proto*\x90\x05\n" +
+	"\x1aauth/v1/error_reason.proto\x12\vapi.auth.v1\x1a\x13errors/errors.proto*\x9b\v\n" +
 	"\vErrorReason\x12\"\n" +
 	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x1a\x04\xa8E\xf4\x03\x12\x16\n" +
 	"\fSYSTEM_ERROR\x10\x01\x1a\x04\xa8E\xf4\x03\x12\x14\n" +
@@ -150,17 +227,41 @@ const file_auth_v1_error_reason_proto_rawDesc = "" +
 	"\x11JWT_TOKEN_EXPIRED\x10\f\x1a\x04\xa8E\x91\x03\x12\x1e\n" +
 	"\x14JWT_TOKEN_NOT_ACTIVE\x10\r\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
 	"\x13JWT_TOKEN_MALFORMED\x10\x0e\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
-	"\x12JWT_CLAIMS_INVALID\x10\x0f\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
+	"\x12JWT_CLAIMS_INVALID\x10\x0f\x1a\x04\xa8E\x91\x03\x12\x1e\n" +
+	"\x14REFRESH_TOKEN_REUSED\x10\x10\x1a\x04\xa8E\x91\x03\x12\x19\n" +
+	"\x0fSESSION_REVOKED\x10\x11\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\x11SESSION_NOT_FOUND\x10\x12\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
 	"\x13INVALID_CREDENTIALS\x10\x14\x1a\x04\xa8E\x91\x03\x12\x1d\n" +
 	"\x13USER_ALREADY_EXISTS\x10\x15\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
 	"\x14EMAIL_ALREADY_EXISTS\x10\x16\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
 	"\x14PHONE_ALREADY_EXISTS\x10\x17\x1a\x04\xa8E\x99\x03\x12\x17\n" +
 	"\rWEAK_PASSWORD\x10\x18\x1a\x04\xa8E\x90\x03\x12\x1e\n" +
 	"\x14INVALID_EMAIL_FORMAT\x10\x19\x1a\x04\xa8E\x90\x03\x12\x1e\n" +
-	"\x14INVALID_PHONE_FORMAT\x10\x1a\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\x14INVALID_PHONE_FORMAT\x10\x1a\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12EMAIL_NOT_VERIFIED\x10\x1b\x1a\x04\xa8E\x93\x03\x12 \n" +
+	"\x16EMAIL_ALREADY_VERIFIED\x10\x1c\x1a\x04\xa8E\x99\x03\x12$\n" +
+	"\x1aVERIFICATION_TOKEN_INVALID\x10\x1d\x1a\x04\xa8E\x90\x03\x12\x16\n" +
 	"\fUNAUTHORIZED\x10\x1e\x1a\x04\xa8E\x91\x03\x12\x13\n" +
 	"\tFORBIDDEN\x10\x1f\x1a\x04\xa8E\x93\x03\x12\x1b\n" +
-	"\x11PERMISSION_DENIED\x10 \x1a\x04\xa8E\x93\x03B(\n" +
+	"\x11PERMISSION_DENIED\x10 \x1a\x04\xa8E\x93\x03\x12\x16\n" +
+	"\fINVALID_ROLE\x10!\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12CANNOT_MODIFY_SELF\x10\"\x1a\x04\xa8E\x93\x03\x12\x1c\n" +
+	"\x12CANNOT_IMPERSONATE\x10#\x1a\x04\xa8E\x93\x03\x12&\n" +
+	"\x1cPASSWORD_RESET_TOKEN_INVALID\x10(\x1a\x04\xa8E\x90\x03\x12\x1e\n" +
+	"\x14TOTP_ALREADY_ENABLED\x102\x1a\x04\xa8E\x99\x03\x12\x1b\n" +
+	"\x11TOTP_NOT_ENROLLED\x103\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11INVALID_TOTP_CODE\x104\x1a\x04\xa8E\x91\x03\x12!\n" +
+	"\x17LOGIN_CHALLENGE_INVALID\x105\x1a\x04\xa8E\x91\x03\x12!\n" +
+	"\x17TOO_MANY_LOGIN_ATTEMPTS\x10<\x1a\x04\xa8E\xad\x03\x12*\n" +
+	" TOO_MANY_PASSWORD_RESET_REQUESTS\x10=\x1a\x04\xa8E\xad\x03\x12\"\n" +
+	"\x18OAUTH_PROVIDER_NOT_FOUND\x10F\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
+	"\x13OAUTH_STATE_INVALID\x10G\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15OAUTH_EXCHANGE_FAILED\x10H\x1a\x04\xa8E\x91\x03\x12\"\n" +
+	"\x18OAUTH_EMAIL_NOT_VERIFIED\x10I\x1a\x04\xa8E\x93\x03\x12 \n" +
+	"\x16OAUTH_ACCOUNT_CONFLICT\x10J\x1a\x04\xa8E\x99\x03\x12\x1b\n" +
+	"\x11API_KEY_NOT_FOUND\x10P\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
+	"\x15INVALID_API_KEY_SCOPE\x10Q\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11TOO_MANY_API_KEYS\x10R\x1a\x04\xa8E\x99\x03B(\n" +
 	"\vapi.auth.v1P\x01Z\x17JobblyBE/api/auth/v1;v1b\x06proto3"
 
 var (
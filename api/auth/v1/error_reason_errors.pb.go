@@ -157,6 +157,42 @@ func ErrorJwtClaimsInvalid(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, ErrorReason_JWT_CLAIMS_INVALID.String(), fmt.Sprintf(format, args...))
 }
 
+func IsRefreshTokenReused(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_REFRESH_TOKEN_REUSED.String() && e.Code == 401
+}
+
+func ErrorRefreshTokenReused(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_REFRESH_TOKEN_REUSED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSessionRevoked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SESSION_REVOKED.String() && e.Code == 401
+}
+
+func ErrorSessionRevoked(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_SESSION_REVOKED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsSessionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SESSION_NOT_FOUND.String() && e.Code == 404
+}
+
+func ErrorSessionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_SESSION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
 // User Authentication Errors
 func IsInvalidCredentials(err error) bool {
 	if err == nil {
@@ -243,6 +279,42 @@ func ErrorInvalidPhoneFormat(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, ErrorReason_INVALID_PHONE_FORMAT.String(), fmt.Sprintf(format, args...))
 }
 
+func IsEmailNotVerified(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EMAIL_NOT_VERIFIED.String() && e.Code == 403
+}
+
+func ErrorEmailNotVerified(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_EMAIL_NOT_VERIFIED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsEmailAlreadyVerified(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EMAIL_ALREADY_VERIFIED.String() && e.Code == 409
+}
+
+func ErrorEmailAlreadyVerified(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_EMAIL_ALREADY_VERIFIED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsVerificationTokenInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_VERIFICATION_TOKEN_INVALID.String() && e.Code == 400
+}
+
+func ErrorVerificationTokenInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_VERIFICATION_TOKEN_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
 // Authorization Errors
 func IsUnauthorized(err error) bool {
 	if err == nil {
@@ -280,3 +352,229 @@ func IsPermissionDenied(err error) bool {
 func ErrorPermissionDenied(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, ErrorReason_PERMISSION_DENIED.String(), fmt.Sprintf(format, args...))
 }
+
+func IsInvalidRole(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_ROLE.String() && e.Code == 400
+}
+
+func ErrorInvalidRole(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_ROLE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCannotModifySelf(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CANNOT_MODIFY_SELF.String() && e.Code == 403
+}
+
+func ErrorCannotModifySelf(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_CANNOT_MODIFY_SELF.String(), fmt.Sprintf(format, args...))
+}
+
+func IsCannotImpersonate(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CANNOT_IMPERSONATE.String() && e.Code == 403
+}
+
+func ErrorCannotImpersonate(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_CANNOT_IMPERSONATE.String(), fmt.Sprintf(format, args...))
+}
+
+// Account Recovery Errors
+func IsPasswordResetTokenInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_PASSWORD_RESET_TOKEN_INVALID.String() && e.Code == 400
+}
+
+// Account Recovery Errors
+func ErrorPasswordResetTokenInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_PASSWORD_RESET_TOKEN_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// Two-Factor Authentication Errors
+func IsTotpAlreadyEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOTP_ALREADY_ENABLED.String() && e.Code == 409
+}
+
+// Two-Factor Authentication Errors
+func ErrorTotpAlreadyEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_TOTP_ALREADY_ENABLED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTotpNotEnrolled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOTP_NOT_ENROLLED.String() && e.Code == 400
+}
+
+func ErrorTotpNotEnrolled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_TOTP_NOT_ENROLLED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidTotpCode(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_TOTP_CODE.String() && e.Code == 401
+}
+
+func ErrorInvalidTotpCode(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_INVALID_TOTP_CODE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsLoginChallengeInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_LOGIN_CHALLENGE_INVALID.String() && e.Code == 401
+}
+
+func ErrorLoginChallengeInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_LOGIN_CHALLENGE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+// Brute-force Protection Errors
+func IsTooManyLoginAttempts(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOO_MANY_LOGIN_ATTEMPTS.String() && e.Code == 429
+}
+
+// Brute-force Protection Errors
+func ErrorTooManyLoginAttempts(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_TOO_MANY_LOGIN_ATTEMPTS.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTooManyPasswordResetRequests(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOO_MANY_PASSWORD_RESET_REQUESTS.String() && e.Code == 429
+}
+
+func ErrorTooManyPasswordResetRequests(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_TOO_MANY_PASSWORD_RESET_REQUESTS.String(), fmt.Sprintf(format, args...))
+}
+
+// Social Login Errors
+func IsOauthProviderNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OAUTH_PROVIDER_NOT_FOUND.String() && e.Code == 404
+}
+
+// Social Login Errors
+func ErrorOauthProviderNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_OAUTH_PROVIDER_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsOauthStateInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OAUTH_STATE_INVALID.String() && e.Code == 400
+}
+
+func ErrorOauthStateInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_OAUTH_STATE_INVALID.String(), fmt.Sprintf(format, args...))
+}
+
+func IsOauthExchangeFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OAUTH_EXCHANGE_FAILED.String() && e.Code == 401
+}
+
+func ErrorOauthExchangeFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_OAUTH_EXCHANGE_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsOauthEmailNotVerified(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OAUTH_EMAIL_NOT_VERIFIED.String() && e.Code == 403
+}
+
+func ErrorOauthEmailNotVerified(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_OAUTH_EMAIL_NOT_VERIFIED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsOauthAccountConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OAUTH_ACCOUNT_CONFLICT.String() && e.Code == 409
+}
+
+func ErrorOauthAccountConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_OAUTH_ACCOUNT_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
+// API Key Errors
+func IsApiKeyNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_API_KEY_NOT_FOUND.String() && e.Code == 404
+}
+
+// API Key Errors
+func ErrorApiKeyNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_API_KEY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+func IsInvalidApiKeyScope(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_API_KEY_SCOPE.String() && e.Code == 400
+}
+
+func ErrorInvalidApiKeyScope(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_API_KEY_SCOPE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsTooManyApiKeys(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_TOO_MANY_API_KEYS.String() && e.Code == 409
+}
+
+func ErrorTooManyApiKeys(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_TOO_MANY_API_KEYS.String(), fmt.Sprintf(format, args...))
+}
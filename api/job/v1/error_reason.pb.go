@@ -57,14 +57,31 @@ const (
 	ErrorReason_JOB_EXPIRED             ErrorReason = 43
 	ErrorReason_UNAUTHORIZED_JOB_ACTION ErrorReason = 44
 	// Company Errors
-	ErrorReason_COMPANY_NOT_FOUND      ErrorReason = 50
-	ErrorReason_COMPANY_ALREADY_EXISTS ErrorReason = 51
-	ErrorReason_INVALID_COMPANY_DATA   ErrorReason = 52
+	ErrorReason_COMPANY_NOT_FOUND             ErrorReason = 50
+	ErrorReason_COMPANY_ALREADY_EXISTS        ErrorReason = 51
+	ErrorReason_INVALID_COMPANY_DATA          ErrorReason = 52
+	ErrorReason_COMPANY_MEMBER_NOT_FOUND      ErrorReason = 53
+	ErrorReason_COMPANY_MEMBER_ALREADY_EXISTS ErrorReason = 54
+	ErrorReason_LAST_COMPANY_OWNER            ErrorReason = 55
 	// Validation Errors
 	ErrorReason_INVALID_EMPLOYMENT_TYPE  ErrorReason = 60
 	ErrorReason_INVALID_EXPERIENCE_LEVEL ErrorReason = 61
 	ErrorReason_INVALID_STATUS           ErrorReason = 62
 	ErrorReason_INVALID_PAGINATION       ErrorReason = 63
+	// Application Errors
+	ErrorReason_APPLICATION_NOT_FOUND          ErrorReason = 70
+	ErrorReason_APPLICATION_ALREADY_EXISTS     ErrorReason = 71
+	ErrorReason_INVALID_APPLICATION_DATA       ErrorReason = 72
+	ErrorReason_JOB_NOT_ACCEPTING_APPLICATIONS ErrorReason = 73
+	// Hiring Pipeline Errors
+	ErrorReason_INVALID_PIPELINE         ErrorReason = 74
+	ErrorReason_PIPELINE_STAGE_IN_USE    ErrorReason = 75
+	ErrorReason_INVALID_STAGE_TRANSITION ErrorReason = 76
+	// Job Alert Errors
+	ErrorReason_SAVED_SEARCH_NOT_FOUND    ErrorReason = 80
+	ErrorReason_INVALID_SAVED_SEARCH      ErrorReason = 81
+	ErrorReason_TOO_MANY_SAVED_SEARCHES   ErrorReason = 82
+	ErrorReason_INVALID_UNSUBSCRIBE_TOKEN ErrorReason = 83
 )
 
 // Enum value maps for ErrorReason.
@@ -100,46 +117,74 @@ var (
 		50: "COMPANY_NOT_FOUND",
 		51: "COMPANY_ALREADY_EXISTS",
 		52: "INVALID_COMPANY_DATA",
+		53: "COMPANY_MEMBER_NOT_FOUND",
+		54: "COMPANY_MEMBER_ALREADY_EXISTS",
+		55: "LAST_COMPANY_OWNER",
 		60: "INVALID_EMPLOYMENT_TYPE",
 		61: "INVALID_EXPERIENCE_LEVEL",
 		62: "INVALID_STATUS",
 		63: "INVALID_PAGINATION",
+		70: "APPLICATION_NOT_FOUND",
+		71: "APPLICATION_ALREADY_EXISTS",
+		72: "INVALID_APPLICATION_DATA",
+		73: "JOB_NOT_ACCEPTING_APPLICATIONS",
+		74: "INVALID_PIPELINE",
+		75: "PIPELINE_STAGE_IN_USE",
+		76: "INVALID_STAGE_TRANSITION",
+		80: "SAVED_SEARCH_NOT_FOUND",
+		81: "INVALID_SAVED_SEARCH",
+		82: "TOO_MANY_SAVED_SEARCHES",
+		83: "INVALID_UNSUBSCRIBE_TOKEN",
 	}
 	ErrorReason_value = map[string]int32{
-		"ERROR_REASON_UNSPECIFIED": 0,
-		"SYSTEM_ERROR":             1,
-		"AUTH_ERROR":               2,
-		"DATA_REQUEST_INVALID":     3,
-		"USER_NOT_FOUND":           4,
-		"NOTIFICATION_NOT_FOUND":   5,
-		"JWT_TOKEN_MISSING":        10,
-		"JWT_TOKEN_INVALID":        11,
-		"JWT_TOKEN_EXPIRED":        12,
-		"JWT_TOKEN_NOT_ACTIVE":     13,
-		"JWT_TOKEN_MALFORMED":      14,
-		"JWT_CLAIMS_INVALID":       15,
-		"INVALID_CREDENTIALS":      20,
-		"USER_ALREADY_EXISTS":      21,
-		"EMAIL_ALREADY_EXISTS":     22,
-		"PHONE_ALREADY_EXISTS":     23,
-		"WEAK_PASSWORD":            24,
-		"INVALID_EMAIL_FORMAT":     25,
-		"INVALID_PHONE_FORMAT":     26,
-		"UNAUTHORIZED":             30,
-		"FORBIDDEN":                31,
-		"PERMISSION_DENIED":        32,
-		"JOB_NOT_FOUND":            40,
-		"JOB_ALREADY_EXISTS":       41,
-		"INVALID_JOB_DATA":         42,
-		"JOB_EXPIRED":              43,
-		"UNAUTHORIZED_JOB_ACTION":  44,
-		"COMPANY_NOT_FOUND":        50,
-		"COMPANY_ALREADY_EXISTS":   51,
-		"INVALID_COMPANY_DATA":     52,
-		"INVALID_EMPLOYMENT_TYPE":  60,
-		"INVALID_EXPERIENCE_LEVEL": 61,
-		"INVALID_STATUS":           62,
-		"INVALID_PAGINATION":       63,
+		"ERROR_REASON_UNSPECIFIED":       0,
+		"SYSTEM_ERROR":                   1,
+		"AUTH_ERROR":                     2,
+		"DATA_REQUEST_INVALID":           3,
+		"USER_NOT_FOUND":                 4,
+		"NOTIFICATION_NOT_FOUND":         5,
+		"JWT_TOKEN_MISSING":              10,
+		"JWT_TOKEN_INVALID":              11,
+		"JWT_TOKEN_EXPIRED":              12,
+		"JWT_TOKEN_NOT_ACTIVE":           13,
+		"JWT_TOKEN_MALFORMED":            14,
+		"JWT_CLAIMS_INVALID":             15,
+		"INVALID_CREDENTIALS":            20,
+		"USER_ALREADY_EXISTS":            21,
+		"EMAIL_ALREADY_EXISTS":           22,
+		"PHONE_ALREADY_EXISTS":           23,
+		"WEAK_PASSWORD":                  24,
+		"INVALID_EMAIL_FORMAT":           25,
+		"INVALID_PHONE_FORMAT":           26,
+		"UNAUTHORIZED":                   30,
+		"FORBIDDEN":                      31,
+		"PERMISSION_DENIED":              32,
+		"JOB_NOT_FOUND":                  40,
+		"JOB_ALREADY_EXISTS":             41,
+		"INVALID_JOB_DATA":               42,
+		"JOB_EXPIRED":                    43,
+		"UNAUTHORIZED_JOB_ACTION":        44,
+		"COMPANY_NOT_FOUND":              50,
+		"COMPANY_ALREADY_EXISTS":         51,
+		"INVALID_COMPANY_DATA":           52,
+		"COMPANY_MEMBER_NOT_FOUND":       53,
+		"COMPANY_MEMBER_ALREADY_EXISTS":  54,
+		"LAST_COMPANY_OWNER":             55,
+		"INVALID_EMPLOYMENT_TYPE":        60,
+		"INVALID_EXPERIENCE_LEVEL":       61,
+		"INVALID_STATUS":                 62,
+		"INVALID_PAGINATION":             63,
+		"APPLICATION_NOT_FOUND":          70,
+		"APPLICATION_ALREADY_EXISTS":     71,
+		"INVALID_APPLICATION_DATA":       72,
+		"JOB_NOT_ACCEPTING_APPLICATIONS": 73,
+		"INVALID_PIPELINE":               74,
+		"PIPELINE_STAGE_IN_USE":          75,
+		"INVALID_STAGE_TRANSITION":       76,
+		"SAVED_SEARCH_NOT_FOUND":         80,
+		"INVALID_SAVED_SEARCH":           81,
+		"TOO_MANY_SAVED_SEARCHES":        82,
+		"INVALID_UNSUBSCRIBE_TOKEN":      83,
 	}
 )
 
@@ -175,7 +220,7 @@ var File_job_v1_error_reason_proto protoreflect.FileDescriptor
 const file_job_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
 	"\x19job/v1/error_reason.proto\x12\n" +
-	"api.job.v1\x1a\x13errors/errors.proto*\xfb\a\n" +
+	"api.job.v1\x1a\x13errors/errors.proto*\xe6\v\n" +
 	"\vErrorReason\x12\"\n" +
 	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x1a\x04\xa8E\xf4\x03\x12\x16\n" +
 	"\fSYSTEM_ERROR\x10\x01\x1a\x04\xa8E\xf4\x03\x12\x14\n" +
@@ -208,11 +253,25 @@ const file_job_v1_error_reason_proto_rawDesc = "" +
 	"\x17UNAUTHORIZED_JOB_ACTION\x10,\x1a\x04\xa8E\x93\x03\x12\x1b\n" +
 	"\x11COMPANY_NOT_FOUND\x102\x1a\x04\xa8E\x94\x03\x12 \n" +
 	"\x16COMPANY_ALREADY_EXISTS\x103\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
-	"\x14INVALID_COMPANY_DATA\x104\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x14INVALID_COMPANY_DATA\x104\x1a\x04\xa8E\x90\x03\x12\"\n" +
+	"\x18COMPANY_MEMBER_NOT_FOUND\x105\x1a\x04\xa8E\x94\x03\x12'\n" +
+	"\x1dCOMPANY_MEMBER_ALREADY_EXISTS\x106\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12LAST_COMPANY_OWNER\x107\x1a\x04\xa8E\x90\x03\x12!\n" +
 	"\x17INVALID_EMPLOYMENT_TYPE\x10<\x1a\x04\xa8E\x90\x03\x12\"\n" +
 	"\x18INVALID_EXPERIENCE_LEVEL\x10=\x1a\x04\xa8E\x90\x03\x12\x18\n" +
 	"\x0eINVALID_STATUS\x10>\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
-	"\x12INVALID_PAGINATION\x10?\x1a\x04\xa8E\x90\x03B&\n" +
+	"\x12INVALID_PAGINATION\x10?\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15APPLICATION_NOT_FOUND\x10F\x1a\x04\xa8E\x94\x03\x12$\n" +
+	"\x1aAPPLICATION_ALREADY_EXISTS\x10G\x1a\x04\xa8E\x99\x03\x12\"\n" +
+	"\x18INVALID_APPLICATION_DATA\x10H\x1a\x04\xa8E\x90\x03\x12(\n" +
+	"\x1eJOB_NOT_ACCEPTING_APPLICATIONS\x10I\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10INVALID_PIPELINE\x10J\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15PIPELINE_STAGE_IN_USE\x10K\x1a\x04\xa8E\x99\x03\x12\"\n" +
+	"\x18INVALID_STAGE_TRANSITION\x10L\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x16SAVED_SEARCH_NOT_FOUND\x10P\x1a\x04\xa8E\x94\x03\x12\x1e\n" +
+	"\x14INVALID_SAVED_SEARCH\x10Q\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x17TOO_MANY_SAVED_SEARCHES\x10R\x1a\x04\xa8E\x90\x03\x12#\n" +
+	"\x19INVALID_UNSUBSCRIBE_TOKEN\x10S\x1a\x04\xa8E\x90\x03B&\n" +
 	"\n" +
 	"api.job.v1P\x01Z\x16JobblyBE/api/job/v1;v1b\x06proto3"
 
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "JobblyBE/api/policy/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -150,6 +151,10 @@ type JobPostingReply struct {
 	Benefits              string                 `protobuf:"bytes,16,opt,name=benefits,proto3" json:"benefits,omitempty"`
 	JobTech               []string               `protobuf:"bytes,17,rep,name=job_tech,json=jobTech,proto3" json:"job_tech,omitempty"` // Technologies/skills required
 	CreatedAt             string                 `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status                string                 `protobuf:"bytes,19,opt,name=status,proto3" json:"status,omitempty"`                        // DRAFT, PUBLISHED, PAUSED, CLOSED, EXPIRED
+	ExpiresAt             string                 `protobuf:"bytes,20,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Empty = never expires
+	IsSaved               bool                   `protobuf:"varint,21,opt,name=is_saved,json=isSaved,proto3" json:"is_saved,omitempty"`      // The current user saved this job, always false for anonymous requests
+	Highlights            []*JobHighlight        `protobuf:"bytes,22,rep,name=highlights,proto3" json:"highlights,omitempty"`                // Only when listing with a keyword
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -310,6 +315,87 @@ func (x *JobPostingReply) GetCreatedAt() string {
 	return ""
 }
 
+func (x *JobPostingReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobPostingReply) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *JobPostingReply) GetIsSaved() bool {
+	if x != nil {
+		return x.IsSaved
+	}
+	return false
+}
+
+func (x *JobPostingReply) GetHighlights() []*JobHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+// JobHighlight is a snippet of a field with the matched keyword terms wrapped in <em></em>, HTML escaped
+type JobHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // title, job_tech, requirements, description
+	Snippet       string                 `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobHighlight) Reset() {
+	*x = JobHighlight{}
+	mi := &file_job_v1_job_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobHighlight) ProtoMessage() {}
+
+func (x *JobHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobHighlight.ProtoReflect.Descriptor instead.
+func (*JobHighlight) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JobHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *JobHighlight) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
 type CreateJobPostingRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	CompanyId             string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
@@ -327,13 +413,15 @@ type CreateJobPostingRequest struct {
 	Requirements          string                 `protobuf:"bytes,13,opt,name=requirements,proto3" json:"requirements,omitempty"`
 	Benefits              string                 `protobuf:"bytes,14,opt,name=benefits,proto3" json:"benefits,omitempty"`
 	JobTech               []string               `protobuf:"bytes,15,rep,name=job_tech,json=jobTech,proto3" json:"job_tech,omitempty"`
+	Status                string                 `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`                        // DRAFT or PUBLISHED (default PUBLISHED)
+	ExpiresAt             string                 `protobuf:"bytes,17,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional, RFC 3339
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *CreateJobPostingRequest) Reset() {
 	*x = CreateJobPostingRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[2]
+	mi := &file_job_v1_job_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -345,7 +433,7 @@ func (x *CreateJobPostingRequest) String() string {
 func (*CreateJobPostingRequest) ProtoMessage() {}
 
 func (x *CreateJobPostingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[2]
+	mi := &file_job_v1_job_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +446,7 @@ func (x *CreateJobPostingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateJobPostingRequest.ProtoReflect.Descriptor instead.
 func (*CreateJobPostingRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{2}
+	return file_job_v1_job_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateJobPostingRequest) GetCompanyId() string {
@@ -466,6 +554,20 @@ func (x *CreateJobPostingRequest) GetJobTech() []string {
 	return nil
 }
 
+func (x *CreateJobPostingRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateJobPostingRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
 type UpdateJobPostingRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -483,13 +585,14 @@ type UpdateJobPostingRequest struct {
 	Requirements          string                 `protobuf:"bytes,13,opt,name=requirements,proto3" json:"requirements,omitempty"`
 	Benefits              string                 `protobuf:"bytes,14,opt,name=benefits,proto3" json:"benefits,omitempty"`
 	JobTech               []string               `protobuf:"bytes,15,rep,name=job_tech,json=jobTech,proto3" json:"job_tech,omitempty"`
+	ExpiresAt             string                 `protobuf:"bytes,16,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional, RFC 3339, empty = never expires
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *UpdateJobPostingRequest) Reset() {
 	*x = UpdateJobPostingRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[3]
+	mi := &file_job_v1_job_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +604,7 @@ func (x *UpdateJobPostingRequest) String() string {
 func (*UpdateJobPostingRequest) ProtoMessage() {}
 
 func (x *UpdateJobPostingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[3]
+	mi := &file_job_v1_job_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +617,7 @@ func (x *UpdateJobPostingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateJobPostingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateJobPostingRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{3}
+	return file_job_v1_job_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateJobPostingRequest) GetId() string {
@@ -622,6 +725,13 @@ func (x *UpdateJobPostingRequest) GetJobTech() []string {
 	return nil
 }
 
+func (x *UpdateJobPostingRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
 type DeleteJobPostingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -631,7 +741,7 @@ type DeleteJobPostingRequest struct {
 
 func (x *DeleteJobPostingRequest) Reset() {
 	*x = DeleteJobPostingRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[4]
+	mi := &file_job_v1_job_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -643,7 +753,7 @@ func (x *DeleteJobPostingRequest) String() string {
 func (*DeleteJobPostingRequest) ProtoMessage() {}
 
 func (x *DeleteJobPostingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[4]
+	mi := &file_job_v1_job_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +766,7 @@ func (x *DeleteJobPostingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobPostingRequest.ProtoReflect.Descriptor instead.
 func (*DeleteJobPostingRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{4}
+	return file_job_v1_job_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteJobPostingRequest) GetId() string {
@@ -675,7 +785,7 @@ type DeleteJobPostingReply struct {
 
 func (x *DeleteJobPostingReply) Reset() {
 	*x = DeleteJobPostingReply{}
-	mi := &file_job_v1_job_proto_msgTypes[5]
+	mi := &file_job_v1_job_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -687,7 +797,7 @@ func (x *DeleteJobPostingReply) String() string {
 func (*DeleteJobPostingReply) ProtoMessage() {}
 
 func (x *DeleteJobPostingReply) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[5]
+	mi := &file_job_v1_job_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +810,7 @@ func (x *DeleteJobPostingReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteJobPostingReply.ProtoReflect.Descriptor instead.
 func (*DeleteJobPostingReply) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{5}
+	return file_job_v1_job_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteJobPostingReply) GetMessage() string {
@@ -719,7 +829,7 @@ type GetJobPostingRequest struct {
 
 func (x *GetJobPostingRequest) Reset() {
 	*x = GetJobPostingRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[6]
+	mi := &file_job_v1_job_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -731,7 +841,7 @@ func (x *GetJobPostingRequest) String() string {
 func (*GetJobPostingRequest) ProtoMessage() {}
 
 func (x *GetJobPostingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[6]
+	mi := &file_job_v1_job_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +854,7 @@ func (x *GetJobPostingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobPostingRequest.ProtoReflect.Descriptor instead.
 func (*GetJobPostingRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{6}
+	return file_job_v1_job_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetJobPostingRequest) GetId() string {
@@ -758,19 +868,23 @@ type ListJobPostingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	CompanyId     string                 `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"` // Filter by company
-	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`                    // Filter by location
-	JobType       string                 `protobuf:"bytes,5,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`       // Filter by job type
-	Level         string                 `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`                          // Filter by level
-	Keyword       string                 `protobuf:"bytes,7,opt,name=keyword,proto3" json:"keyword,omitempty"`                      // Search in title and description
-	JobTech       []string               `protobuf:"bytes,8,rep,name=job_tech,json=jobTech,proto3" json:"job_tech,omitempty"`       // Filter by technologies
+	CompanyId     string                 `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`               // Filter by company
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`                                  // Filter by location
+	JobType       string                 `protobuf:"bytes,5,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`                     // Filter by job type
+	Level         string                 `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`                                        // Filter by level
+	Keyword       string                 `protobuf:"bytes,7,opt,name=keyword,proto3" json:"keyword,omitempty"`                                    // Full-text search in title, job_tech, requirements and description
+	JobTech       []string               `protobuf:"bytes,8,rep,name=job_tech,json=jobTech,proto3" json:"job_tech,omitempty"`                     // Filter by technologies
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                                      // Filter by status (members of company_id only)
+	Sort          string                 `protobuf:"bytes,10,opt,name=sort,proto3" json:"sort,omitempty"`                                         // newest (default) or relevance (requires keyword)
+	IncludeFacets bool                   `protobuf:"varint,11,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets,omitempty"` // Also return the counts of the filter sidebars
+	SalaryBucket  string                 `protobuf:"bytes,12,opt,name=salary_bucket,json=salaryBucket,proto3" json:"salary_bucket,omitempty"`     // Filter by salary bucket (values of the salary_bucket facet)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListJobPostingsRequest) Reset() {
 	*x = ListJobPostingsRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[7]
+	mi := &file_job_v1_job_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -782,7 +896,7 @@ func (x *ListJobPostingsRequest) String() string {
 func (*ListJobPostingsRequest) ProtoMessage() {}
 
 func (x *ListJobPostingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[7]
+	mi := &file_job_v1_job_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,7 +909,7 @@ func (x *ListJobPostingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobPostingsRequest.ProtoReflect.Descriptor instead.
 func (*ListJobPostingsRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{7}
+	return file_job_v1_job_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListJobPostingsRequest) GetPage() int32 {
@@ -854,19 +968,92 @@ func (x *ListJobPostingsRequest) GetJobTech() []string {
 	return nil
 }
 
+func (x *ListJobPostingsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListJobPostingsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListJobPostingsRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
+func (x *ListJobPostingsRequest) GetSalaryBucket() string {
+	if x != nil {
+		return x.SalaryBucket
+	}
+	return ""
+}
+
+type ChangeJobStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeJobStatusRequest) Reset() {
+	*x = ChangeJobStatusRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeJobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeJobStatusRequest) ProtoMessage() {}
+
+func (x *ChangeJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChangeJobStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type ListJobPostingsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Jobs          []*JobPostingReply     `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Facets        *JobFacets             `protobuf:"bytes,5,opt,name=facets,proto3" json:"facets,omitempty"` // Only with include_facets
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListJobPostingsReply) Reset() {
 	*x = ListJobPostingsReply{}
-	mi := &file_job_v1_job_proto_msgTypes[8]
+	mi := &file_job_v1_job_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -878,7 +1065,7 @@ func (x *ListJobPostingsReply) String() string {
 func (*ListJobPostingsReply) ProtoMessage() {}
 
 func (x *ListJobPostingsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[8]
+	mi := &file_job_v1_job_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,7 +1078,7 @@ func (x *ListJobPostingsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobPostingsReply.ProtoReflect.Descriptor instead.
 func (*ListJobPostingsReply) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{8}
+	return file_job_v1_job_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListJobPostingsReply) GetJobs() []*JobPostingReply {
@@ -922,36 +1109,41 @@ func (x *ListJobPostingsReply) GetPageSize() int32 {
 	return 0
 }
 
-type CompanyReply struct {
+func (x *ListJobPostingsReply) GetFacets() *JobFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// JobFacets are job counts by filter value, each facet ignores its own filter
+type JobFacets struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Website       string                 `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	Industry      string                 `protobuf:"bytes,6,opt,name=industry,proto3" json:"industry,omitempty"`
-	CompanySize   string                 `protobuf:"bytes,7,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
-	Location      string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
-	FoundedYear   string                 `protobuf:"bytes,9,opt,name=founded_year,json=foundedYear,proto3" json:"founded_year,omitempty"`
+	Level         []*FacetCount          `protobuf:"bytes,1,rep,name=level,proto3" json:"level,omitempty"`
+	JobType       []*FacetCount          `protobuf:"bytes,2,rep,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Location      []*FacetCount          `protobuf:"bytes,3,rep,name=location,proto3" json:"location,omitempty"`              // Top 20
+	JobTech       []*FacetCount          `protobuf:"bytes,4,rep,name=job_tech,json=jobTech,proto3" json:"job_tech,omitempty"` // Top 20
+	Company       []*FacetCount          `protobuf:"bytes,5,rep,name=company,proto3" json:"company,omitempty"`                // Top 20, published jobs only
+	SalaryBucket  []*FacetCount          `protobuf:"bytes,6,rep,name=salary_bucket,json=salaryBucket,proto3" json:"salary_bucket,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompanyReply) Reset() {
-	*x = CompanyReply{}
-	mi := &file_job_v1_job_proto_msgTypes[9]
+func (x *JobFacets) Reset() {
+	*x = JobFacets{}
+	mi := &file_job_v1_job_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanyReply) String() string {
+func (x *JobFacets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanyReply) ProtoMessage() {}
+func (*JobFacets) ProtoMessage() {}
 
-func (x *CompanyReply) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[9]
+func (x *JobFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,63 +1154,514 @@ func (x *CompanyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanyReply.ProtoReflect.Descriptor instead.
-func (*CompanyReply) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use JobFacets.ProtoReflect.Descriptor instead.
+func (*JobFacets) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CompanyReply) GetId() string {
+func (x *JobFacets) GetLevel() []*FacetCount {
 	if x != nil {
-		return x.Id
+		return x.Level
 	}
-	return ""
+	return nil
 }
 
-func (x *CompanyReply) GetName() string {
+func (x *JobFacets) GetJobType() []*FacetCount {
 	if x != nil {
-		return x.Name
+		return x.JobType
 	}
-	return ""
+	return nil
 }
 
-func (x *CompanyReply) GetDescription() string {
+func (x *JobFacets) GetLocation() []*FacetCount {
 	if x != nil {
-		return x.Description
+		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *CompanyReply) GetWebsite() string {
+func (x *JobFacets) GetJobTech() []*FacetCount {
 	if x != nil {
-		return x.Website
+		return x.JobTech
 	}
-	return ""
+	return nil
 }
 
-func (x *CompanyReply) GetLogoUrl() string {
+func (x *JobFacets) GetCompany() []*FacetCount {
 	if x != nil {
-		return x.LogoUrl
+		return x.Company
 	}
-	return ""
+	return nil
 }
 
-func (x *CompanyReply) GetIndustry() string {
+func (x *JobFacets) GetSalaryBucket() []*FacetCount {
 	if x != nil {
-		return x.Industry
+		return x.SalaryBucket
 	}
-	return ""
+	return nil
 }
 
-func (x *CompanyReply) GetCompanySize() string {
+type FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // Value to filter by (company ID for company)
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"` // Display name
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_job_v1_job_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[12]
 	if x != nil {
-		return x.CompanySize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CompanyReply) GetLocation() string {
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FacetCount) GetValue() string {
 	if x != nil {
-		return x.Location
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SaveJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveJobRequest) Reset() {
+	*x = SaveJobRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveJobRequest) ProtoMessage() {}
+
+func (x *SaveJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveJobRequest.ProtoReflect.Descriptor instead.
+func (*SaveJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SaveJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnsaveJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsaveJobRequest) Reset() {
+	*x = UnsaveJobRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsaveJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsaveJobRequest) ProtoMessage() {}
+
+func (x *UnsaveJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsaveJobRequest.ProtoReflect.Descriptor instead.
+func (*UnsaveJobRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnsaveJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnsaveJobReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsaveJobReply) Reset() {
+	*x = UnsaveJobReply{}
+	mi := &file_job_v1_job_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsaveJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsaveJobReply) ProtoMessage() {}
+
+func (x *UnsaveJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsaveJobReply.ProtoReflect.Descriptor instead.
+func (*UnsaveJobReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnsaveJobReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListSavedJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedJobsRequest) Reset() {
+	*x = ListSavedJobsRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedJobsRequest) ProtoMessage() {}
+
+func (x *ListSavedJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedJobsRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSavedJobsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSavedJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SavedJobReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *JobPostingReply       `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"` // status shows whether the job was closed or expired
+	SavedAt       string                 `protobuf:"bytes,2,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedJobReply) Reset() {
+	*x = SavedJobReply{}
+	mi := &file_job_v1_job_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedJobReply) ProtoMessage() {}
+
+func (x *SavedJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedJobReply.ProtoReflect.Descriptor instead.
+func (*SavedJobReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SavedJobReply) GetJob() *JobPostingReply {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *SavedJobReply) GetSavedAt() string {
+	if x != nil {
+		return x.SavedAt
+	}
+	return ""
+}
+
+type ListSavedJobsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedJobs     []*SavedJobReply       `protobuf:"bytes,1,rep,name=saved_jobs,json=savedJobs,proto3" json:"saved_jobs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedJobsReply) Reset() {
+	*x = ListSavedJobsReply{}
+	mi := &file_job_v1_job_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedJobsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedJobsReply) ProtoMessage() {}
+
+func (x *ListSavedJobsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedJobsReply.ProtoReflect.Descriptor instead.
+func (*ListSavedJobsReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListSavedJobsReply) GetSavedJobs() []*SavedJobReply {
+	if x != nil {
+		return x.SavedJobs
+	}
+	return nil
+}
+
+func (x *ListSavedJobsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSavedJobsReply) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSavedJobsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CompanyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Website       string                 `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Industry      string                 `protobuf:"bytes,6,opt,name=industry,proto3" json:"industry,omitempty"`
+	CompanySize   string                 `protobuf:"bytes,7,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
+	Location      string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	FoundedYear   string                 `protobuf:"bytes,9,opt,name=founded_year,json=foundedYear,proto3" json:"founded_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyReply) Reset() {
+	*x = CompanyReply{}
+	mi := &file_job_v1_job_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyReply) ProtoMessage() {}
+
+func (x *CompanyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyReply.ProtoReflect.Descriptor instead.
+func (*CompanyReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CompanyReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompanyReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompanyReply) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CompanyReply) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *CompanyReply) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *CompanyReply) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *CompanyReply) GetCompanySize() string {
+	if x != nil {
+		return x.CompanySize
+	}
+	return ""
+}
+
+func (x *CompanyReply) GetLocation() string {
+	if x != nil {
+		return x.Location
 	}
 	return ""
 }
@@ -1030,35 +1673,236 @@ func (x *CompanyReply) GetFoundedYear() string {
 	return ""
 }
 
-type CreateCompanyRequest struct {
+type CreateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Website       string                 `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Industry      string                 `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
+	CompanySize   string                 `protobuf:"bytes,6,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
+	Location      string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	FoundedYear   string                 `protobuf:"bytes,8,opt,name=founded_year,json=foundedYear,proto3" json:"founded_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetCompanySize() string {
+	if x != nil {
+		return x.CompanySize
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetFoundedYear() string {
+	if x != nil {
+		return x.FoundedYear
+	}
+	return ""
+}
+
+type UpdateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Website       string                 `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Industry      string                 `protobuf:"bytes,6,opt,name=industry,proto3" json:"industry,omitempty"`
+	CompanySize   string                 `protobuf:"bytes,7,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
+	Location      string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	FoundedYear   string                 `protobuf:"bytes,9,opt,name=founded_year,json=foundedYear,proto3" json:"founded_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetCompanySize() string {
+	if x != nil {
+		return x.CompanySize
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetFoundedYear() string {
+	if x != nil {
+		return x.FoundedYear
+	}
+	return ""
+}
+
+type DeleteCompanyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Website       string                 `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	Industry      string                 `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
-	CompanySize   string                 `protobuf:"bytes,6,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
-	Location      string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
-	FoundedYear   string                 `protobuf:"bytes,8,opt,name=founded_year,json=foundedYear,proto3" json:"founded_year,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyRequest) Reset() {
-	*x = CreateCompanyRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[10]
+func (x *DeleteCompanyRequest) Reset() {
+	*x = DeleteCompanyRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyRequest) String() string {
+func (x *DeleteCompanyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompanyRequest) ProtoMessage() {}
+func (*DeleteCompanyRequest) ProtoMessage() {}
 
-func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[10]
+func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,97 +1913,280 @@ func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteCompanyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateCompanyRequest) GetName() string {
+func (x *DeleteCompanyRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetDescription() string {
+type DeleteCompanyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompanyReply) Reset() {
+	*x = DeleteCompanyReply{}
+	mi := &file_job_v1_job_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompanyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompanyReply) ProtoMessage() {}
+
+func (x *DeleteCompanyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[23]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompanyReply.ProtoReflect.Descriptor instead.
+func (*DeleteCompanyReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteCompanyReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCompaniesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Industry      string                 `protobuf:"bytes,3,opt,name=industry,proto3" json:"industry,omitempty"` // Filter by industry
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"` // Filter by location
+	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`   // Search in name and description
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesRequest) Reset() {
+	*x = ListCompaniesRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesRequest) ProtoMessage() {}
+
+func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListCompaniesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCompaniesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCompaniesRequest) GetIndustry() string {
+	if x != nil {
+		return x.Industry
+	}
+	return ""
+}
+
+func (x *ListCompaniesRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListCompaniesRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetWebsite() string {
+type ListCompaniesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*CompanyReply        `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesReply) Reset() {
+	*x = ListCompaniesReply{}
+	mi := &file_job_v1_job_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesReply) ProtoMessage() {}
+
+func (x *ListCompaniesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[26]
 	if x != nil {
-		return x.Website
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateCompanyRequest) GetLogoUrl() string {
-	if x != nil {
-		return x.LogoUrl
-	}
-	return ""
+// Deprecated: Use ListCompaniesReply.ProtoReflect.Descriptor instead.
+func (*ListCompaniesReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateCompanyRequest) GetIndustry() string {
+func (x *ListCompaniesReply) GetCompanies() []*CompanyReply {
 	if x != nil {
-		return x.Industry
+		return x.Companies
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCompanyRequest) GetCompanySize() string {
+func (x *ListCompaniesReply) GetTotal() int32 {
 	if x != nil {
-		return x.CompanySize
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCompanyRequest) GetLocation() string {
+func (x *ListCompaniesReply) GetPage() int32 {
 	if x != nil {
-		return x.Location
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCompanyRequest) GetFoundedYear() string {
+func (x *ListCompaniesReply) GetPageSize() int32 {
 	if x != nil {
-		return x.FoundedYear
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type UpdateCompanyRequest struct {
+type CompanyMemberReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Website       string                 `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,5,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	Industry      string                 `protobuf:"bytes,6,opt,name=industry,proto3" json:"industry,omitempty"`
-	CompanySize   string                 `protobuf:"bytes,7,opt,name=company_size,json=companySize,proto3" json:"company_size,omitempty"`
-	Location      string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
-	FoundedYear   string                 `protobuf:"bytes,9,opt,name=founded_year,json=foundedYear,proto3" json:"founded_year,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`     // OWNER, RECRUITER
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // PENDING, ACTIVE
+	InvitedBy     string                 `protobuf:"bytes,7,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AcceptedAt    string                 `protobuf:"bytes,9,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyRequest) Reset() {
-	*x = UpdateCompanyRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[11]
+func (x *CompanyMemberReply) Reset() {
+	*x = CompanyMemberReply{}
+	mi := &file_job_v1_job_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyRequest) String() string {
+func (x *CompanyMemberReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyRequest) ProtoMessage() {}
+func (*CompanyMemberReply) ProtoMessage() {}
 
-func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[11]
+func (x *CompanyMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,96 +2197,98 @@ func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CompanyMemberReply.ProtoReflect.Descriptor instead.
+func (*CompanyMemberReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateCompanyRequest) GetId() string {
+func (x *CompanyMemberReply) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetName() string {
+func (x *CompanyMemberReply) GetCompanyId() string {
 	if x != nil {
-		return x.Name
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetDescription() string {
+func (x *CompanyMemberReply) GetUserId() string {
 	if x != nil {
-		return x.Description
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetWebsite() string {
+func (x *CompanyMemberReply) GetEmail() string {
 	if x != nil {
-		return x.Website
+		return x.Email
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetLogoUrl() string {
+func (x *CompanyMemberReply) GetRole() string {
 	if x != nil {
-		return x.LogoUrl
+		return x.Role
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetIndustry() string {
+func (x *CompanyMemberReply) GetStatus() string {
 	if x != nil {
-		return x.Industry
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetCompanySize() string {
+func (x *CompanyMemberReply) GetInvitedBy() string {
 	if x != nil {
-		return x.CompanySize
+		return x.InvitedBy
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetLocation() string {
+func (x *CompanyMemberReply) GetCreatedAt() string {
 	if x != nil {
-		return x.Location
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetFoundedYear() string {
+func (x *CompanyMemberReply) GetAcceptedAt() string {
 	if x != nil {
-		return x.FoundedYear
+		return x.AcceptedAt
 	}
 	return ""
 }
 
-type DeleteCompanyRequest struct {
+type InviteCompanyMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // Email of a registered user
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`   // OWNER, RECRUITER (default RECRUITER)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCompanyRequest) Reset() {
-	*x = DeleteCompanyRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[12]
+func (x *InviteCompanyMemberRequest) Reset() {
+	*x = InviteCompanyMemberRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCompanyRequest) String() string {
+func (x *InviteCompanyMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCompanyRequest) ProtoMessage() {}
+func (*InviteCompanyMemberRequest) ProtoMessage() {}
 
-func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[12]
+func (x *InviteCompanyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,40 +2299,54 @@ func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCompanyRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use InviteCompanyMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteCompanyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteCompanyRequest) GetId() string {
+func (x *InviteCompanyMemberRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Id
+		return x.CompanyId
 	}
 	return ""
 }
 
-type DeleteCompanyReply struct {
+func (x *InviteCompanyMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteCompanyMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AcceptCompanyInvitationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCompanyReply) Reset() {
-	*x = DeleteCompanyReply{}
-	mi := &file_job_v1_job_proto_msgTypes[13]
+func (x *AcceptCompanyInvitationRequest) Reset() {
+	*x = AcceptCompanyInvitationRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCompanyReply) String() string {
+func (x *AcceptCompanyInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCompanyReply) ProtoMessage() {}
+func (*AcceptCompanyInvitationRequest) ProtoMessage() {}
 
-func (x *DeleteCompanyReply) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[13]
+func (x *AcceptCompanyInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,40 +2357,41 @@ func (x *DeleteCompanyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCompanyReply.ProtoReflect.Descriptor instead.
-func (*DeleteCompanyReply) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AcceptCompanyInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCompanyInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DeleteCompanyReply) GetSuccess() bool {
+func (x *AcceptCompanyInvitationRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Success
+		return x.CompanyId
 	}
-	return false
+	return ""
 }
 
-type GetCompanyRequest struct {
+type RemoveCompanyMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyRequest) Reset() {
-	*x = GetCompanyRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[14]
+func (x *RemoveCompanyMemberRequest) Reset() {
+	*x = RemoveCompanyMemberRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyRequest) String() string {
+func (x *RemoveCompanyMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyRequest) ProtoMessage() {}
+func (*RemoveCompanyMemberRequest) ProtoMessage() {}
 
-func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[14]
+func (x *RemoveCompanyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1358,44 +2402,47 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RemoveCompanyMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetCompanyRequest) GetId() string {
+func (x *RemoveCompanyMemberRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Id
+		return x.CompanyId
 	}
 	return ""
 }
 
-type ListCompaniesRequest struct {
+func (x *RemoveCompanyMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveCompanyMemberReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Industry      string                 `protobuf:"bytes,3,opt,name=industry,proto3" json:"industry,omitempty"` // Filter by industry
-	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"` // Filter by location
-	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`   // Search in name and description
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCompaniesRequest) Reset() {
-	*x = ListCompaniesRequest{}
-	mi := &file_job_v1_job_proto_msgTypes[15]
+func (x *RemoveCompanyMemberReply) Reset() {
+	*x = RemoveCompanyMemberReply{}
+	mi := &file_job_v1_job_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCompaniesRequest) String() string {
+func (x *RemoveCompanyMemberReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCompaniesRequest) ProtoMessage() {}
+func (*RemoveCompanyMemberReply) ProtoMessage() {}
 
-func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[15]
+func (x *RemoveCompanyMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,71 +2453,84 @@ func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
-func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RemoveCompanyMemberReply.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyMemberReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListCompaniesRequest) GetPage() int32 {
+func (x *RemoveCompanyMemberReply) GetSuccess() bool {
 	if x != nil {
-		return x.Page
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ListCompaniesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+type ListCompanyMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCompaniesRequest) GetIndustry() string {
-	if x != nil {
-		return x.Industry
-	}
-	return ""
+func (x *ListCompanyMembersRequest) Reset() {
+	*x = ListCompanyMembersRequest{}
+	mi := &file_job_v1_job_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCompaniesRequest) GetLocation() string {
+func (x *ListCompanyMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanyMembersRequest) ProtoMessage() {}
+
+func (x *ListCompanyMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[32]
 	if x != nil {
-		return x.Location
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListCompaniesRequest) GetKeyword() string {
+// Deprecated: Use ListCompanyMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListCompanyMembersRequest) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListCompanyMembersRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Keyword
+		return x.CompanyId
 	}
 	return ""
 }
 
-type ListCompaniesReply struct {
+type ListCompanyMembersReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Companies     []*CompanyReply        `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Members       []*CompanyMemberReply  `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCompaniesReply) Reset() {
-	*x = ListCompaniesReply{}
-	mi := &file_job_v1_job_proto_msgTypes[16]
+func (x *ListCompanyMembersReply) Reset() {
+	*x = ListCompanyMembersReply{}
+	mi := &file_job_v1_job_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCompaniesReply) String() string {
+func (x *ListCompanyMembersReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCompaniesReply) ProtoMessage() {}
+func (*ListCompanyMembersReply) ProtoMessage() {}
 
-func (x *ListCompaniesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_job_v1_job_proto_msgTypes[16]
+func (x *ListCompanyMembersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_job_v1_job_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,45 +2541,24 @@ func (x *ListCompaniesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCompaniesReply.ProtoReflect.Descriptor instead.
-func (*ListCompaniesReply) Descriptor() ([]byte, []int) {
-	return file_job_v1_job_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListCompanyMembersReply.ProtoReflect.Descriptor instead.
+func (*ListCompanyMembersReply) Descriptor() ([]byte, []int) {
+	return file_job_v1_job_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListCompaniesReply) GetCompanies() []*CompanyReply {
+func (x *ListCompanyMembersReply) GetMembers() []*CompanyMemberReply {
 	if x != nil {
-		return x.Companies
+		return x.Members
 	}
 	return nil
 }
 
-func (x *ListCompaniesReply) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListCompaniesReply) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListCompaniesReply) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
 var File_job_v1_job_proto protoreflect.FileDescriptor
 
 const file_job_v1_job_proto_rawDesc = "" +
 	"\n" +
 	"\x10job/v1/job.proto\x12\n" +
-	"api.job.v1\x1a\x1cgoogle/api/annotations.proto\"\x86\x02\n" +
+	"api.job.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x16policy/v1/policy.proto\"\x86\x02\n" +
 	"\vCompanyInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1529,7 +2568,7 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\bindustry\x18\x06 \x01(\tR\bindustry\x12!\n" +
 	"\fcompany_size\x18\a \x01(\tR\vcompanySize\x12\x1a\n" +
 	"\blocation\x18\b \x01(\tR\blocation\x12!\n" +
-	"\ffounded_year\x18\t \x01(\tR\vfoundedYear\"\xd9\x04\n" +
+	"\ffounded_year\x18\t \x01(\tR\vfoundedYear\"\xe5\x05\n" +
 	"\x0fJobPostingReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1553,7 +2592,17 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\bbenefits\x18\x10 \x01(\tR\bbenefits\x12\x19\n" +
 	"\bjob_tech\x18\x11 \x03(\tR\ajobTech\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x12 \x01(\tR\tcreatedAt\"\xff\x03\n" +
+	"created_at\x18\x12 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06status\x18\x13 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x14 \x01(\tR\texpiresAt\x12\x19\n" +
+	"\bis_saved\x18\x15 \x01(\bR\aisSaved\x128\n" +
+	"\n" +
+	"highlights\x18\x16 \x03(\v2\x18.api.job.v1.JobHighlightR\n" +
+	"highlights\">\n" +
+	"\fJobHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\"\xb6\x04\n" +
 	"\x17CreateJobPostingRequest\x12\x1d\n" +
 	"\n" +
 	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x14\n" +
@@ -1573,7 +2622,10 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\x10responsibilities\x18\f \x01(\tR\x10responsibilities\x12\"\n" +
 	"\frequirements\x18\r \x01(\tR\frequirements\x12\x1a\n" +
 	"\bbenefits\x18\x0e \x01(\tR\bbenefits\x12\x19\n" +
-	"\bjob_tech\x18\x0f \x03(\tR\ajobTech\"\xf0\x03\n" +
+	"\bjob_tech\x18\x0f \x03(\tR\ajobTech\x12\x16\n" +
+	"\x06status\x18\x10 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x11 \x01(\tR\texpiresAt\"\x8f\x04\n" +
 	"\x17UpdateJobPostingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
@@ -1592,13 +2644,15 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\x10responsibilities\x18\f \x01(\tR\x10responsibilities\x12\"\n" +
 	"\frequirements\x18\r \x01(\tR\frequirements\x12\x1a\n" +
 	"\bbenefits\x18\x0e \x01(\tR\bbenefits\x12\x19\n" +
-	"\bjob_tech\x18\x0f \x03(\tR\ajobTech\")\n" +
+	"\bjob_tech\x18\x0f \x03(\tR\ajobTech\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x10 \x01(\tR\texpiresAt\")\n" +
 	"\x17DeleteJobPostingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x15DeleteJobPostingReply\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"&\n" +
 	"\x14GetJobPostingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xea\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe2\x02\n" +
 	"\x16ListJobPostingsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -1608,11 +2662,49 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\bjob_type\x18\x05 \x01(\tR\ajobType\x12\x14\n" +
 	"\x05level\x18\x06 \x01(\tR\x05level\x12\x18\n" +
 	"\akeyword\x18\a \x01(\tR\akeyword\x12\x19\n" +
-	"\bjob_tech\x18\b \x03(\tR\ajobTech\"\x8e\x01\n" +
+	"\bjob_tech\x18\b \x03(\tR\ajobTech\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x12\n" +
+	"\x04sort\x18\n" +
+	" \x01(\tR\x04sort\x12%\n" +
+	"\x0einclude_facets\x18\v \x01(\bR\rincludeFacets\x12#\n" +
+	"\rsalary_bucket\x18\f \x01(\tR\fsalaryBucket\"(\n" +
+	"\x16ChangeJobStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xbd\x01\n" +
 	"\x14ListJobPostingsReply\x12/\n" +
 	"\x04jobs\x18\x01 \x03(\v2\x1b.api.job.v1.JobPostingReplyR\x04jobs\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12-\n" +
+	"\x06facets\x18\x05 \x01(\v2\x15.api.job.v1.JobFacetsR\x06facets\"\xc2\x02\n" +
+	"\tJobFacets\x12,\n" +
+	"\x05level\x18\x01 \x03(\v2\x16.api.job.v1.FacetCountR\x05level\x121\n" +
+	"\bjob_type\x18\x02 \x03(\v2\x16.api.job.v1.FacetCountR\ajobType\x122\n" +
+	"\blocation\x18\x03 \x03(\v2\x16.api.job.v1.FacetCountR\blocation\x121\n" +
+	"\bjob_tech\x18\x04 \x03(\v2\x16.api.job.v1.FacetCountR\ajobTech\x120\n" +
+	"\acompany\x18\x05 \x03(\v2\x16.api.job.v1.FacetCountR\acompany\x12;\n" +
+	"\rsalary_bucket\x18\x06 \x03(\v2\x16.api.job.v1.FacetCountR\fsalaryBucket\"N\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\" \n" +
+	"\x0eSaveJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\"\n" +
+	"\x10UnsaveJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x0eUnsaveJobReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"G\n" +
+	"\x14ListSavedJobsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"Y\n" +
+	"\rSavedJobReply\x12-\n" +
+	"\x03job\x18\x01 \x01(\v2\x1b.api.job.v1.JobPostingReplyR\x03job\x12\x19\n" +
+	"\bsaved_at\x18\x02 \x01(\tR\asavedAt\"\x95\x01\n" +
+	"\x12ListSavedJobsReply\x128\n" +
+	"\n" +
+	"saved_jobs\x18\x01 \x03(\v2\x19.api.job.v1.SavedJobReplyR\tsavedJobs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x87\x02\n" +
 	"\fCompanyReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
@@ -1659,21 +2751,71 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\tcompanies\x18\x01 \x03(\v2\x18.api.job.v1.CompanyReplyR\tcompanies\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize2\xc0\x04\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xfd\x01\n" +
+	"\x12CompanyMemberReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\a \x01(\tR\tinvitedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vaccepted_at\x18\t \x01(\tR\n" +
+	"acceptedAt\"e\n" +
+	"\x1aInviteCompanyMemberRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"?\n" +
+	"\x1eAcceptCompanyInvitationRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\"T\n" +
+	"\x1aRemoveCompanyMemberRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"4\n" +
+	"\x18RemoveCompanyMemberReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\":\n" +
+	"\x19ListCompanyMembersRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\"S\n" +
+	"\x17ListCompanyMembersReply\x128\n" +
+	"\amembers\x18\x01 \x03(\v2\x1e.api.job.v1.CompanyMemberReplyR\amembers2\xc4\v\n" +
+	"\n" +
+	"JobPosting\x12\x7f\n" +
+	"\x10CreateJobPosting\x12#.api.job.v1.CreateJobPostingRequest\x1a\x1b.api.job.v1.JobPostingReply\")\xa2\xbb\x18\x0e\b\x02\x1a\n" +
+	"jobs:write\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/v1/jobs\x12\x84\x01\n" +
+	"\x10UpdateJobPosting\x12#.api.job.v1.UpdateJobPostingRequest\x1a\x1b.api.job.v1.JobPostingReply\".\xa2\xbb\x18\x0e\b\x02\x1a\n" +
+	"jobs:write\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/v1/jobs/{id}\x12\x87\x01\n" +
+	"\x10DeleteJobPosting\x12#.api.job.v1.DeleteJobPostingRequest\x1a!.api.job.v1.DeleteJobPostingReply\"+\xa2\xbb\x18\x0e\b\x02\x1a\n" +
+	"jobs:write\x82\xd3\xe4\x93\x02\x13*\x11/api/v1/jobs/{id}\x12z\n" +
+	"\rGetJobPosting\x12 .api.job.v1.GetJobPostingRequest\x1a\x1b.api.job.v1.JobPostingReply\"*\xa2\xbb\x18\r\b\x01\x1a\tjobs:read\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/jobs/{id}\x12~\n" +
+	"\x0fListJobPostings\x12\".api.job.v1.ListJobPostingsRequest\x1a .api.job.v1.ListJobPostingsReply\"%\xa2\xbb\x18\r\b\x01\x1a\tjobs:read\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/jobs\x12\x8c\x01\n" +
+	"\x11PublishJobPosting\x12\".api.job.v1.ChangeJobStatusRequest\x1a\x1b.api.job.v1.JobPostingReply\"6\xa2\xbb\x18\x0e\b\x02\x1a\n" +
+	"jobs:write\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/jobs/{id}/publish\x12\x88\x01\n" +
+	"\x0fPauseJobPosting\x12\".api.job.v1.ChangeJobStatusRequest\x1a\x1b.api.job.v1.JobPostingReply\"4\xa2\xbb\x18\x0e\b\x02\x1a\n" +
+	"jobs:write\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/jobs/{id}/pause\x12\x88\x01\n" +
+	"\x0fCloseJobPosting\x12\".api.job.v1.ChangeJobStatusRequest\x1a\x1b.api.job.v1.JobPostingReply\"4\xa2\xbb\x18\x0e\b\x02\x1a\n" +
+	"jobs:write\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/jobs/{id}/close\x12}\n" +
+	"\aSaveJob\x12\x1a.api.job.v1.SaveJobRequest\x1a\x1b.api.job.v1.JobPostingReply\"9\xa2\xbb\x18\x14\b\x02\x1a\x10saved_jobs:write\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/jobs/{id}/save\x12}\n" +
+	"\tUnsaveJob\x12\x1c.api.job.v1.UnsaveJobRequest\x1a\x1a.api.job.v1.UnsaveJobReply\"6\xa2\xbb\x18\x14\b\x02\x1a\x10saved_jobs:write\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/jobs/{id}/save\x12\x84\x01\n" +
+	"\rListSavedJobs\x12 .api.job.v1.ListSavedJobsRequest\x1a\x1e.api.job.v1.ListSavedJobsReply\"1\xa2\xbb\x18\x13\b\x02\x1a\x0fsaved_jobs:read\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/saved-jobs2\xd3\n" +
 	"\n" +
-	"JobPosting\x12m\n" +
-	"\x10CreateJobPosting\x12#.api.job.v1.CreateJobPostingRequest\x1a\x1b.api.job.v1.JobPostingReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/v1/jobs\x12r\n" +
-	"\x10UpdateJobPosting\x12#.api.job.v1.UpdateJobPostingRequest\x1a\x1b.api.job.v1.JobPostingReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/v1/jobs/{id}\x12u\n" +
-	"\x10DeleteJobPosting\x12#.api.job.v1.DeleteJobPostingRequest\x1a!.api.job.v1.DeleteJobPostingReply\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/api/v1/jobs/{id}\x12i\n" +
-	"\rGetJobPosting\x12 .api.job.v1.GetJobPostingRequest\x1a\x1b.api.job.v1.JobPostingReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/jobs/{id}\x12m\n" +
-	"\x0fListJobPostings\x12\".api.job.v1.ListJobPostingsRequest\x1a .api.job.v1.ListJobPostingsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/jobs2\xac\x04\n" +
-	"\aCompany\x12i\n" +
-	"\rCreateCompany\x12 .api.job.v1.CreateCompanyRequest\x1a\x18.api.job.v1.CompanyReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/companies\x12n\n" +
-	"\rUpdateCompany\x12 .api.job.v1.UpdateCompanyRequest\x1a\x18.api.job.v1.CompanyReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/companies/{id}\x12q\n" +
-	"\rDeleteCompany\x12 .api.job.v1.DeleteCompanyRequest\x1a\x1e.api.job.v1.DeleteCompanyReply\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/companies/{id}\x12e\n" +
+	"\aCompany\x12\x80\x01\n" +
+	"\rCreateCompany\x12 .api.job.v1.CreateCompanyRequest\x1a\x18.api.job.v1.CompanyReply\"3\xa2\xbb\x18\x13\b\x02\x1a\x0fcompanies:write\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/companies\x12\x85\x01\n" +
+	"\rUpdateCompany\x12 .api.job.v1.UpdateCompanyRequest\x1a\x18.api.job.v1.CompanyReply\"8\xa2\xbb\x18\x13\b\x02\x1a\x0fcompanies:write\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/companies/{id}\x12\x88\x01\n" +
+	"\rDeleteCompany\x12 .api.job.v1.DeleteCompanyRequest\x1a\x1e.api.job.v1.DeleteCompanyReply\"5\xa2\xbb\x18\x13\b\x02\x1a\x0fcompanies:write\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/companies/{id}\x12{\n" +
 	"\n" +
-	"GetCompany\x12\x1d.api.job.v1.GetCompanyRequest\x1a\x18.api.job.v1.CompanyReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/companies/{id}\x12l\n" +
-	"\rListCompanies\x12 .api.job.v1.ListCompaniesRequest\x1a\x1e.api.job.v1.ListCompaniesReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/companiesB&\n" +
+	"GetCompany\x12\x1d.api.job.v1.GetCompanyRequest\x1a\x18.api.job.v1.CompanyReply\"4\xa2\xbb\x18\x12\b\x01\x1a\x0ecompanies:read\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/companies/{id}\x12\x82\x01\n" +
+	"\rListCompanies\x12 .api.job.v1.ListCompaniesRequest\x1a\x1e.api.job.v1.ListCompaniesReply\"/\xa2\xbb\x18\x12\b\x01\x1a\x0ecompanies:read\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/companies\x12\xa7\x01\n" +
+	"\x13InviteCompanyMember\x12&.api.job.v1.InviteCompanyMemberRequest\x1a\x1e.api.job.v1.CompanyMemberReply\"H\xa2\xbb\x18\x13\b\x02\x1a\x0fcompanies:write\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/companies/{company_id}/members\x12\xa5\x01\n" +
+	"\x17AcceptCompanyInvitation\x12*.api.job.v1.AcceptCompanyInvitationRequest\x1a\x1e.api.job.v1.CompanyMemberReply\">\xa2\xbb\x18\x02\b\x02\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/companies/{company_id}/members/accept\x12\xb4\x01\n" +
+	"\x13RemoveCompanyMember\x12&.api.job.v1.RemoveCompanyMemberRequest\x1a$.api.job.v1.RemoveCompanyMemberReply\"O\xa2\xbb\x18\x13\b\x02\x1a\x0fcompanies:write\x82\xd3\xe4\x93\x022*0/api/v1/companies/{company_id}/members/{user_id}\x12\xa6\x01\n" +
+	"\x12ListCompanyMembers\x12%.api.job.v1.ListCompanyMembersRequest\x1a#.api.job.v1.ListCompanyMembersReply\"D\xa2\xbb\x18\x12\b\x02\x1a\x0ecompanies:read\x82\xd3\xe4\x93\x02(\x12&/api/v1/companies/{company_id}/membersB&\n" +
 	"\n" +
 	"api.job.v1P\x01Z\x16JobblyBE/api/job/v1;v1b\x06proto3"
 
@@ -1689,55 +2831,103 @@ func file_job_v1_job_proto_rawDescGZIP() []byte {
 	return file_job_v1_job_proto_rawDescData
 }
 
-var file_job_v1_job_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_job_v1_job_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_job_v1_job_proto_goTypes = []any{
-	(*CompanyInfo)(nil),             // 0: api.job.v1.CompanyInfo
-	(*JobPostingReply)(nil),         // 1: api.job.v1.JobPostingReply
-	(*CreateJobPostingRequest)(nil), // 2: api.job.v1.CreateJobPostingRequest
-	(*UpdateJobPostingRequest)(nil), // 3: api.job.v1.UpdateJobPostingRequest
-	(*DeleteJobPostingRequest)(nil), // 4: api.job.v1.DeleteJobPostingRequest
-	(*DeleteJobPostingReply)(nil),   // 5: api.job.v1.DeleteJobPostingReply
-	(*GetJobPostingRequest)(nil),    // 6: api.job.v1.GetJobPostingRequest
-	(*ListJobPostingsRequest)(nil),  // 7: api.job.v1.ListJobPostingsRequest
-	(*ListJobPostingsReply)(nil),    // 8: api.job.v1.ListJobPostingsReply
-	(*CompanyReply)(nil),            // 9: api.job.v1.CompanyReply
-	(*CreateCompanyRequest)(nil),    // 10: api.job.v1.CreateCompanyRequest
-	(*UpdateCompanyRequest)(nil),    // 11: api.job.v1.UpdateCompanyRequest
-	(*DeleteCompanyRequest)(nil),    // 12: api.job.v1.DeleteCompanyRequest
-	(*DeleteCompanyReply)(nil),      // 13: api.job.v1.DeleteCompanyReply
-	(*GetCompanyRequest)(nil),       // 14: api.job.v1.GetCompanyRequest
-	(*ListCompaniesRequest)(nil),    // 15: api.job.v1.ListCompaniesRequest
-	(*ListCompaniesReply)(nil),      // 16: api.job.v1.ListCompaniesReply
+	(*CompanyInfo)(nil),                    // 0: api.job.v1.CompanyInfo
+	(*JobPostingReply)(nil),                // 1: api.job.v1.JobPostingReply
+	(*JobHighlight)(nil),                   // 2: api.job.v1.JobHighlight
+	(*CreateJobPostingRequest)(nil),        // 3: api.job.v1.CreateJobPostingRequest
+	(*UpdateJobPostingRequest)(nil),        // 4: api.job.v1.UpdateJobPostingRequest
+	(*DeleteJobPostingRequest)(nil),        // 5: api.job.v1.DeleteJobPostingRequest
+	(*DeleteJobPostingReply)(nil),          // 6: api.job.v1.DeleteJobPostingReply
+	(*GetJobPostingRequest)(nil),           // 7: api.job.v1.GetJobPostingRequest
+	(*ListJobPostingsRequest)(nil),         // 8: api.job.v1.ListJobPostingsRequest
+	(*ChangeJobStatusRequest)(nil),         // 9: api.job.v1.ChangeJobStatusRequest
+	(*ListJobPostingsReply)(nil),           // 10: api.job.v1.ListJobPostingsReply
+	(*JobFacets)(nil),                      // 11: api.job.v1.JobFacets
+	(*FacetCount)(nil),                     // 12: api.job.v1.FacetCount
+	(*SaveJobRequest)(nil),                 // 13: api.job.v1.SaveJobRequest
+	(*UnsaveJobRequest)(nil),               // 14: api.job.v1.UnsaveJobRequest
+	(*UnsaveJobReply)(nil),                 // 15: api.job.v1.UnsaveJobReply
+	(*ListSavedJobsRequest)(nil),           // 16: api.job.v1.ListSavedJobsRequest
+	(*SavedJobReply)(nil),                  // 17: api.job.v1.SavedJobReply
+	(*ListSavedJobsReply)(nil),             // 18: api.job.v1.ListSavedJobsReply
+	(*CompanyReply)(nil),                   // 19: api.job.v1.CompanyReply
+	(*CreateCompanyRequest)(nil),           // 20: api.job.v1.CreateCompanyRequest
+	(*UpdateCompanyRequest)(nil),           // 21: api.job.v1.UpdateCompanyRequest
+	(*DeleteCompanyRequest)(nil),           // 22: api.job.v1.DeleteCompanyRequest
+	(*DeleteCompanyReply)(nil),             // 23: api.job.v1.DeleteCompanyReply
+	(*GetCompanyRequest)(nil),              // 24: api.job.v1.GetCompanyRequest
+	(*ListCompaniesRequest)(nil),           // 25: api.job.v1.ListCompaniesRequest
+	(*ListCompaniesReply)(nil),             // 26: api.job.v1.ListCompaniesReply
+	(*CompanyMemberReply)(nil),             // 27: api.job.v1.CompanyMemberReply
+	(*InviteCompanyMemberRequest)(nil),     // 28: api.job.v1.InviteCompanyMemberRequest
+	(*AcceptCompanyInvitationRequest)(nil), // 29: api.job.v1.AcceptCompanyInvitationRequest
+	(*RemoveCompanyMemberRequest)(nil),     // 30: api.job.v1.RemoveCompanyMemberRequest
+	(*RemoveCompanyMemberReply)(nil),       // 31: api.job.v1.RemoveCompanyMemberReply
+	(*ListCompanyMembersRequest)(nil),      // 32: api.job.v1.ListCompanyMembersRequest
+	(*ListCompanyMembersReply)(nil),        // 33: api.job.v1.ListCompanyMembersReply
 }
 var file_job_v1_job_proto_depIdxs = []int32{
 	0,  // 0: api.job.v1.JobPostingReply.company:type_name -> api.job.v1.CompanyInfo
-	1,  // 1: api.job.v1.ListJobPostingsReply.jobs:type_name -> api.job.v1.JobPostingReply
-	9,  // 2: api.job.v1.ListCompaniesReply.companies:type_name -> api.job.v1.CompanyReply
-	2,  // 3: api.job.v1.JobPosting.CreateJobPosting:input_type -> api.job.v1.CreateJobPostingRequest
-	3,  // 4: api.job.v1.JobPosting.UpdateJobPosting:input_type -> api.job.v1.UpdateJobPostingRequest
-	4,  // 5: api.job.v1.JobPosting.DeleteJobPosting:input_type -> api.job.v1.DeleteJobPostingRequest
-	6,  // 6: api.job.v1.JobPosting.GetJobPosting:input_type -> api.job.v1.GetJobPostingRequest
-	7,  // 7: api.job.v1.JobPosting.ListJobPostings:input_type -> api.job.v1.ListJobPostingsRequest
-	10, // 8: api.job.v1.Company.CreateCompany:input_type -> api.job.v1.CreateCompanyRequest
-	11, // 9: api.job.v1.Company.UpdateCompany:input_type -> api.job.v1.UpdateCompanyRequest
-	12, // 10: api.job.v1.Company.DeleteCompany:input_type -> api.job.v1.DeleteCompanyRequest
-	14, // 11: api.job.v1.Company.GetCompany:input_type -> api.job.v1.GetCompanyRequest
-	15, // 12: api.job.v1.Company.ListCompanies:input_type -> api.job.v1.ListCompaniesRequest
-	1,  // 13: api.job.v1.JobPosting.CreateJobPosting:output_type -> api.job.v1.JobPostingReply
-	1,  // 14: api.job.v1.JobPosting.UpdateJobPosting:output_type -> api.job.v1.JobPostingReply
-	5,  // 15: api.job.v1.JobPosting.DeleteJobPosting:output_type -> api.job.v1.DeleteJobPostingReply
-	1,  // 16: api.job.v1.JobPosting.GetJobPosting:output_type -> api.job.v1.JobPostingReply
-	8,  // 17: api.job.v1.JobPosting.ListJobPostings:output_type -> api.job.v1.ListJobPostingsReply
-	9,  // 18: api.job.v1.Company.CreateCompany:output_type -> api.job.v1.CompanyReply
-	9,  // 19: api.job.v1.Company.UpdateCompany:output_type -> api.job.v1.CompanyReply
-	13, // 20: api.job.v1.Company.DeleteCompany:output_type -> api.job.v1.DeleteCompanyReply
-	9,  // 21: api.job.v1.Company.GetCompany:output_type -> api.job.v1.CompanyReply
-	16, // 22: api.job.v1.Company.ListCompanies:output_type -> api.job.v1.ListCompaniesReply
-	13, // [13:23] is the sub-list for method output_type
-	3,  // [3:13] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	2,  // 1: api.job.v1.JobPostingReply.highlights:type_name -> api.job.v1.JobHighlight
+	1,  // 2: api.job.v1.ListJobPostingsReply.jobs:type_name -> api.job.v1.JobPostingReply
+	11, // 3: api.job.v1.ListJobPostingsReply.facets:type_name -> api.job.v1.JobFacets
+	12, // 4: api.job.v1.JobFacets.level:type_name -> api.job.v1.FacetCount
+	12, // 5: api.job.v1.JobFacets.job_type:type_name -> api.job.v1.FacetCount
+	12, // 6: api.job.v1.JobFacets.location:type_name -> api.job.v1.FacetCount
+	12, // 7: api.job.v1.JobFacets.job_tech:type_name -> api.job.v1.FacetCount
+	12, // 8: api.job.v1.JobFacets.company:type_name -> api.job.v1.FacetCount
+	12, // 9: api.job.v1.JobFacets.salary_bucket:type_name -> api.job.v1.FacetCount
+	1,  // 10: api.job.v1.SavedJobReply.job:type_name -> api.job.v1.JobPostingReply
+	17, // 11: api.job.v1.ListSavedJobsReply.saved_jobs:type_name -> api.job.v1.SavedJobReply
+	19, // 12: api.job.v1.ListCompaniesReply.companies:type_name -> api.job.v1.CompanyReply
+	27, // 13: api.job.v1.ListCompanyMembersReply.members:type_name -> api.job.v1.CompanyMemberReply
+	3,  // 14: api.job.v1.JobPosting.CreateJobPosting:input_type -> api.job.v1.CreateJobPostingRequest
+	4,  // 15: api.job.v1.JobPosting.UpdateJobPosting:input_type -> api.job.v1.UpdateJobPostingRequest
+	5,  // 16: api.job.v1.JobPosting.DeleteJobPosting:input_type -> api.job.v1.DeleteJobPostingRequest
+	7,  // 17: api.job.v1.JobPosting.GetJobPosting:input_type -> api.job.v1.GetJobPostingRequest
+	8,  // 18: api.job.v1.JobPosting.ListJobPostings:input_type -> api.job.v1.ListJobPostingsRequest
+	9,  // 19: api.job.v1.JobPosting.PublishJobPosting:input_type -> api.job.v1.ChangeJobStatusRequest
+	9,  // 20: api.job.v1.JobPosting.PauseJobPosting:input_type -> api.job.v1.ChangeJobStatusRequest
+	9,  // 21: api.job.v1.JobPosting.CloseJobPosting:input_type -> api.job.v1.ChangeJobStatusRequest
+	13, // 22: api.job.v1.JobPosting.SaveJob:input_type -> api.job.v1.SaveJobRequest
+	14, // 23: api.job.v1.JobPosting.UnsaveJob:input_type -> api.job.v1.UnsaveJobRequest
+	16, // 24: api.job.v1.JobPosting.ListSavedJobs:input_type -> api.job.v1.ListSavedJobsRequest
+	20, // 25: api.job.v1.Company.CreateCompany:input_type -> api.job.v1.CreateCompanyRequest
+	21, // 26: api.job.v1.Company.UpdateCompany:input_type -> api.job.v1.UpdateCompanyRequest
+	22, // 27: api.job.v1.Company.DeleteCompany:input_type -> api.job.v1.DeleteCompanyRequest
+	24, // 28: api.job.v1.Company.GetCompany:input_type -> api.job.v1.GetCompanyRequest
+	25, // 29: api.job.v1.Company.ListCompanies:input_type -> api.job.v1.ListCompaniesRequest
+	28, // 30: api.job.v1.Company.InviteCompanyMember:input_type -> api.job.v1.InviteCompanyMemberRequest
+	29, // 31: api.job.v1.Company.AcceptCompanyInvitation:input_type -> api.job.v1.AcceptCompanyInvitationRequest
+	30, // 32: api.job.v1.Company.RemoveCompanyMember:input_type -> api.job.v1.RemoveCompanyMemberRequest
+	32, // 33: api.job.v1.Company.ListCompanyMembers:input_type -> api.job.v1.ListCompanyMembersRequest
+	1,  // 34: api.job.v1.JobPosting.CreateJobPosting:output_type -> api.job.v1.JobPostingReply
+	1,  // 35: api.job.v1.JobPosting.UpdateJobPosting:output_type -> api.job.v1.JobPostingReply
+	6,  // 36: api.job.v1.JobPosting.DeleteJobPosting:output_type -> api.job.v1.DeleteJobPostingReply
+	1,  // 37: api.job.v1.JobPosting.GetJobPosting:output_type -> api.job.v1.JobPostingReply
+	10, // 38: api.job.v1.JobPosting.ListJobPostings:output_type -> api.job.v1.ListJobPostingsReply
+	1,  // 39: api.job.v1.JobPosting.PublishJobPosting:output_type -> api.job.v1.JobPostingReply
+	1,  // 40: api.job.v1.JobPosting.PauseJobPosting:output_type -> api.job.v1.JobPostingReply
+	1,  // 41: api.job.v1.JobPosting.CloseJobPosting:output_type -> api.job.v1.JobPostingReply
+	1,  // 42: api.job.v1.JobPosting.SaveJob:output_type -> api.job.v1.JobPostingReply
+	15, // 43: api.job.v1.JobPosting.UnsaveJob:output_type -> api.job.v1.UnsaveJobReply
+	18, // 44: api.job.v1.JobPosting.ListSavedJobs:output_type -> api.job.v1.ListSavedJobsReply
+	19, // 45: api.job.v1.Company.CreateCompany:output_type -> api.job.v1.CompanyReply
+	19, // 46: api.job.v1.Company.UpdateCompany:output_type -> api.job.v1.CompanyReply
+	23, // 47: api.job.v1.Company.DeleteCompany:output_type -> api.job.v1.DeleteCompanyReply
+	19, // 48: api.job.v1.Company.GetCompany:output_type -> api.job.v1.CompanyReply
+	26, // 49: api.job.v1.Company.ListCompanies:output_type -> api.job.v1.ListCompaniesReply
+	27, // 50: api.job.v1.Company.InviteCompanyMember:output_type -> api.job.v1.CompanyMemberReply
+	27, // 51: api.job.v1.Company.AcceptCompanyInvitation:output_type -> api.job.v1.CompanyMemberReply
+	31, // 52: api.job.v1.Company.RemoveCompanyMember:output_type -> api.job.v1.RemoveCompanyMemberReply
+	33, // 53: api.job.v1.Company.ListCompanyMembers:output_type -> api.job.v1.ListCompanyMembersReply
+	34, // [34:54] is the sub-list for method output_type
+	14, // [14:34] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_job_v1_job_proto_init() }
@@ -1751,7 +2941,7 @@ func file_job_v1_job_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_job_v1_job_proto_rawDesc), len(file_job_v1_job_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
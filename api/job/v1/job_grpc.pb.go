@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	JobPosting_CreateJobPosting_FullMethodName = "/api.job.v1.JobPosting/CreateJobPosting"
-	JobPosting_UpdateJobPosting_FullMethodName = "/api.job.v1.JobPosting/UpdateJobPosting"
-	JobPosting_DeleteJobPosting_FullMethodName = "/api.job.v1.JobPosting/DeleteJobPosting"
-	JobPosting_GetJobPosting_FullMethodName    = "/api.job.v1.JobPosting/GetJobPosting"
-	JobPosting_ListJobPostings_FullMethodName  = "/api.job.v1.JobPosting/ListJobPostings"
+	JobPosting_CreateJobPosting_FullMethodName  = "/api.job.v1.JobPosting/CreateJobPosting"
+	JobPosting_UpdateJobPosting_FullMethodName  = "/api.job.v1.JobPosting/UpdateJobPosting"
+	JobPosting_DeleteJobPosting_FullMethodName  = "/api.job.v1.JobPosting/DeleteJobPosting"
+	JobPosting_GetJobPosting_FullMethodName     = "/api.job.v1.JobPosting/GetJobPosting"
+	JobPosting_ListJobPostings_FullMethodName   = "/api.job.v1.JobPosting/ListJobPostings"
+	JobPosting_PublishJobPosting_FullMethodName = "/api.job.v1.JobPosting/PublishJobPosting"
+	JobPosting_PauseJobPosting_FullMethodName   = "/api.job.v1.JobPosting/PauseJobPosting"
+	JobPosting_CloseJobPosting_FullMethodName   = "/api.job.v1.JobPosting/CloseJobPosting"
+	JobPosting_SaveJob_FullMethodName           = "/api.job.v1.JobPosting/SaveJob"
+	JobPosting_UnsaveJob_FullMethodName         = "/api.job.v1.JobPosting/UnsaveJob"
+	JobPosting_ListSavedJobs_FullMethodName     = "/api.job.v1.JobPosting/ListSavedJobs"
 )
 
 // JobPostingClient is the client API for JobPosting service.
@@ -42,6 +48,18 @@ type JobPostingClient interface {
 	GetJobPosting(ctx context.Context, in *GetJobPostingRequest, opts ...grpc.CallOption) (*JobPostingReply, error)
 	// List all job postings with pagination and filters
 	ListJobPostings(ctx context.Context, in *ListJobPostingsRequest, opts ...grpc.CallOption) (*ListJobPostingsReply, error)
+	// Publish a draft, paused or expired job posting
+	PublishJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...grpc.CallOption) (*JobPostingReply, error)
+	// Pause a published job posting (hidden until published again)
+	PauseJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...grpc.CallOption) (*JobPostingReply, error)
+	// Close a job posting for good
+	CloseJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...grpc.CallOption) (*JobPostingReply, error)
+	// Save a published job to the current user's saved jobs
+	SaveJob(ctx context.Context, in *SaveJobRequest, opts ...grpc.CallOption) (*JobPostingReply, error)
+	// Remove a job from the current user's saved jobs
+	UnsaveJob(ctx context.Context, in *UnsaveJobRequest, opts ...grpc.CallOption) (*UnsaveJobReply, error)
+	// List the current user's saved jobs, including closed and expired jobs
+	ListSavedJobs(ctx context.Context, in *ListSavedJobsRequest, opts ...grpc.CallOption) (*ListSavedJobsReply, error)
 }
 
 type jobPostingClient struct {
@@ -102,6 +120,66 @@ func (c *jobPostingClient) ListJobPostings(ctx context.Context, in *ListJobPosti
 	return out, nil
 }
 
+func (c *jobPostingClient) PublishJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...grpc.CallOption) (*JobPostingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobPostingReply)
+	err := c.cc.Invoke(ctx, JobPosting_PublishJobPosting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobPostingClient) PauseJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...grpc.CallOption) (*JobPostingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobPostingReply)
+	err := c.cc.Invoke(ctx, JobPosting_PauseJobPosting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobPostingClient) CloseJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...grpc.CallOption) (*JobPostingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobPostingReply)
+	err := c.cc.Invoke(ctx, JobPosting_CloseJobPosting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobPostingClient) SaveJob(ctx context.Context, in *SaveJobRequest, opts ...grpc.CallOption) (*JobPostingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobPostingReply)
+	err := c.cc.Invoke(ctx, JobPosting_SaveJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobPostingClient) UnsaveJob(ctx context.Context, in *UnsaveJobRequest, opts ...grpc.CallOption) (*UnsaveJobReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsaveJobReply)
+	err := c.cc.Invoke(ctx, JobPosting_UnsaveJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobPostingClient) ListSavedJobs(ctx context.Context, in *ListSavedJobsRequest, opts ...grpc.CallOption) (*ListSavedJobsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedJobsReply)
+	err := c.cc.Invoke(ctx, JobPosting_ListSavedJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobPostingServer is the server API for JobPosting service.
 // All implementations must embed UnimplementedJobPostingServer
 // for forward compatibility.
@@ -118,6 +196,18 @@ type JobPostingServer interface {
 	GetJobPosting(context.Context, *GetJobPostingRequest) (*JobPostingReply, error)
 	// List all job postings with pagination and filters
 	ListJobPostings(context.Context, *ListJobPostingsRequest) (*ListJobPostingsReply, error)
+	// Publish a draft, paused or expired job posting
+	PublishJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error)
+	// Pause a published job posting (hidden until published again)
+	PauseJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error)
+	// Close a job posting for good
+	CloseJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error)
+	// Save a published job to the current user's saved jobs
+	SaveJob(context.Context, *SaveJobRequest) (*JobPostingReply, error)
+	// Remove a job from the current user's saved jobs
+	UnsaveJob(context.Context, *UnsaveJobRequest) (*UnsaveJobReply, error)
+	// List the current user's saved jobs, including closed and expired jobs
+	ListSavedJobs(context.Context, *ListSavedJobsRequest) (*ListSavedJobsReply, error)
 	mustEmbedUnimplementedJobPostingServer()
 }
 
@@ -143,6 +233,24 @@ func (UnimplementedJobPostingServer) GetJobPosting(context.Context, *GetJobPosti
 func (UnimplementedJobPostingServer) ListJobPostings(context.Context, *ListJobPostingsRequest) (*ListJobPostingsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJobPostings not implemented")
 }
+func (UnimplementedJobPostingServer) PublishJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishJobPosting not implemented")
+}
+func (UnimplementedJobPostingServer) PauseJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJobPosting not implemented")
+}
+func (UnimplementedJobPostingServer) CloseJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseJobPosting not implemented")
+}
+func (UnimplementedJobPostingServer) SaveJob(context.Context, *SaveJobRequest) (*JobPostingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveJob not implemented")
+}
+func (UnimplementedJobPostingServer) UnsaveJob(context.Context, *UnsaveJobRequest) (*UnsaveJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsaveJob not implemented")
+}
+func (UnimplementedJobPostingServer) ListSavedJobs(context.Context, *ListSavedJobsRequest) (*ListSavedJobsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedJobs not implemented")
+}
 func (UnimplementedJobPostingServer) mustEmbedUnimplementedJobPostingServer() {}
 func (UnimplementedJobPostingServer) testEmbeddedByValue()                    {}
 
@@ -254,6 +362,114 @@ func _JobPosting_ListJobPostings_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobPosting_PublishJobPosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeJobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobPostingServer).PublishJobPosting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobPosting_PublishJobPosting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobPostingServer).PublishJobPosting(ctx, req.(*ChangeJobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobPosting_PauseJobPosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeJobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobPostingServer).PauseJobPosting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobPosting_PauseJobPosting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobPostingServer).PauseJobPosting(ctx, req.(*ChangeJobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobPosting_CloseJobPosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeJobStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobPostingServer).CloseJobPosting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobPosting_CloseJobPosting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobPostingServer).CloseJobPosting(ctx, req.(*ChangeJobStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobPosting_SaveJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobPostingServer).SaveJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobPosting_SaveJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobPostingServer).SaveJob(ctx, req.(*SaveJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobPosting_UnsaveJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsaveJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobPostingServer).UnsaveJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobPosting_UnsaveJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobPostingServer).UnsaveJob(ctx, req.(*UnsaveJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobPosting_ListSavedJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobPostingServer).ListSavedJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobPosting_ListSavedJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobPostingServer).ListSavedJobs(ctx, req.(*ListSavedJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobPosting_ServiceDesc is the grpc.ServiceDesc for JobPosting service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,17 +497,45 @@ var JobPosting_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListJobPostings",
 			Handler:    _JobPosting_ListJobPostings_Handler,
 		},
+		{
+			MethodName: "PublishJobPosting",
+			Handler:    _JobPosting_PublishJobPosting_Handler,
+		},
+		{
+			MethodName: "PauseJobPosting",
+			Handler:    _JobPosting_PauseJobPosting_Handler,
+		},
+		{
+			MethodName: "CloseJobPosting",
+			Handler:    _JobPosting_CloseJobPosting_Handler,
+		},
+		{
+			MethodName: "SaveJob",
+			Handler:    _JobPosting_SaveJob_Handler,
+		},
+		{
+			MethodName: "UnsaveJob",
+			Handler:    _JobPosting_UnsaveJob_Handler,
+		},
+		{
+			MethodName: "ListSavedJobs",
+			Handler:    _JobPosting_ListSavedJobs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "job/v1/job.proto",
 }
 
 const (
-	Company_CreateCompany_FullMethodName = "/api.job.v1.Company/CreateCompany"
-	Company_UpdateCompany_FullMethodName = "/api.job.v1.Company/UpdateCompany"
-	Company_DeleteCompany_FullMethodName = "/api.job.v1.Company/DeleteCompany"
-	Company_GetCompany_FullMethodName    = "/api.job.v1.Company/GetCompany"
-	Company_ListCompanies_FullMethodName = "/api.job.v1.Company/ListCompanies"
+	Company_CreateCompany_FullMethodName           = "/api.job.v1.Company/CreateCompany"
+	Company_UpdateCompany_FullMethodName           = "/api.job.v1.Company/UpdateCompany"
+	Company_DeleteCompany_FullMethodName           = "/api.job.v1.Company/DeleteCompany"
+	Company_GetCompany_FullMethodName              = "/api.job.v1.Company/GetCompany"
+	Company_ListCompanies_FullMethodName           = "/api.job.v1.Company/ListCompanies"
+	Company_InviteCompanyMember_FullMethodName     = "/api.job.v1.Company/InviteCompanyMember"
+	Company_AcceptCompanyInvitation_FullMethodName = "/api.job.v1.Company/AcceptCompanyInvitation"
+	Company_RemoveCompanyMember_FullMethodName     = "/api.job.v1.Company/RemoveCompanyMember"
+	Company_ListCompanyMembers_FullMethodName      = "/api.job.v1.Company/ListCompanyMembers"
 )
 
 // CompanyClient is the client API for Company service.
@@ -310,6 +554,14 @@ type CompanyClient interface {
 	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*CompanyReply, error)
 	// List all companies with pagination
 	ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesReply, error)
+	// Invite a registered user to join the company (owner only)
+	InviteCompanyMember(ctx context.Context, in *InviteCompanyMemberRequest, opts ...grpc.CallOption) (*CompanyMemberReply, error)
+	// Accept a pending invitation of the current user
+	AcceptCompanyInvitation(ctx context.Context, in *AcceptCompanyInvitationRequest, opts ...grpc.CallOption) (*CompanyMemberReply, error)
+	// Remove a member or cancel an invitation (owner only, or the member leaving)
+	RemoveCompanyMember(ctx context.Context, in *RemoveCompanyMemberRequest, opts ...grpc.CallOption) (*RemoveCompanyMemberReply, error)
+	// List members and pending invitations (members only)
+	ListCompanyMembers(ctx context.Context, in *ListCompanyMembersRequest, opts ...grpc.CallOption) (*ListCompanyMembersReply, error)
 }
 
 type companyClient struct {
@@ -370,6 +622,46 @@ func (c *companyClient) ListCompanies(ctx context.Context, in *ListCompaniesRequ
 	return out, nil
 }
 
+func (c *companyClient) InviteCompanyMember(ctx context.Context, in *InviteCompanyMemberRequest, opts ...grpc.CallOption) (*CompanyMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyMemberReply)
+	err := c.cc.Invoke(ctx, Company_InviteCompanyMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyClient) AcceptCompanyInvitation(ctx context.Context, in *AcceptCompanyInvitationRequest, opts ...grpc.CallOption) (*CompanyMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyMemberReply)
+	err := c.cc.Invoke(ctx, Company_AcceptCompanyInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyClient) RemoveCompanyMember(ctx context.Context, in *RemoveCompanyMemberRequest, opts ...grpc.CallOption) (*RemoveCompanyMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCompanyMemberReply)
+	err := c.cc.Invoke(ctx, Company_RemoveCompanyMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyClient) ListCompanyMembers(ctx context.Context, in *ListCompanyMembersRequest, opts ...grpc.CallOption) (*ListCompanyMembersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompanyMembersReply)
+	err := c.cc.Invoke(ctx, Company_ListCompanyMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CompanyServer is the server API for Company service.
 // All implementations must embed UnimplementedCompanyServer
 // for forward compatibility.
@@ -386,6 +678,14 @@ type CompanyServer interface {
 	GetCompany(context.Context, *GetCompanyRequest) (*CompanyReply, error)
 	// List all companies with pagination
 	ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesReply, error)
+	// Invite a registered user to join the company (owner only)
+	InviteCompanyMember(context.Context, *InviteCompanyMemberRequest) (*CompanyMemberReply, error)
+	// Accept a pending invitation of the current user
+	AcceptCompanyInvitation(context.Context, *AcceptCompanyInvitationRequest) (*CompanyMemberReply, error)
+	// Remove a member or cancel an invitation (owner only, or the member leaving)
+	RemoveCompanyMember(context.Context, *RemoveCompanyMemberRequest) (*RemoveCompanyMemberReply, error)
+	// List members and pending invitations (members only)
+	ListCompanyMembers(context.Context, *ListCompanyMembersRequest) (*ListCompanyMembersReply, error)
 	mustEmbedUnimplementedCompanyServer()
 }
 
@@ -411,6 +711,18 @@ func (UnimplementedCompanyServer) GetCompany(context.Context, *GetCompanyRequest
 func (UnimplementedCompanyServer) ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCompanies not implemented")
 }
+func (UnimplementedCompanyServer) InviteCompanyMember(context.Context, *InviteCompanyMemberRequest) (*CompanyMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCompanyMember not implemented")
+}
+func (UnimplementedCompanyServer) AcceptCompanyInvitation(context.Context, *AcceptCompanyInvitationRequest) (*CompanyMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCompanyInvitation not implemented")
+}
+func (UnimplementedCompanyServer) RemoveCompanyMember(context.Context, *RemoveCompanyMemberRequest) (*RemoveCompanyMemberReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompanyMember not implemented")
+}
+func (UnimplementedCompanyServer) ListCompanyMembers(context.Context, *ListCompanyMembersRequest) (*ListCompanyMembersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompanyMembers not implemented")
+}
 func (UnimplementedCompanyServer) mustEmbedUnimplementedCompanyServer() {}
 func (UnimplementedCompanyServer) testEmbeddedByValue()                 {}
 
@@ -522,6 +834,78 @@ func _Company_ListCompanies_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Company_InviteCompanyMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCompanyMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServer).InviteCompanyMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Company_InviteCompanyMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServer).InviteCompanyMember(ctx, req.(*InviteCompanyMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Company_AcceptCompanyInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCompanyInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServer).AcceptCompanyInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Company_AcceptCompanyInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServer).AcceptCompanyInvitation(ctx, req.(*AcceptCompanyInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Company_RemoveCompanyMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCompanyMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServer).RemoveCompanyMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Company_RemoveCompanyMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServer).RemoveCompanyMember(ctx, req.(*RemoveCompanyMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Company_ListCompanyMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompanyMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServer).ListCompanyMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Company_ListCompanyMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServer).ListCompanyMembers(ctx, req.(*ListCompanyMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Company_ServiceDesc is the grpc.ServiceDesc for Company service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -549,6 +933,22 @@ var Company_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCompanies",
 			Handler:    _Company_ListCompanies_Handler,
 		},
+		{
+			MethodName: "InviteCompanyMember",
+			Handler:    _Company_InviteCompanyMember_Handler,
+		},
+		{
+			MethodName: "AcceptCompanyInvitation",
+			Handler:    _Company_AcceptCompanyInvitation_Handler,
+		},
+		{
+			MethodName: "RemoveCompanyMember",
+			Handler:    _Company_RemoveCompanyMember_Handler,
+		},
+		{
+			MethodName: "ListCompanyMembers",
+			Handler:    _Company_ListCompanyMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "job/v1/job.proto",
@@ -19,13 +19,21 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationJobPostingCloseJobPosting = "/api.job.v1.JobPosting/CloseJobPosting"
 const OperationJobPostingCreateJobPosting = "/api.job.v1.JobPosting/CreateJobPosting"
 const OperationJobPostingDeleteJobPosting = "/api.job.v1.JobPosting/DeleteJobPosting"
 const OperationJobPostingGetJobPosting = "/api.job.v1.JobPosting/GetJobPosting"
 const OperationJobPostingListJobPostings = "/api.job.v1.JobPosting/ListJobPostings"
+const OperationJobPostingListSavedJobs = "/api.job.v1.JobPosting/ListSavedJobs"
+const OperationJobPostingPauseJobPosting = "/api.job.v1.JobPosting/PauseJobPosting"
+const OperationJobPostingPublishJobPosting = "/api.job.v1.JobPosting/PublishJobPosting"
+const OperationJobPostingSaveJob = "/api.job.v1.JobPosting/SaveJob"
+const OperationJobPostingUnsaveJob = "/api.job.v1.JobPosting/UnsaveJob"
 const OperationJobPostingUpdateJobPosting = "/api.job.v1.JobPosting/UpdateJobPosting"
 
 type JobPostingHTTPServer interface {
+	// CloseJobPosting Close a job posting for good
+	CloseJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error)
 	// CreateJobPosting Create a new job posting
 	CreateJobPosting(context.Context, *CreateJobPostingRequest) (*JobPostingReply, error)
 	// DeleteJobPosting Delete a job posting
@@ -34,6 +42,16 @@ type JobPostingHTTPServer interface {
 	GetJobPosting(context.Context, *GetJobPostingRequest) (*JobPostingReply, error)
 	// ListJobPostings List all job postings with pagination and filters
 	ListJobPostings(context.Context, *ListJobPostingsRequest) (*ListJobPostingsReply, error)
+	// ListSavedJobs List the current user's saved jobs, including closed and expired jobs
+	ListSavedJobs(context.Context, *ListSavedJobsRequest) (*ListSavedJobsReply, error)
+	// PauseJobPosting Pause a published job posting (hidden until published again)
+	PauseJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error)
+	// PublishJobPosting Publish a draft, paused or expired job posting
+	PublishJobPosting(context.Context, *ChangeJobStatusRequest) (*JobPostingReply, error)
+	// SaveJob Save a published job to the current user's saved jobs
+	SaveJob(context.Context, *SaveJobRequest) (*JobPostingReply, error)
+	// UnsaveJob Remove a job from the current user's saved jobs
+	UnsaveJob(context.Context, *UnsaveJobRequest) (*UnsaveJobReply, error)
 	// UpdateJobPosting Update an existing job posting
 	UpdateJobPosting(context.Context, *UpdateJobPostingRequest) (*JobPostingReply, error)
 }
@@ -45,6 +63,12 @@ func RegisterJobPostingHTTPServer(s *http.Server, srv JobPostingHTTPServer) {
 	r.DELETE("/api/v1/jobs/{id}", _JobPosting_DeleteJobPosting0_HTTP_Handler(srv))
 	r.GET("/api/v1/jobs/{id}", _JobPosting_GetJobPosting0_HTTP_Handler(srv))
 	r.GET("/api/v1/jobs", _JobPosting_ListJobPostings0_HTTP_Handler(srv))
+	r.POST("/api/v1/jobs/{id}/publish", _JobPosting_PublishJobPosting0_HTTP_Handler(srv))
+	r.POST("/api/v1/jobs/{id}/pause", _JobPosting_PauseJobPosting0_HTTP_Handler(srv))
+	r.POST("/api/v1/jobs/{id}/close", _JobPosting_CloseJobPosting0_HTTP_Handler(srv))
+	r.POST("/api/v1/jobs/{id}/save", _JobPosting_SaveJob0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/jobs/{id}/save", _JobPosting_UnsaveJob0_HTTP_Handler(srv))
+	r.GET("/api/v1/saved-jobs", _JobPosting_ListSavedJobs0_HTTP_Handler(srv))
 }
 
 func _JobPosting_CreateJobPosting0_HTTP_Handler(srv JobPostingHTTPServer) func(ctx http.Context) error {
@@ -157,7 +181,150 @@ func _JobPosting_ListJobPostings0_HTTP_Handler(srv JobPostingHTTPServer) func(ct
 	}
 }
 
+func _JobPosting_PublishJobPosting0_HTTP_Handler(srv JobPostingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeJobStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationJobPostingPublishJobPosting)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishJobPosting(ctx, req.(*ChangeJobStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*JobPostingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _JobPosting_PauseJobPosting0_HTTP_Handler(srv JobPostingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeJobStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationJobPostingPauseJobPosting)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PauseJobPosting(ctx, req.(*ChangeJobStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*JobPostingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _JobPosting_CloseJobPosting0_HTTP_Handler(srv JobPostingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeJobStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationJobPostingCloseJobPosting)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CloseJobPosting(ctx, req.(*ChangeJobStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*JobPostingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _JobPosting_SaveJob0_HTTP_Handler(srv JobPostingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SaveJobRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationJobPostingSaveJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SaveJob(ctx, req.(*SaveJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*JobPostingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _JobPosting_UnsaveJob0_HTTP_Handler(srv JobPostingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnsaveJobRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationJobPostingUnsaveJob)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnsaveJob(ctx, req.(*UnsaveJobRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnsaveJobReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _JobPosting_ListSavedJobs0_HTTP_Handler(srv JobPostingHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSavedJobsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationJobPostingListSavedJobs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSavedJobs(ctx, req.(*ListSavedJobsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSavedJobsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type JobPostingHTTPClient interface {
+	// CloseJobPosting Close a job posting for good
+	CloseJobPosting(ctx context.Context, req *ChangeJobStatusRequest, opts ...http.CallOption) (rsp *JobPostingReply, err error)
 	// CreateJobPosting Create a new job posting
 	CreateJobPosting(ctx context.Context, req *CreateJobPostingRequest, opts ...http.CallOption) (rsp *JobPostingReply, err error)
 	// DeleteJobPosting Delete a job posting
@@ -166,6 +333,16 @@ type JobPostingHTTPClient interface {
 	GetJobPosting(ctx context.Context, req *GetJobPostingRequest, opts ...http.CallOption) (rsp *JobPostingReply, err error)
 	// ListJobPostings List all job postings with pagination and filters
 	ListJobPostings(ctx context.Context, req *ListJobPostingsRequest, opts ...http.CallOption) (rsp *ListJobPostingsReply, err error)
+	// ListSavedJobs List the current user's saved jobs, including closed and expired jobs
+	ListSavedJobs(ctx context.Context, req *ListSavedJobsRequest, opts ...http.CallOption) (rsp *ListSavedJobsReply, err error)
+	// PauseJobPosting Pause a published job posting (hidden until published again)
+	PauseJobPosting(ctx context.Context, req *ChangeJobStatusRequest, opts ...http.CallOption) (rsp *JobPostingReply, err error)
+	// PublishJobPosting Publish a draft, paused or expired job posting
+	PublishJobPosting(ctx context.Context, req *ChangeJobStatusRequest, opts ...http.CallOption) (rsp *JobPostingReply, err error)
+	// SaveJob Save a published job to the current user's saved jobs
+	SaveJob(ctx context.Context, req *SaveJobRequest, opts ...http.CallOption) (rsp *JobPostingReply, err error)
+	// UnsaveJob Remove a job from the current user's saved jobs
+	UnsaveJob(ctx context.Context, req *UnsaveJobRequest, opts ...http.CallOption) (rsp *UnsaveJobReply, err error)
 	// UpdateJobPosting Update an existing job posting
 	UpdateJobPosting(ctx context.Context, req *UpdateJobPostingRequest, opts ...http.CallOption) (rsp *JobPostingReply, err error)
 }
@@ -178,6 +355,20 @@ func NewJobPostingHTTPClient(client *http.Client) JobPostingHTTPClient {
 	return &JobPostingHTTPClientImpl{client}
 }
 
+// CloseJobPosting Close a job posting for good
+func (c *JobPostingHTTPClientImpl) CloseJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...http.CallOption) (*JobPostingReply, error) {
+	var out JobPostingReply
+	pattern := "/api/v1/jobs/{id}/close"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationJobPostingCloseJobPosting))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateJobPosting Create a new job posting
 func (c *JobPostingHTTPClientImpl) CreateJobPosting(ctx context.Context, in *CreateJobPostingRequest, opts ...http.CallOption) (*JobPostingReply, error) {
 	var out JobPostingReply
@@ -234,6 +425,76 @@ func (c *JobPostingHTTPClientImpl) ListJobPostings(ctx context.Context, in *List
 	return &out, nil
 }
 
+// ListSavedJobs List the current user's saved jobs, including closed and expired jobs
+func (c *JobPostingHTTPClientImpl) ListSavedJobs(ctx context.Context, in *ListSavedJobsRequest, opts ...http.CallOption) (*ListSavedJobsReply, error) {
+	var out ListSavedJobsReply
+	pattern := "/api/v1/saved-jobs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationJobPostingListSavedJobs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseJobPosting Pause a published job posting (hidden until published again)
+func (c *JobPostingHTTPClientImpl) PauseJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...http.CallOption) (*JobPostingReply, error) {
+	var out JobPostingReply
+	pattern := "/api/v1/jobs/{id}/pause"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationJobPostingPauseJobPosting))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PublishJobPosting Publish a draft, paused or expired job posting
+func (c *JobPostingHTTPClientImpl) PublishJobPosting(ctx context.Context, in *ChangeJobStatusRequest, opts ...http.CallOption) (*JobPostingReply, error) {
+	var out JobPostingReply
+	pattern := "/api/v1/jobs/{id}/publish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationJobPostingPublishJobPosting))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SaveJob Save a published job to the current user's saved jobs
+func (c *JobPostingHTTPClientImpl) SaveJob(ctx context.Context, in *SaveJobRequest, opts ...http.CallOption) (*JobPostingReply, error) {
+	var out JobPostingReply
+	pattern := "/api/v1/jobs/{id}/save"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationJobPostingSaveJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnsaveJob Remove a job from the current user's saved jobs
+func (c *JobPostingHTTPClientImpl) UnsaveJob(ctx context.Context, in *UnsaveJobRequest, opts ...http.CallOption) (*UnsaveJobReply, error) {
+	var out UnsaveJobReply
+	pattern := "/api/v1/jobs/{id}/save"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationJobPostingUnsaveJob))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateJobPosting Update an existing job posting
 func (c *JobPostingHTTPClientImpl) UpdateJobPosting(ctx context.Context, in *UpdateJobPostingRequest, opts ...http.CallOption) (*JobPostingReply, error) {
 	var out JobPostingReply
@@ -248,21 +509,33 @@ func (c *JobPostingHTTPClientImpl) UpdateJobPosting(ctx context.Context, in *Upd
 	return &out, nil
 }
 
+const OperationCompanyAcceptCompanyInvitation = "/api.job.v1.Company/AcceptCompanyInvitation"
 const OperationCompanyCreateCompany = "/api.job.v1.Company/CreateCompany"
 const OperationCompanyDeleteCompany = "/api.job.v1.Company/DeleteCompany"
 const OperationCompanyGetCompany = "/api.job.v1.Company/GetCompany"
+const OperationCompanyInviteCompanyMember = "/api.job.v1.Company/InviteCompanyMember"
 const OperationCompanyListCompanies = "/api.job.v1.Company/ListCompanies"
+const OperationCompanyListCompanyMembers = "/api.job.v1.Company/ListCompanyMembers"
+const OperationCompanyRemoveCompanyMember = "/api.job.v1.Company/RemoveCompanyMember"
 const OperationCompanyUpdateCompany = "/api.job.v1.Company/UpdateCompany"
 
 type CompanyHTTPServer interface {
+	// AcceptCompanyInvitation Accept a pending invitation of the current user
+	AcceptCompanyInvitation(context.Context, *AcceptCompanyInvitationRequest) (*CompanyMemberReply, error)
 	// CreateCompany Create a new company
 	CreateCompany(context.Context, *CreateCompanyRequest) (*CompanyReply, error)
 	// DeleteCompany Delete a company
 	DeleteCompany(context.Context, *DeleteCompanyRequest) (*DeleteCompanyReply, error)
 	// GetCompany Get a single company by ID
 	GetCompany(context.Context, *GetCompanyRequest) (*CompanyReply, error)
+	// InviteCompanyMember Invite a registered user to join the company (owner only)
+	InviteCompanyMember(context.Context, *InviteCompanyMemberRequest) (*CompanyMemberReply, error)
 	// ListCompanies List all companies with pagination
 	ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesReply, error)
+	// ListCompanyMembers List members and pending invitations (members only)
+	ListCompanyMembers(context.Context, *ListCompanyMembersRequest) (*ListCompanyMembersReply, error)
+	// RemoveCompanyMember Remove a member or cancel an invitation (owner only, or the member leaving)
+	RemoveCompanyMember(context.Context, *RemoveCompanyMemberRequest) (*RemoveCompanyMemberReply, error)
 	// UpdateCompany Update an existing company
 	UpdateCompany(context.Context, *UpdateCompanyRequest) (*CompanyReply, error)
 }
@@ -274,6 +547,10 @@ func RegisterCompanyHTTPServer(s *http.Server, srv CompanyHTTPServer) {
 	r.DELETE("/api/v1/companies/{id}", _Company_DeleteCompany0_HTTP_Handler(srv))
 	r.GET("/api/v1/companies/{id}", _Company_GetCompany0_HTTP_Handler(srv))
 	r.GET("/api/v1/companies", _Company_ListCompanies0_HTTP_Handler(srv))
+	r.POST("/api/v1/companies/{company_id}/members", _Company_InviteCompanyMember0_HTTP_Handler(srv))
+	r.POST("/api/v1/companies/{company_id}/members/accept", _Company_AcceptCompanyInvitation0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/companies/{company_id}/members/{user_id}", _Company_RemoveCompanyMember0_HTTP_Handler(srv))
+	r.GET("/api/v1/companies/{company_id}/members", _Company_ListCompanyMembers0_HTTP_Handler(srv))
 }
 
 func _Company_CreateCompany0_HTTP_Handler(srv CompanyHTTPServer) func(ctx http.Context) error {
@@ -386,15 +663,117 @@ func _Company_ListCompanies0_HTTP_Handler(srv CompanyHTTPServer) func(ctx http.C
 	}
 }
 
+func _Company_InviteCompanyMember0_HTTP_Handler(srv CompanyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InviteCompanyMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCompanyInviteCompanyMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InviteCompanyMember(ctx, req.(*InviteCompanyMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompanyMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Company_AcceptCompanyInvitation0_HTTP_Handler(srv CompanyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptCompanyInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCompanyAcceptCompanyInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptCompanyInvitation(ctx, req.(*AcceptCompanyInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompanyMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Company_RemoveCompanyMember0_HTTP_Handler(srv CompanyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveCompanyMemberRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCompanyRemoveCompanyMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveCompanyMember(ctx, req.(*RemoveCompanyMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveCompanyMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Company_ListCompanyMembers0_HTTP_Handler(srv CompanyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCompanyMembersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCompanyListCompanyMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCompanyMembers(ctx, req.(*ListCompanyMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCompanyMembersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type CompanyHTTPClient interface {
+	// AcceptCompanyInvitation Accept a pending invitation of the current user
+	AcceptCompanyInvitation(ctx context.Context, req *AcceptCompanyInvitationRequest, opts ...http.CallOption) (rsp *CompanyMemberReply, err error)
 	// CreateCompany Create a new company
 	CreateCompany(ctx context.Context, req *CreateCompanyRequest, opts ...http.CallOption) (rsp *CompanyReply, err error)
 	// DeleteCompany Delete a company
 	DeleteCompany(ctx context.Context, req *DeleteCompanyRequest, opts ...http.CallOption) (rsp *DeleteCompanyReply, err error)
 	// GetCompany Get a single company by ID
 	GetCompany(ctx context.Context, req *GetCompanyRequest, opts ...http.CallOption) (rsp *CompanyReply, err error)
+	// InviteCompanyMember Invite a registered user to join the company (owner only)
+	InviteCompanyMember(ctx context.Context, req *InviteCompanyMemberRequest, opts ...http.CallOption) (rsp *CompanyMemberReply, err error)
 	// ListCompanies List all companies with pagination
 	ListCompanies(ctx context.Context, req *ListCompaniesRequest, opts ...http.CallOption) (rsp *ListCompaniesReply, err error)
+	// ListCompanyMembers List members and pending invitations (members only)
+	ListCompanyMembers(ctx context.Context, req *ListCompanyMembersRequest, opts ...http.CallOption) (rsp *ListCompanyMembersReply, err error)
+	// RemoveCompanyMember Remove a member or cancel an invitation (owner only, or the member leaving)
+	RemoveCompanyMember(ctx context.Context, req *RemoveCompanyMemberRequest, opts ...http.CallOption) (rsp *RemoveCompanyMemberReply, err error)
 	// UpdateCompany Update an existing company
 	UpdateCompany(ctx context.Context, req *UpdateCompanyRequest, opts ...http.CallOption) (rsp *CompanyReply, err error)
 }
@@ -407,6 +786,20 @@ func NewCompanyHTTPClient(client *http.Client) CompanyHTTPClient {
 	return &CompanyHTTPClientImpl{client}
 }
 
+// AcceptCompanyInvitation Accept a pending invitation of the current user
+func (c *CompanyHTTPClientImpl) AcceptCompanyInvitation(ctx context.Context, in *AcceptCompanyInvitationRequest, opts ...http.CallOption) (*CompanyMemberReply, error) {
+	var out CompanyMemberReply
+	pattern := "/api/v1/companies/{company_id}/members/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCompanyAcceptCompanyInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateCompany Create a new company
 func (c *CompanyHTTPClientImpl) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...http.CallOption) (*CompanyReply, error) {
 	var out CompanyReply
@@ -449,6 +842,20 @@ func (c *CompanyHTTPClientImpl) GetCompany(ctx context.Context, in *GetCompanyRe
 	return &out, nil
 }
 
+// InviteCompanyMember Invite a registered user to join the company (owner only)
+func (c *CompanyHTTPClientImpl) InviteCompanyMember(ctx context.Context, in *InviteCompanyMemberRequest, opts ...http.CallOption) (*CompanyMemberReply, error) {
+	var out CompanyMemberReply
+	pattern := "/api/v1/companies/{company_id}/members"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCompanyInviteCompanyMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListCompanies List all companies with pagination
 func (c *CompanyHTTPClientImpl) ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...http.CallOption) (*ListCompaniesReply, error) {
 	var out ListCompaniesReply
@@ -463,6 +870,34 @@ func (c *CompanyHTTPClientImpl) ListCompanies(ctx context.Context, in *ListCompa
 	return &out, nil
 }
 
+// ListCompanyMembers List members and pending invitations (members only)
+func (c *CompanyHTTPClientImpl) ListCompanyMembers(ctx context.Context, in *ListCompanyMembersRequest, opts ...http.CallOption) (*ListCompanyMembersReply, error) {
+	var out ListCompanyMembersReply
+	pattern := "/api/v1/companies/{company_id}/members"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCompanyListCompanyMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveCompanyMember Remove a member or cancel an invitation (owner only, or the member leaving)
+func (c *CompanyHTTPClientImpl) RemoveCompanyMember(ctx context.Context, in *RemoveCompanyMemberRequest, opts ...http.CallOption) (*RemoveCompanyMemberReply, error) {
+	var out RemoveCompanyMemberReply
+	pattern := "/api/v1/companies/{company_id}/members/{user_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCompanyRemoveCompanyMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateCompany Update an existing company
 func (c *CompanyHTTPClientImpl) UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...http.CallOption) (*CompanyReply, error) {
 	var out CompanyReply
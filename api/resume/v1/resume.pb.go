@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "JobblyBE/api/policy/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -704,7 +705,7 @@ var File_resume_v1_resume_proto protoreflect.FileDescriptor
 
 const file_resume_v1_resume_proto_rawDesc = "" +
 	"\n" +
-	"\x16resume/v1/resume.proto\x12\rapi.resume.v1\x1a\x1cgoogle/api/annotations.proto\"\xb1\x01\n" +
+	"\x16resume/v1/resume.proto\x12\rapi.resume.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x16policy/v1/policy.proto\"\xb1\x01\n" +
 	"\vResumeReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12@\n" +
@@ -754,13 +755,13 @@ const file_resume_v1_resume_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
 	"\x11DeleteResumeReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\xad\x04\n" +
-	"\x06Resume\x12j\n" +
-	"\fCreateResume\x12\".api.resume.v1.CreateResumeRequest\x1a\x1a.api.resume.v1.ResumeReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/resumes\x12o\n" +
-	"\fUpdateResume\x12\".api.resume.v1.UpdateResumeRequest\x1a\x1a.api.resume.v1.ResumeReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/v1/resumes/{id}\x12f\n" +
-	"\tGetResume\x12\x1f.api.resume.v1.GetResumeRequest\x1a\x1a.api.resume.v1.ResumeReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/resumes/{id}\x12j\n" +
-	"\vListResumes\x12!.api.resume.v1.ListResumesRequest\x1a\x1f.api.resume.v1.ListResumesReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/resumes\x12r\n" +
-	"\fDeleteResume\x12\".api.resume.v1.DeleteResumeRequest\x1a .api.resume.v1.DeleteResumeReply\"\x1c\x82\xd3\xe4\x93\x02\x16*\x14/api/v1/resumes/{id}B,\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\x96\x05\n" +
+	"\x06Resume\x12\x7f\n" +
+	"\fCreateResume\x12\".api.resume.v1.CreateResumeRequest\x1a\x1a.api.resume.v1.ResumeReply\"/\xa2\xbb\x18\x11\b\x02\x1a\rresumes:write\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/resumes\x12\x84\x01\n" +
+	"\fUpdateResume\x12\".api.resume.v1.UpdateResumeRequest\x1a\x1a.api.resume.v1.ResumeReply\"4\xa2\xbb\x18\x11\b\x02\x1a\rresumes:write\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/api/v1/resumes/{id}\x12z\n" +
+	"\tGetResume\x12\x1f.api.resume.v1.GetResumeRequest\x1a\x1a.api.resume.v1.ResumeReply\"0\xa2\xbb\x18\x10\b\x02\x1a\fresumes:read\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/resumes/{id}\x12~\n" +
+	"\vListResumes\x12!.api.resume.v1.ListResumesRequest\x1a\x1f.api.resume.v1.ListResumesReply\"+\xa2\xbb\x18\x10\b\x02\x1a\fresumes:read\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/resumes\x12\x87\x01\n" +
+	"\fDeleteResume\x12\".api.resume.v1.DeleteResumeRequest\x1a .api.resume.v1.DeleteResumeReply\"1\xa2\xbb\x18\x11\b\x02\x1a\rresumes:write\x82\xd3\xe4\x93\x02\x16*\x14/api/v1/resumes/{id}B,\n" +
 	"\rapi.resume.v1P\x01Z\x19JobblyBE/api/resume/v1;v1b\x06proto3"
 
 var (
@@ -25,7 +25,5 @@ func wireApp(*conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error)
 		biz.ProviderSet,
 		service.ProviderSet,
 		newApp,
-		// Extract JwtSecret from conf.Server
-		wire.FieldsOf(new(*conf.Server), "JwtSecret"),
 	))
 }
@@ -29,23 +29,89 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 		return nil, nil, err
 	}
 	userRepo := data.NewUserRepo(dataData, logger)
-	authUseCase := biz.NewAuthUsecase(userRepo, logger)
-	string2 := confServer.JwtSecret
-	authService := service.NewAuthService(authUseCase, string2, logger)
-	grpcServer := server.NewGRPCServer(confServer, authService, logger)
+	loginAttemptRepo := data.NewLoginAttemptRepo(dataData, logger)
+	loginThrottle := biz.NewLoginThrottle(loginAttemptRepo, confServer, logger)
+	auditRecorder := data.NewAuditRecorder(dataData, logger)
+	authUseCase := biz.NewAuthUsecase(userRepo, loginThrottle, auditRecorder, logger)
+	sessionRepo := data.NewSessionRepo(dataData, logger)
+	securityEventRepo := data.NewSecurityEventRepo(dataData, logger)
+	revocationStore := data.NewTokenRevocationRepo(dataData, logger)
+	sessionUseCase := biz.NewSessionUseCase(sessionRepo, securityEventRepo, revocationStore, logger)
+	mailer := data.NewMailer(confServer, logger)
+	keySet, err := data.NewTokenKeySet(confServer, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	emailVerificationUseCase := biz.NewEmailVerificationUseCase(userRepo, mailer, keySet, confServer, logger)
+	passwordResetRepo := data.NewPasswordResetRepo(dataData, logger)
+	passwordResetUseCase := biz.NewPasswordResetUseCase(authUseCase, userRepo, passwordResetRepo, sessionUseCase, loginThrottle, mailer, confServer, logger)
+	totpRepo := data.NewTOTPRepo(dataData, logger)
+	totpUseCase := biz.NewTOTPUseCase(totpRepo, userRepo, loginThrottle, revocationStore, keySet, logger)
+	oAuthStateRepo := data.NewOAuthStateRepo(dataData, logger)
+	userIdentityRepo := data.NewUserIdentityRepo(dataData, logger)
+	oAuthUseCase, err := biz.NewOAuthUseCase(confServer, oAuthStateRepo, userIdentityRepo, userRepo, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	apiKeyRepo := data.NewAPIKeyRepo(dataData, logger)
+	apiKeyUseCase := biz.NewAPIKeyUseCase(apiKeyRepo, userRepo, logger)
+	personalDataStores := data.NewPersonalDataStores(dataData, logger)
+	accountDataUseCase := biz.NewAccountDataUseCase(userRepo, personalDataStores, sessionUseCase, auditRecorder, logger)
+	authService := service.NewAuthService(authUseCase, sessionUseCase, emailVerificationUseCase, passwordResetUseCase, totpUseCase, oAuthUseCase, apiKeyUseCase, accountDataUseCase, revocationStore, keySet, logger)
+	apiKeyValidator := biz.NewAPIKeyValidator(apiKeyUseCase)
+	policyTable, err := server.NewPolicyTable()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer, err := server.NewGRPCServer(confServer, authService, keySet, revocationStore, apiKeyValidator, policyTable, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	jobPostingRepo := data.NewJobPostingRepo(dataData, logger)
 	companyRepo := data.NewCompanyRepo(dataData, logger)
-	jobPostingUseCase := biz.NewJobPostingUseCase(jobPostingRepo, companyRepo, logger)
+	companyMemberRepo := data.NewCompanyMemberRepo(dataData, logger)
+	jobPostingUseCase := biz.NewJobPostingUseCase(jobPostingRepo, companyRepo, companyMemberRepo, auditRecorder, logger)
 	userTrackingRepo := data.NewUserTrackingRepo(dataData, logger)
 	userTrackingUseCase := biz.NewUserTrackingUseCase(userTrackingRepo, logger)
-	jobPostingService := service.NewJobPostingService(jobPostingUseCase, userTrackingUseCase)
-	companyUseCase := biz.NewCompanyUseCase(companyRepo, logger)
+	savedJobRepo := data.NewSavedJobRepo(dataData, logger)
+	savedJobUseCase := biz.NewSavedJobUseCase(savedJobRepo, jobPostingRepo, logger)
+	jobPostingService := service.NewJobPostingService(jobPostingUseCase, userTrackingUseCase, savedJobUseCase)
+	companyUseCase := biz.NewCompanyUseCase(companyRepo, companyMemberRepo, userRepo, auditRecorder, logger)
 	companyService := service.NewCompanyService(companyUseCase)
 	resumeRepo := data.NewResumeRepo(dataData, logger)
-	resumeUseCase := biz.NewResumeUseCase(resumeRepo, logger)
+	resumeUseCase := biz.NewResumeUseCase(resumeRepo, userRepo, auditRecorder, logger)
 	resumeService := service.NewResumeService(resumeUseCase)
-	httpServer := server.NewHTTPServer(confServer, confData, authService, jobPostingService, companyService, resumeService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	applicationRepo := data.NewApplicationRepo(dataData, logger)
+	pipelineRepo := data.NewPipelineRepo(dataData, logger)
+	applicationUseCase := biz.NewApplicationUseCase(applicationRepo, jobPostingRepo, companyRepo, resumeRepo, companyMemberRepo, pipelineRepo, auditRecorder, logger)
+	applicationService := service.NewApplicationService(applicationUseCase)
+	hiringPipelineService := service.NewHiringPipelineService(applicationUseCase)
+	savedSearchRepo := data.NewSavedSearchRepo(dataData, logger)
+	alertMatchRepo := data.NewAlertMatchRepo(dataData, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	alertNotifiers := biz.NewAlertNotifiers(mailer, notificationRepo)
+	jobAlertUseCase := biz.NewJobAlertUseCase(savedSearchRepo, alertMatchRepo, jobPostingRepo, userRepo, alertNotifiers, confServer, logger)
+	jobAlertService := service.NewJobAlertService(jobAlertUseCase)
+	notificationUseCase := biz.NewNotificationUseCase(notificationRepo, logger)
+	notificationService := service.NewNotificationService(notificationUseCase)
+	userAdminUseCase := biz.NewUserAdminUseCase(userRepo, sessionUseCase, accountDataUseCase, auditRecorder, keySet, logger)
+	userAdminService := service.NewUserAdminService(userAdminUseCase, logger)
+	auditLogRepo := data.NewAuditLogRepo(dataData, logger)
+	auditLogUseCase := biz.NewAuditLogUseCase(auditLogRepo, logger)
+	auditLogService := service.NewAuditLogService(auditLogUseCase, logger)
+	leaseStore := data.NewSchedulerLeaseStore(dataData, logger)
+	scheduler, err := server.NewScheduler(confServer, leaseStore, jobPostingUseCase, userTrackingUseCase, accountDataUseCase, jobAlertUseCase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	schedulerService := service.NewSchedulerService(scheduler, logger)
+	httpServer := server.NewHTTPServer(confServer, confData, authService, jobPostingService, companyService, resumeService, applicationService, hiringPipelineService, jobAlertService, notificationService, userAdminService, auditLogService, schedulerService, keySet, revocationStore, apiKeyValidator, policyTable, logger)
+	app := newApp(logger, grpcServer, httpServer, scheduler)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -0,0 +1,84 @@
+// Command backfill-normalized fills the normalized search fields (title_norm, name_norm,
+// location_norm) of job postings and companies written before they existed,
+// or inserted directly into MongoDB (e.g. script/seed_data.js).
+// Trước đó nó xóa text index job_posting_text cũ (trên title) và tạo lại trên title_norm.
+//
+//	go run ./cmd/backfill-normalized -conf ./configs
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"JobblyBE/internal/conf"
+	"JobblyBE/internal/data"
+	"JobblyBE/pkg/logx"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// flagconf is the config flag.
+var flagconf string
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+func main() {
+	flag.Parse()
+
+	zapLogger, err := logx.NewZapLogger()
+	if err != nil {
+		panic(err)
+	}
+	logger := log.With(zapLogger, "ts", log.DefaultTimestamp, "caller", log.DefaultCaller)
+	helper := log.NewHelper(logger)
+
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	d, cleanup, err := data.NewData(bc.Data, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+
+	dropped, err := d.MigrateJobPostingTextIndex(ctx)
+	if err != nil {
+		helper.Errorf("text index migration failed: %v", err)
+		cleanup()
+		os.Exit(1)
+	}
+	if dropped {
+		helper.Info("recreated job posting text index on title_norm")
+	}
+
+	updated, err := d.BackfillNormalizedFields(ctx)
+	for collection, n := range updated {
+		helper.Infof("backfilled %d documents of %s", n, collection)
+	}
+	if err != nil {
+		helper.Errorf("backfill failed: %v", err)
+		cleanup()
+		os.Exit(1)
+	}
+	helper.Info("backfill done")
+}
@@ -0,0 +1,208 @@
+package biz
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AccountDeletionGracePeriod là thời gian giữ tài khoản đã xóa trước khi purge hẳn
+const AccountDeletionGracePeriod = 30 * 24 * time.Hour
+
+// Personal data export formats
+const (
+	ExportFormatJSON = "json"
+	ExportFormatZip  = "zip"
+)
+
+var (
+	ErrInvalidExportFormat = errors.New("export format must be json or zip")
+	ErrAccountDeleted      = errors.New("account is scheduled for deletion")
+)
+
+// PersonalDataStore is a collection holding documents of a user.
+// Mỗi collection mới chứa dữ liệu cá nhân phải đăng ký một store
+// để được đưa vào bản export và bị xóa khi purge tài khoản.
+type PersonalDataStore interface {
+	// Name là tên của phần dữ liệu trong bản export (vd: "user_tracking")
+	Name() string
+	// ExportUserData trả về các document của user, đã bỏ các field bí mật (hash, secret)
+	ExportUserData(ctx context.Context, userID string) ([]map[string]interface{}, error)
+	// PurgeUserData xóa hẳn các document của user, trả về số document đã xóa
+	PurgeUserData(ctx context.Context, userID string) (int64, error)
+}
+
+// PersonalDataStores lists every personal data store, purged in order
+// (document user nằm cuối để purge lỗi giữa chừng có thể chạy lại)
+type PersonalDataStores []PersonalDataStore
+
+// PersonalDataExport is a rendered personal data bundle
+type PersonalDataExport struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// AccountDataUseCase handles personal data export and account deletion
+type AccountDataUseCase struct {
+	userRepo  UserRepo
+	stores    PersonalDataStores
+	sessionUC *SessionUseCase
+	audit     AuditRecorder
+	log       *log.Helper
+}
+
+// NewAccountDataUseCase creates a new AccountDataUseCase
+func NewAccountDataUseCase(userRepo UserRepo, stores PersonalDataStores, sessionUC *SessionUseCase, audit AuditRecorder, logger log.Logger) *AccountDataUseCase {
+	return &AccountDataUseCase{
+		userRepo:  userRepo,
+		stores:    stores,
+		sessionUC: sessionUC,
+		audit:     audit,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// ExportUserData collects every personal data store of a user into a JSON document
+// or a zip archive with one JSON file per store
+func (uc *AccountDataUseCase) ExportUserData(ctx context.Context, userID, format string) (*PersonalDataExport, error) {
+	uc.log.WithContext(ctx).Infof("ExportUserData: %s (%s)", userID, format)
+
+	if format == "" {
+		format = ExportFormatJSON
+	}
+	if format != ExportFormatJSON && format != ExportFormatZip {
+		return nil, ErrInvalidExportFormat
+	}
+
+	exportedAt := time.Now()
+	sections := make(map[string][]map[string]interface{}, len(uc.stores))
+	for _, store := range uc.stores {
+		docs, err := store.ExportUserData(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", store.Name(), err)
+		}
+		if docs == nil {
+			docs = []map[string]interface{}{}
+		}
+		sections[store.Name()] = docs
+	}
+
+	filename := fmt.Sprintf("jobbly-data-%s-%s", userID, exportedAt.Format("20060102"))
+	if format == ExportFormatJSON {
+		data, err := json.MarshalIndent(map[string]interface{}{
+			"user_id":     userID,
+			"exported_at": exportedAt,
+			"data":        sections,
+		}, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return &PersonalDataExport{Filename: filename + ".json", ContentType: "application/json", Data: data}, nil
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, store := range uc.stores {
+		w, err := zw.Create(store.Name() + ".json")
+		if err != nil {
+			return nil, err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(sections[store.Name()]); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &PersonalDataExport{Filename: filename + ".zip", ContentType: "application/zip", Data: buf.Bytes()}, nil
+}
+
+// DeleteAccount soft deletes an account: the user is deactivated, every session revoked
+// and the data purged once the grace period is over. Trả về thời điểm sẽ purge.
+func (uc *AccountDataUseCase) DeleteAccount(ctx context.Context, userID string) (time.Time, error) {
+	uc.log.WithContext(ctx).Infof("DeleteAccount: %s", userID)
+
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if user == nil {
+		return time.Time{}, ErrUserNotFound
+	}
+	if user.DeletedAt != nil {
+		return time.Time{}, ErrAccountDeleted
+	}
+
+	now := time.Now()
+	purgeAt := now.Add(AccountDeletionGracePeriod)
+	if err := uc.userRepo.MarkUserDeleted(ctx, userID, now, purgeAt); err != nil {
+		return time.Time{}, err
+	}
+	if _, err := uc.sessionUC.RevokeOtherSessions(ctx, userID, "", SessionRevokeAccountDeleted); err != nil {
+		return time.Time{}, err
+	}
+
+	uc.record(ctx, userID, AuditAccountDeletionRequested, userID, nil,
+		map[string]interface{}{"deleted_at": now, "purge_at": purgeAt})
+
+	return purgeAt, nil
+}
+
+// PurgeDeletedAccounts hard deletes the accounts whose grace period ended before now,
+// across every personal data store. Trả về số tài khoản đã purge.
+func (uc *AccountDataUseCase) PurgeDeletedAccounts(ctx context.Context, now time.Time, limit int) (int, error) {
+	userIDs, err := uc.userRepo.ListUsersToPurge(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, userID := range userIDs {
+		counts, err := uc.PurgeAccount(ctx, userID)
+		if err != nil {
+			return purged, err
+		}
+		uc.record(ctx, AuditActorSystem, AuditAccountPurged, userID, nil, counts)
+		purged++
+	}
+
+	return purged, nil
+}
+
+// PurgeAccount deletes the documents of a user in every store at once
+// and returns the number of deleted documents per store
+func (uc *AccountDataUseCase) PurgeAccount(ctx context.Context, userID string) (map[string]interface{}, error) {
+	counts := make(map[string]interface{}, len(uc.stores))
+	for _, store := range uc.stores {
+		n, err := store.PurgeUserData(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to purge %s of %s: %w", store.Name(), userID, err)
+		}
+		counts[store.Name()] = n
+	}
+
+	uc.log.WithContext(ctx).Infof("Purged account %s: %v", userID, counts)
+	return counts, nil
+}
+
+// record writes an audit entry of a change to an account
+func (uc *AccountDataUseCase) record(ctx context.Context, actorID, action, userID string, before, after map[string]interface{}) {
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: AuditTargetUser,
+		TargetID:   userID,
+		Before:     before,
+		After:      after,
+	})
+}
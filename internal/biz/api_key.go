@@ -0,0 +1,250 @@
+package biz
+
+import (
+	"JobblyBE/pkg/middleware/auth"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const MaxAPIKeysPerUser = 20 // Số key chưa thu hồi tối đa của một user
+
+// API key scopes, declared on RPCs with the scopes field of (api.policy.v1.policy)
+const (
+	ScopeJobsRead       = "jobs:read"
+	ScopeJobsWrite      = "jobs:write"
+	ScopeCompaniesRead  = "companies:read"
+	ScopeCompaniesWrite = "companies:write"
+	ScopeResumesRead    = "resumes:read"
+	ScopeResumesWrite   = "resumes:write"
+	ScopeProfileRead    = "profile:read"
+
+	ScopeApplicationsRead  = "applications:read"
+	ScopeApplicationsWrite = "applications:write"
+
+	ScopeSavedJobsRead  = "saved_jobs:read"
+	ScopeSavedJobsWrite = "saved_jobs:write"
+
+	ScopeAlertsRead         = "alerts:read"
+	ScopeAlertsWrite        = "alerts:write"
+	ScopeNotificationsRead  = "notifications:read"
+	ScopeNotificationsWrite = "notifications:write"
+)
+
+// APIKeyScopes lists every scope an API key can be granted
+var APIKeyScopes = []string{
+	ScopeJobsRead,
+	ScopeJobsWrite,
+	ScopeCompaniesRead,
+	ScopeCompaniesWrite,
+	ScopeResumesRead,
+	ScopeResumesWrite,
+	ScopeProfileRead,
+	ScopeApplicationsRead,
+	ScopeApplicationsWrite,
+	ScopeSavedJobsRead,
+	ScopeSavedJobsWrite,
+	ScopeAlertsRead,
+	ScopeAlertsWrite,
+	ScopeNotificationsRead,
+	ScopeNotificationsWrite,
+}
+
+var (
+	ErrAPIKeyNotFound     = errors.New("api key not found")
+	ErrInvalidAPIKeyScope = errors.New("invalid api key scope")
+	ErrTooManyAPIKeys     = errors.New("too many api keys")
+)
+
+// APIKey là personal API key của user, chỉ lưu hash của key
+type APIKey struct {
+	ID         string
+	UserID     string
+	Name       string
+	LookupID   string // phần giữa của key "jbk_<lookup id>_<secret>", dùng để tìm key
+	KeyHash    string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// Prefix returns the displayable start of the key
+func (k *APIKey) Prefix() string {
+	return auth.APIKeyPrefix + "_" + k.LookupID
+}
+
+// APIKeyRepo persists API keys
+type APIKeyRepo interface {
+	CreateAPIKey(ctx context.Context, key *APIKey) (*APIKey, error)
+	GetAPIKeyByLookupID(ctx context.Context, lookupID string) (*APIKey, error)
+	// ListUserAPIKeys trả về các key chưa thu hồi của user, mới nhất trước
+	ListUserAPIKeys(ctx context.Context, userID string) ([]*APIKey, error)
+	// RevokeAPIKey thu hồi key của user. Trả về false nếu không có key chưa thu hồi nào khớp.
+	RevokeAPIKey(ctx context.Context, userID, keyID string) (bool, error)
+	// TouchAPIKey cập nhật last_used_at (tối đa mỗi phút một lần)
+	TouchAPIKey(ctx context.Context, keyID string, at time.Time) error
+}
+
+// APIKeyUseCase manages personal API keys and resolves them for the auth middleware
+type APIKeyUseCase struct {
+	repo     APIKeyRepo
+	userRepo UserRepo
+	log      *log.Helper
+}
+
+// NewAPIKeyUseCase creates a new APIKeyUseCase
+func NewAPIKeyUseCase(repo APIKeyRepo, userRepo UserRepo, logger log.Logger) *APIKeyUseCase {
+	return &APIKeyUseCase{
+		repo:     repo,
+		userRepo: userRepo,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// NewAPIKeyValidator exposes the use case to the auth middleware
+func NewAPIKeyValidator(uc *APIKeyUseCase) auth.APIKeyValidator {
+	return uc
+}
+
+// CreateAPIKey creates a key for userID. Returns the full key, which is never stored.
+func (uc *APIKeyUseCase) CreateAPIKey(ctx context.Context, userID, name string, scopes []string, expiresAt *time.Time) (string, *APIKey, error) {
+	uc.log.WithContext(ctx).Infof("CreateAPIKey: %s %v", userID, scopes)
+
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	keys, err := uc.repo.ListUserAPIKeys(ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(keys) >= MaxAPIKeysPerUser {
+		return "", nil, ErrTooManyAPIKeys
+	}
+
+	rawKey, lookupID, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		uc.log.Errorf("failed to generate api key: %v", err)
+		return "", nil, err
+	}
+
+	key, err := uc.repo.CreateAPIKey(ctx, &APIKey{
+		UserID:    userID,
+		Name:      strings.TrimSpace(name),
+		LookupID:  lookupID,
+		KeyHash:   hash,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		uc.log.Errorf("failed to create api key: %v", err)
+		return "", nil, err
+	}
+
+	return rawKey, key, nil
+}
+
+// ListAPIKeys lists the active keys of a user
+func (uc *APIKeyUseCase) ListAPIKeys(ctx context.Context, userID string) ([]*APIKey, error) {
+	return uc.repo.ListUserAPIKeys(ctx, userID)
+}
+
+// RevokeAPIKey revokes one of the user's keys, effective on the next request
+func (uc *APIKeyUseCase) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	uc.log.WithContext(ctx).Infof("RevokeAPIKey: %s %s", userID, keyID)
+
+	revoked, err := uc.repo.RevokeAPIKey(ctx, userID, keyID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// ValidateAPIKey implements auth.APIKeyValidator: the key must exist, be unrevoked and
+// unexpired, and belong to an active user. Claims carry the user's current role.
+func (uc *APIKeyUseCase) ValidateAPIKey(ctx context.Context, rawKey string) (*auth.JWTClaims, error) {
+	lookupID, ok := auth.ParseAPIKey(rawKey)
+	if !ok {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	key, err := uc.repo.GetAPIKeyByLookupID(ctx, lookupID)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil || subtle.ConstantTimeCompare([]byte(key.KeyHash), []byte(auth.HashAPIKey(rawKey))) != 1 {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	now := time.Now()
+	if key.RevokedAt != nil || (key.ExpiresAt != nil && !key.ExpiresAt.After(now)) {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, key.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if !user.Active {
+		return nil, ErrUserInactive
+	}
+
+	if err := uc.repo.TouchAPIKey(ctx, key.ID, now); err != nil {
+		uc.log.Warnf("failed to update api key last use: %v", err)
+	}
+
+	return &auth.JWTClaims{
+		UserID:      user.UserID,
+		Email:       user.Email,
+		FullName:    user.FullName,
+		PhoneNumber: user.PhoneNumber,
+		Role:        string(user.Role),
+		TokenType:   auth.APIKeyToken,
+		APIKeyID:    key.ID,
+		Scopes:      key.Scopes,
+	}, nil
+}
+
+// IsAPIKeyScope reports whether scope is a known API key scope
+func IsAPIKeyScope(scope string) bool {
+	for _, s := range APIKeyScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeScopes validates and deduplicates the requested scopes
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, ErrInvalidAPIKeyScope
+	}
+
+	seen := make(map[string]bool, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !IsAPIKeyScope(scope) {
+			return nil, ErrInvalidAPIKeyScope
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,280 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrApplicationNotFound         = errors.New("application not found")
+	ErrApplicationAlreadyExists    = errors.New("application already exists")
+	ErrInvalidApplication          = errors.New("invalid application data")
+	ErrInvalidApplicationStatus    = errors.New("invalid application status")
+	ErrJobNotAcceptingApplications = errors.New("job is not accepting applications")
+)
+
+// MaxCoverLetterLength là số ký tự tối đa của thư xin việc
+const MaxCoverLetterLength = 5000
+
+// ApplicationStatus là trạng thái của một đơn ứng tuyển
+type ApplicationStatus string
+
+const (
+	ApplicationStatusSubmitted ApplicationStatus = "SUBMITTED"
+	ApplicationStatusWithdrawn ApplicationStatus = "WITHDRAWN"
+)
+
+// Application is a candidate's application to a job posting
+type Application struct {
+	ID            string
+	JobID         string
+	CompanyID     string
+	UserID        string
+	JobTitle      string // tại thời điểm ứng tuyển
+	CompanyName   string
+	ResumeID      string
+	ResumeVersion int32
+	Resume        *ResumeDetail // bản chụp CV lúc ứng tuyển, không đổi khi CV được sửa
+	CoverLetter   string
+	Status        ApplicationStatus
+	Stage         string         // key của stage trong pipeline tuyển dụng của công ty
+	StageHistory  []*StageChange // cũ nhất trước
+	WithdrawnAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ApplicationFilter filters applications, empty fields match everything
+type ApplicationFilter struct {
+	JobID     string
+	CompanyID string
+	UserID    string
+	Status    ApplicationStatus
+	Stage     string
+}
+
+// ApplicationRepo interface
+type ApplicationRepo interface {
+	// CreateApplication returns ErrApplicationAlreadyExists if the user already applied to the job
+	CreateApplication(ctx context.Context, app *Application) (*Application, error)
+	GetApplication(ctx context.Context, id string) (*Application, error)
+	// ListApplications returns matching applications, newest first
+	ListApplications(ctx context.Context, filter *ApplicationFilter, page, pageSize int32) ([]*Application, int32, error)
+	// WithdrawApplication withdraws a submitted application, trả về false nếu đơn không còn SUBMITTED
+	WithdrawApplication(ctx context.Context, id string, at time.Time) (bool, error)
+	// MoveApplicationStage sets the stage of a submitted application if it is still at stage from
+	// and appends change to its history, trả về false nếu đơn đã bị thay đổi
+	MoveApplicationStage(ctx context.Context, id, from string, change *StageChange) (bool, error)
+	// CountApplicationsByStage counts matching applications per stage, đơn chưa có stage đếm vào key ""
+	CountApplicationsByStage(ctx context.Context, filter *ApplicationFilter) (map[string]int32, error)
+}
+
+// ApplicationUseCase handles job applications
+type ApplicationUseCase struct {
+	appRepo      ApplicationRepo
+	jobRepo      JobPostingRepo
+	companyRepo  CompanyRepo
+	resumeRepo   ResumeRepo
+	memberRepo   CompanyMemberRepo
+	pipelineRepo PipelineRepo
+	audit        AuditRecorder
+	log          *log.Helper
+}
+
+// NewApplicationUseCase creates a new application use case
+func NewApplicationUseCase(appRepo ApplicationRepo, jobRepo JobPostingRepo, companyRepo CompanyRepo, resumeRepo ResumeRepo, memberRepo CompanyMemberRepo, pipelineRepo PipelineRepo, audit AuditRecorder, logger log.Logger) *ApplicationUseCase {
+	return &ApplicationUseCase{
+		appRepo:      appRepo,
+		jobRepo:      jobRepo,
+		companyRepo:  companyRepo,
+		resumeRepo:   resumeRepo,
+		memberRepo:   memberRepo,
+		pipelineRepo: pipelineRepo,
+		audit:        audit,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Apply submits an application of actor to a published job with one of their resumes.
+// Mỗi user chỉ ứng tuyển một lần vào một job (kể cả khi đã rút đơn).
+func (uc *ApplicationUseCase) Apply(ctx context.Context, actor *Actor, jobID, resumeID, coverLetter string) (*Application, error) {
+	uc.log.WithContext(ctx).Infof("Apply: %s -> %s", actor.UserID, jobID)
+
+	coverLetter = strings.TrimSpace(coverLetter)
+	if resumeID == "" || utf8.RuneCountInString(coverLetter) > MaxCoverLetterLength {
+		return nil, ErrInvalidApplication
+	}
+
+	job, err := uc.jobRepo.GetJobPosting(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrJobNotFound
+	}
+	switch job.CurrentStatus(time.Now()) {
+	case JobStatusPublished:
+	case JobStatusDraft:
+		return nil, ErrJobNotFound
+	case JobStatusExpired:
+		return nil, ErrJobExpired
+	default:
+		return nil, ErrJobNotAcceptingApplications
+	}
+
+	resume, err := uc.resumeRepo.GetResume(ctx, resumeID)
+	if err != nil {
+		return nil, err
+	}
+	if resume == nil || resume.UserID != actor.UserID {
+		return nil, ErrResumeNotFound
+	}
+
+	app := &Application{
+		JobID:         job.ID,
+		CompanyID:     job.CompanyID,
+		UserID:        actor.UserID,
+		JobTitle:      job.Title,
+		ResumeID:      resume.ID,
+		ResumeVersion: resume.Version,
+		Resume:        resume.ResumeDetail,
+		CoverLetter:   coverLetter,
+		Status:        ApplicationStatusSubmitted,
+	}
+	company, err := uc.companyRepo.GetCompany(ctx, job.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if company != nil {
+		app.CompanyName = company.Name
+	}
+
+	// Đơn mới nằm ở stage đầu của pipeline công ty
+	pipeline, err := uc.pipeline(ctx, job.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	app.Stage = pipeline.InitialStage()
+	app.StageHistory = []*StageChange{{To: app.Stage, ActorID: actor.UserID, ChangedAt: time.Now()}}
+
+	created, err := uc.appRepo.CreateApplication(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, actor, AuditApplicationSubmitted, created.ID, nil, map[string]interface{}{
+		"job_id":         created.JobID,
+		"resume_id":      created.ResumeID,
+		"resume_version": created.ResumeVersion,
+	})
+
+	return created, nil
+}
+
+// GetApplication returns an application to its candidate or to the members of the job's company
+func (uc *ApplicationUseCase) GetApplication(ctx context.Context, actor *Actor, id string) (*Application, error) {
+	app, err := uc.appRepo.GetApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if app == nil {
+		return nil, ErrApplicationNotFound
+	}
+
+	if app.UserID != actor.UserID {
+		err := authorizeCompanyAction(ctx, uc.memberRepo, actor, app.CompanyID)
+		if errors.Is(err, ErrUnauthorizedJobAction) {
+			return nil, ErrApplicationNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return app, nil
+}
+
+// ListMyApplications lists the applications of actor, newest first
+func (uc *ApplicationUseCase) ListMyApplications(ctx context.Context, actor *Actor, status ApplicationStatus, page, pageSize int32) ([]*Application, int32, error) {
+	return uc.listApplications(ctx, &ApplicationFilter{UserID: actor.UserID, Status: status}, page, pageSize)
+}
+
+// ListJobApplications lists the applicants of a job (company members only), optionally at one pipeline stage
+func (uc *ApplicationUseCase) ListJobApplications(ctx context.Context, actor *Actor, jobID string, status ApplicationStatus, stage string, page, pageSize int32) ([]*Application, int32, error) {
+	job, err := uc.jobRepo.GetJobPosting(ctx, jobID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if job == nil {
+		return nil, 0, ErrJobNotFound
+	}
+
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, job.CompanyID); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.listApplications(ctx, &ApplicationFilter{JobID: jobID, Status: status, Stage: stage}, page, pageSize)
+}
+
+// WithdrawApplication withdraws a submitted application (candidate only)
+func (uc *ApplicationUseCase) WithdrawApplication(ctx context.Context, actor *Actor, id string) (*Application, error) {
+	uc.log.WithContext(ctx).Infof("WithdrawApplication: %s", id)
+
+	app, err := uc.appRepo.GetApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if app == nil || app.UserID != actor.UserID {
+		return nil, ErrApplicationNotFound
+	}
+	if app.Status != ApplicationStatusSubmitted {
+		return nil, ErrInvalidApplicationStatus
+	}
+
+	ok, err := uc.appRepo.WithdrawApplication(ctx, id, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidApplicationStatus
+	}
+
+	uc.record(ctx, actor, AuditApplicationWithdrawn, id,
+		map[string]interface{}{"status": string(app.Status)},
+		map[string]interface{}{"status": string(ApplicationStatusWithdrawn)})
+
+	return uc.appRepo.GetApplication(ctx, id)
+}
+
+func (uc *ApplicationUseCase) listApplications(ctx context.Context, filter *ApplicationFilter, page, pageSize int32) ([]*Application, int32, error) {
+	if filter.Status != "" && filter.Status != ApplicationStatusSubmitted && filter.Status != ApplicationStatusWithdrawn {
+		return nil, 0, ErrInvalidApplicationStatus
+	}
+
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return uc.appRepo.ListApplications(ctx, filter, page, pageSize)
+}
+
+// record writes an audit entry of a change made by actor to an application
+func (uc *ApplicationUseCase) record(ctx context.Context, actor *Actor, action, appID string, before, after map[string]interface{}) {
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actor.UserID,
+		Action:     action,
+		TargetType: AuditTargetApplication,
+		TargetID:   appID,
+		Before:     before,
+		After:      after,
+	})
+}
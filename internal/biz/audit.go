@@ -0,0 +1,216 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Audit actions
+const (
+	AuditUserActivated    = "user.activated"
+	AuditUserDeactivated  = "user.deactivated"
+	AuditUserRoleChanged  = "user.role_changed"
+	AuditUserLoggedOut    = "user.force_logout"
+	AuditUserDeleted      = "user.deleted"
+	AuditUserImpersonated = "user.impersonated"
+
+	AuditAccountDeletionRequested = "account.deletion_requested"
+	AuditAccountPurged            = "account.purged"
+
+	AuditAuthRegistered      = "auth.registered"
+	AuditAuthLogin           = "auth.login"
+	AuditAuthLoginFailed     = "auth.login_failed"
+	AuditAuthProfileUpdated  = "auth.profile_updated"
+	AuditAuthPasswordChanged = "auth.password_changed"
+	AuditAuthPasswordReset   = "auth.password_reset"
+
+	AuditCompanyCreated = "company.created"
+	AuditCompanyUpdated = "company.updated"
+	AuditCompanyDeleted = "company.deleted"
+
+	AuditCompanyPipelineUpdated = "company.pipeline_updated"
+
+	AuditJobCreated = "job.created"
+	AuditJobUpdated = "job.updated"
+	AuditJobDeleted = "job.deleted"
+
+	AuditJobStatusChanged = "job.status_changed"
+
+	AuditResumeCreated = "resume.created"
+	AuditResumeUpdated = "resume.updated"
+	AuditResumeDeleted = "resume.deleted"
+
+	AuditApplicationSubmitted = "application.submitted"
+	AuditApplicationWithdrawn = "application.withdrawn"
+
+	AuditApplicationStageChanged = "application.stage_changed"
+)
+
+// AuditActorSystem là actor của các thao tác chạy nền (không có user thực hiện)
+const AuditActorSystem = "system"
+
+// Audit target types
+const (
+	AuditTargetUser    = "user"
+	AuditTargetCompany = "company"
+	AuditTargetJob     = "job"
+	AuditTargetResume  = "resume"
+
+	AuditTargetApplication = "application"
+)
+
+// AuditRedacted replaces the value of a personal field in the audit log.
+// Audit log là append-only và không bị xóa khi purge tài khoản, nên với dữ liệu cá nhân
+// chỉ ghi lại tên field (và việc nó thay đổi), không ghi giá trị.
+const AuditRedacted = "[redacted]"
+
+// personalAuditFields lists the personal fields of each target type, redacted by recordAudit
+var personalAuditFields = map[string][]string{
+	AuditTargetUser:   {"email", "full_name", "phone_number"},
+	AuditTargetResume: {"name", "email", "phone"},
+}
+
+// AuditEntry is one record of the audit trail (append-only)
+type AuditEntry struct {
+	ID             string
+	ActorID        string // user thực hiện thao tác
+	ImpersonatorID string // admin đang mạo danh actor (nếu có)
+	Action         string
+	TargetType     string
+	TargetID       string
+	Before         map[string]interface{} // giá trị các field trước khi thay đổi
+	After          map[string]interface{}
+	Reason         string
+	IPAddress      string
+	RequestID      string
+	CreatedAt      time.Time
+}
+
+// AuditRecorder writes audit entries
+type AuditRecorder interface {
+	Record(ctx context.Context, entry *AuditEntry) error
+}
+
+// AuditFilter filters the audit log, empty fields match everything
+type AuditFilter struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	From       *time.Time // created_at >= From
+	To         *time.Time // created_at < To
+}
+
+// AuditLogRepo queries the audit log (read only)
+type AuditLogRepo interface {
+	// ListAuditEntries returns matching entries, newest first
+	ListAuditEntries(ctx context.Context, filter *AuditFilter, page, pageSize int32) ([]*AuditEntry, int32, error)
+}
+
+// RequestInfo describes the request a change is made in
+type RequestInfo struct {
+	IPAddress      string
+	UserAgent      string
+	RequestID      string
+	ImpersonatorID string
+}
+
+type requestInfoKey struct{}
+
+// NewRequestInfoContext returns a context carrying info
+func NewRequestInfoContext(ctx context.Context, info *RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext returns the request info of ctx (empty outside of a request)
+func RequestInfoFromContext(ctx context.Context) *RequestInfo {
+	if info, ok := ctx.Value(requestInfoKey{}).(*RequestInfo); ok && info != nil {
+		return info
+	}
+	return &RequestInfo{}
+}
+
+// recordAudit writes entry with the IP and request ID of ctx.
+// Thay đổi đã được áp dụng nên lỗi ghi audit chỉ được log lại.
+func recordAudit(ctx context.Context, audit AuditRecorder, logger *log.Helper, entry *AuditEntry) {
+	info := RequestInfoFromContext(ctx)
+	entry.IPAddress = info.IPAddress
+	entry.RequestID = info.RequestID
+	entry.ImpersonatorID = info.ImpersonatorID
+	entry.Before = redactAuditFields(entry.Before, personalAuditFields[entry.TargetType])
+	entry.After = redactAuditFields(entry.After, personalAuditFields[entry.TargetType])
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	if err := audit.Record(ctx, entry); err != nil {
+		logger.WithContext(ctx).Errorf("failed to record audit entry %s for %s %s: %v", entry.Action, entry.TargetType, entry.TargetID, err)
+	}
+}
+
+// redactAuditFields returns fields with the values of personal replaced by AuditRedacted.
+// Field rỗng (nil hoặc "") giữ nguyên để vẫn thấy được field bị xóa hay được đặt.
+func redactAuditFields(fields map[string]interface{}, personal []string) map[string]interface{} {
+	if len(fields) == 0 || len(personal) == 0 {
+		return fields
+	}
+	redacted := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		redacted[key] = value
+	}
+	for _, key := range personal {
+		if value, ok := redacted[key]; ok && value != nil && value != "" {
+			redacted[key] = AuditRedacted
+		}
+	}
+	return redacted
+}
+
+// auditDiff keeps only the fields whose value changed between before and after
+func auditDiff(before, after map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	changedBefore := map[string]interface{}{}
+	changedAfter := map[string]interface{}{}
+	for key, value := range after {
+		if old, ok := before[key]; !ok || !reflect.DeepEqual(old, value) {
+			changedBefore[key] = before[key]
+			changedAfter[key] = value
+		}
+	}
+	for key, old := range before {
+		if _, ok := after[key]; !ok {
+			changedBefore[key] = old
+			changedAfter[key] = nil
+		}
+	}
+	return changedBefore, changedAfter
+}
+
+// AuditLogUseCase lets administrators query the audit log
+type AuditLogUseCase struct {
+	repo AuditLogRepo
+	log  *log.Helper
+}
+
+// NewAuditLogUseCase creates a new AuditLogUseCase
+func NewAuditLogUseCase(repo AuditLogRepo, logger log.Logger) *AuditLogUseCase {
+	return &AuditLogUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListAuditEntries lists audit entries matching filter, newest first
+func (uc *AuditLogUseCase) ListAuditEntries(ctx context.Context, filter *AuditFilter, page, pageSize int32) ([]*AuditEntry, int32, error) {
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return uc.repo.ListAuditEntries(ctx, filter, page, pageSize)
+}
@@ -0,0 +1,73 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type memoryAuditRecorder struct {
+	entries []*AuditEntry
+}
+
+func (r *memoryAuditRecorder) Record(ctx context.Context, entry *AuditEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func TestRecordAuditRedactsPersonalFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		targetType string
+		before     map[string]interface{}
+		after      map[string]interface{}
+		wantBefore map[string]interface{}
+		wantAfter  map[string]interface{}
+	}{
+		{
+			name:       "user profile",
+			targetType: AuditTargetUser,
+			before:     map[string]interface{}{"full_name": "Nguyễn Văn A", "phone_number": ""},
+			after:      map[string]interface{}{"full_name": "Nguyễn Văn B", "phone_number": "0901234567"},
+			wantBefore: map[string]interface{}{"full_name": AuditRedacted, "phone_number": ""},
+			wantAfter:  map[string]interface{}{"full_name": AuditRedacted, "phone_number": AuditRedacted},
+		},
+		{
+			name:       "user registration keeps role",
+			targetType: AuditTargetUser,
+			after:      map[string]interface{}{"email": "a@example.com", "full_name": "A", "role": "USER"},
+			wantAfter:  map[string]interface{}{"email": AuditRedacted, "full_name": AuditRedacted, "role": "USER"},
+		},
+		{
+			name:       "resume",
+			targetType: AuditTargetResume,
+			before:     map[string]interface{}{"version": int32(1), "name": "A", "email": "a@example.com", "phone": nil},
+			wantBefore: map[string]interface{}{"version": int32(1), "name": AuditRedacted, "email": AuditRedacted, "phone": nil},
+		},
+		{
+			name:       "company name is not personal",
+			targetType: AuditTargetCompany,
+			after:      map[string]interface{}{"name": "Tech Company"},
+			wantAfter:  map[string]interface{}{"name": "Tech Company"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &memoryAuditRecorder{}
+			recordAudit(context.Background(), recorder, log.NewHelper(log.DefaultLogger), &AuditEntry{
+				TargetType: tt.targetType,
+				Before:     tt.before,
+				After:      tt.after,
+			})
+			entry := recorder.entries[0]
+			if !reflect.DeepEqual(entry.Before, tt.wantBefore) {
+				t.Errorf("Before = %v, want %v", entry.Before, tt.wantBefore)
+			}
+			if !reflect.DeepEqual(entry.After, tt.wantAfter) {
+				t.Errorf("After = %v, want %v", entry.After, tt.wantAfter)
+			}
+		})
+	}
+}
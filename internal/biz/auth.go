@@ -11,27 +11,31 @@ import (
 )
 
 var (
-	ErrUserNotFound = errors.New("user not found")
-	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrUserAlreadyExists  = errors.New("user already exists")
 	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrUserInactive = errors.New("user account is inactive")
-	ErrWeakPassword = errors.New("password is too weak")
-	ErrInvalidEmail = errors.New("invalid email format")
-	ErrInvalidPhone = errors.New("invalid phone format")
+	ErrUserInactive       = errors.New("user account is inactive")
+	ErrWeakPassword       = errors.New("password is too weak")
+	ErrInvalidEmail       = errors.New("invalid email format")
+	ErrInvalidPhone       = errors.New("invalid phone format")
+	ErrEmailNotVerified   = errors.New("email is not verified")
 )
 
 // User entity in business layer
 type User struct {
-	UserID      string
-	FullName    string
-	Email       string
-	Password    string // hashed password
-	PhoneNumber string
-	Role        Role
-	Active      bool
-	LastLogin   *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	UserID          string
+	FullName        string
+	Email           string
+	Password        string // hashed password
+	PhoneNumber     string
+	Role            Role
+	Active          bool
+	EmailVerified   bool
+	PasswordResetAt *time.Time // token phát hành trước thời điểm này không còn hiệu lực
+	LastLogin       *time.Time
+	DeletedAt       *time.Time // tài khoản đã bị xóa mềm, chờ purge
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 // UserRepo interface định nghĩa các methods để tương tác với database
@@ -41,18 +45,45 @@ type UserRepo interface {
 	GetUserByID(ctx context.Context, id string) (*User, error)
 	UpdateLastLogin(ctx context.Context, userID string) error
 	UpdateUser(ctx context.Context, user *User) error
+	// SetEmailVerificationToken lưu jti của link xác thực email mới nhất (link cũ hết hiệu lực)
+	SetEmailVerificationToken(ctx context.Context, userID, tokenID string) error
+	// MarkEmailVerified xác thực email nếu tokenID vẫn là link mới nhất. Trả về false nếu không khớp.
+	MarkEmailVerified(ctx context.Context, userID, tokenID string) (bool, error)
+	// ResetPassword đặt mật khẩu mới (đã hash) và ghi lại thời điểm reset
+	ResetPassword(ctx context.Context, userID, hashedPassword string) error
+	// ListUsers tìm kiếm user (admin), mới nhất trước
+	ListUsers(ctx context.Context, filter *UserFilter, page, pageSize int32) ([]*User, int32, error)
+	SetUserActive(ctx context.Context, userID string, active bool) error
+	SetUserRole(ctx context.Context, userID string, role Role) error
+	// ChangeUserRole đổi role thành to chỉ khi role hiện tại là from. Trả về false nếu không khớp.
+	ChangeUserRole(ctx context.Context, userID string, from, to Role) (bool, error)
+	// MarkUserDeleted xóa mềm: deactivate user và hẹn thời điểm purge
+	MarkUserDeleted(ctx context.Context, userID string, deletedAt, purgeAt time.Time) error
+	// ListUsersToPurge returns the IDs of soft deleted users whose purge time is before now
+	ListUsersToPurge(ctx context.Context, now time.Time, limit int) ([]string, error)
+}
+
+// UserFilter filters the admin user list
+type UserFilter struct {
+	Keyword string // tìm trong full name và email
+	Role    Role
+	Active  *bool
 }
 
 // AuthUseCase handles authentication business logic
 type AuthUseCase struct {
 	userRepo UserRepo
+	throttle *LoginThrottle
+	audit    AuditRecorder
 	log      *log.Helper
 }
 
 // NewAuthUsecase creates a new AuthUseCase
-func NewAuthUsecase(userRepo UserRepo, logger log.Logger) *AuthUseCase {
+func NewAuthUsecase(userRepo UserRepo, throttle *LoginThrottle, audit AuditRecorder, logger log.Logger) *AuthUseCase {
 	return &AuthUseCase{
 		userRepo: userRepo,
+		throttle: throttle,
+		audit:    audit,
 		log:      log.NewHelper(logger),
 	}
 }
@@ -93,36 +124,53 @@ func (uc *AuthUseCase) RegisterUser(ctx context.Context, fullName, email, passwo
 		return nil, err
 	}
 
+	uc.record(ctx, createdUser.UserID, AuditAuthRegistered, createdUser.UserID, "", nil,
+		map[string]interface{}{"email": createdUser.Email, "full_name": createdUser.FullName, "role": string(createdUser.Role)})
+
 	return createdUser, nil
 }
 
-// Login authenticates a user
-func (uc *AuthUseCase) Login(ctx context.Context, email, password string) (*User, error) {
+// Login authenticates a user. clientIP (có thể rỗng) dùng để giới hạn số lần đoán mật khẩu theo IP.
+func (uc *AuthUseCase) Login(ctx context.Context, email, password, clientIP string) (*User, error) {
 	uc.log.WithContext(ctx).Infof("Login: %s", email)
 
+	// Tài khoản hoặc IP đang bị khóa: không kiểm tra mật khẩu
+	accountKey, ipKey := AccountKey(email), IPKey(clientIP)
+	if err := uc.throttle.Check(ctx, accountKey, ipKey); err != nil {
+		uc.recordLoginFailed(ctx, "", email, "locked")
+		return nil, err
+	}
+
 	// Get user by email
 	user, err := uc.userRepo.GetUserByEmail(ctx, email)
 	if err != nil {
 		return nil, err
 	}
 	if user == nil {
-		return nil, ErrInvalidCredentials
+		// Email không tồn tại cũng bị tính, để không lộ email nào đã đăng ký
+		uc.recordLoginFailed(ctx, "", email, "unknown_email")
+		return nil, uc.passwordCheckFailed(ctx, accountKey, ipKey)
 	}
 
 	// Check if user is active
 	if !user.Active {
+		uc.recordLoginFailed(ctx, user.UserID, email, "inactive")
 		return nil, ErrUserInactive
 	}
 
 	// Verify password
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
 	if err != nil {
-		return nil, ErrInvalidCredentials
+		uc.recordLoginFailed(ctx, user.UserID, email, "invalid_password")
+		return nil, uc.passwordCheckFailed(ctx, accountKey, ipKey)
 	}
+	uc.throttle.RecordSuccess(ctx, accountKey)
 
 	// Update last login
 	_ = uc.userRepo.UpdateLastLogin(ctx, user.UserID)
 
+	uc.record(ctx, user.UserID, AuditAuthLogin, user.UserID, "", nil, nil)
+
 	return user, nil
 }
 
@@ -169,6 +217,8 @@ func (uc *AuthUseCase) UpdateProfile(ctx context.Context, userID, fullName, phon
 		return nil, ErrUserNotFound
 	}
 
+	before := map[string]interface{}{"full_name": user.FullName, "phone_number": user.PhoneNumber}
+
 	// Update fields if provided
 	if fullName != "" {
 		user.FullName = fullName
@@ -184,6 +234,10 @@ func (uc *AuthUseCase) UpdateProfile(ctx context.Context, userID, fullName, phon
 		return nil, err
 	}
 
+	changedBefore, changedAfter := auditDiff(before,
+		map[string]interface{}{"full_name": user.FullName, "phone_number": user.PhoneNumber})
+	uc.record(ctx, userID, AuditAuthProfileUpdated, userID, "", changedBefore, changedAfter)
+
 	return user, nil
 }
 
@@ -200,11 +254,16 @@ func (uc *AuthUseCase) ChangePassword(ctx context.Context, userID, oldPassword,
 		return ErrUserNotFound
 	}
 
-	// Verify old password
+	// Verify old password (cùng giới hạn số lần đoán với Login)
+	accountKey := AccountKey(user.Email)
+	if err := uc.throttle.Check(ctx, accountKey); err != nil {
+		return err
+	}
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(oldPassword))
 	if err != nil {
-		return ErrInvalidCredentials
+		return uc.passwordCheckFailed(ctx, accountKey)
 	}
+	uc.throttle.RecordSuccess(ctx, accountKey)
 
 	// Validate new password strength
 	if err := uc.ValidatePassword(newPassword); err != nil {
@@ -226,5 +285,89 @@ func (uc *AuthUseCase) ChangePassword(ctx context.Context, userID, oldPassword,
 		return err
 	}
 
+	uc.record(ctx, userID, AuditAuthPasswordChanged, userID, "", nil, nil)
+
 	return nil
 }
+
+// ConfirmPassword re-checks the password of a signed in user before a sensitive operation.
+// Tài khoản chỉ đăng nhập bằng OAuth không có mật khẩu nên không cần xác nhận.
+func (uc *AuthUseCase) ConfirmPassword(ctx context.Context, userID, password string) (*User, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if user.Password == "" {
+		return user, nil
+	}
+
+	// Cùng giới hạn số lần đoán với Login
+	accountKey := AccountKey(user.Email)
+	if err := uc.throttle.Check(ctx, accountKey); err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		return nil, uc.passwordCheckFailed(ctx, accountKey)
+	}
+	uc.throttle.RecordSuccess(ctx, accountKey)
+
+	return user, nil
+}
+
+// passwordCheckFailed records a wrong password for keys and returns the error to report:
+// *LoginLockedError if this attempt triggered a lockout, ErrInvalidCredentials otherwise
+func (uc *AuthUseCase) passwordCheckFailed(ctx context.Context, keys ...string) error {
+	if err := uc.throttle.RecordFailure(ctx, keys...); err != nil {
+		return err
+	}
+	return ErrInvalidCredentials
+}
+
+// ResetPassword sets a new password without checking the old one (account recovery)
+func (uc *AuthUseCase) ResetPassword(ctx context.Context, userID, newPassword string) error {
+	uc.log.WithContext(ctx).Infof("ResetPassword: %s", userID)
+
+	// Validate new password strength
+	if err := uc.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	// Hash new password
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		uc.log.Errorf("failed to hash password: %v", err)
+		return err
+	}
+
+	err = uc.userRepo.ResetPassword(ctx, userID, string(hashedPassword))
+	if err != nil {
+		uc.log.Errorf("failed to reset password: %v", err)
+		return err
+	}
+
+	// Người reset chỉ chứng minh được quyền với email, không có actor đã đăng nhập
+	uc.record(ctx, "", AuditAuthPasswordReset, userID, "", nil, nil)
+
+	return nil
+}
+
+// recordLoginFailed audits a failed login, userID is empty if no account has the email
+func (uc *AuthUseCase) recordLoginFailed(ctx context.Context, userID, email, reason string) {
+	uc.record(ctx, "", AuditAuthLoginFailed, userID, reason, nil, map[string]interface{}{"email": email})
+}
+
+// record writes an audit entry of a change to a user account
+func (uc *AuthUseCase) record(ctx context.Context, actorID, action, userID, reason string, before, after map[string]interface{}) {
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: AuditTargetUser,
+		TargetID:   userID,
+		Before:     before,
+		After:      after,
+		Reason:     reason,
+	})
+}
@@ -9,11 +9,39 @@ var ProviderSet = wire.NewSet(
 	NewCompanyUseCase,
 	NewUserTrackingUseCase,
 	NewResumeUseCase,
+	NewSessionUseCase,
+	NewEmailVerificationUseCase,
+	NewPasswordResetUseCase,
+	NewTOTPUseCase,
+	NewLoginThrottle,
+	NewOAuthUseCase,
+	NewAPIKeyUseCase,
+	NewAPIKeyValidator,
+	NewUserAdminUseCase,
+	NewAccountDataUseCase,
+	NewAuditLogUseCase,
+	NewApplicationUseCase,
+	NewSavedJobUseCase,
+	NewJobAlertUseCase,
+	NewAlertNotifiers,
+	NewNotificationUseCase,
 )
 
 type Role string
 
 const (
-	RoleAdmin Role = "ADMIN"
-	RoleUser  Role = "USER"
+	RoleAdmin     Role = "ADMIN"
+	RoleUser      Role = "USER"
+	RoleRecruiter Role = "RECRUITER" // Thành viên của ít nhất một công ty
 )
+
+// Actor là user đã xác thực đang thực hiện thao tác
+type Actor struct {
+	UserID string
+	Role   Role
+}
+
+// IsAdmin reports whether the actor is an administrator
+func (a *Actor) IsAdmin() bool {
+	return a != nil && a.Role == RoleAdmin
+}
@@ -49,19 +49,28 @@ type CompanyFilter struct {
 // CompanyUseCase handles company business logic
 type CompanyUseCase struct {
 	companyRepo CompanyRepo
+	memberRepo  CompanyMemberRepo
+	userRepo    UserRepo
+	audit       AuditRecorder
 	log         *log.Helper
 }
 
 // NewCompanyUseCase creates a new company use case
-func NewCompanyUseCase(companyRepo CompanyRepo, logger log.Logger) *CompanyUseCase {
+func NewCompanyUseCase(companyRepo CompanyRepo, memberRepo CompanyMemberRepo, userRepo UserRepo, audit AuditRecorder, logger log.Logger) *CompanyUseCase {
 	return &CompanyUseCase{
 		companyRepo: companyRepo,
+		memberRepo:  memberRepo,
+		userRepo:    userRepo,
+		audit:       audit,
 		log:         log.NewHelper(logger),
 	}
 }
 
-// CreateCompany creates a new company
-func (uc *CompanyUseCase) CreateCompany(ctx context.Context, company *Company) (*Company, error) {
+// CreateCompany creates a new company, the creator becomes its owner
+func (uc *CompanyUseCase) CreateCompany(ctx context.Context, actor *Actor, company *Company) (*Company, error) {
+	if actor == nil || actor.UserID == "" {
+		return nil, ErrUnauthorizedJobAction
+	}
 
 	// Check if company already exists
 	existingCompany, err := uc.companyRepo.GetCompanyByName(ctx, company.Name)
@@ -83,11 +92,32 @@ func (uc *CompanyUseCase) CreateCompany(ctx context.Context, company *Company) (
 		return nil, err
 	}
 
+	// Người tạo là owner của công ty
+	owner := &CompanyMember{
+		CompanyID: createdCompany.ID,
+		UserID:    actor.UserID,
+		Role:      MemberRoleOwner,
+		Status:    MemberStatusActive,
+		InvitedBy: actor.UserID,
+	}
+	if user, err := uc.userRepo.GetUserByID(ctx, actor.UserID); err == nil && user != nil {
+		owner.Email = user.Email
+	}
+	if _, err := uc.memberRepo.CreateMember(ctx, owner); err != nil {
+		uc.log.Errorf("failed to create company owner: %v", err)
+		return nil, err
+	}
+	if err := uc.promoteToRecruiter(ctx, actor.UserID); err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, actor, AuditCompanyCreated, createdCompany.ID, nil, companyAuditFields(createdCompany))
+
 	return createdCompany, nil
 }
 
-// UpdateCompany updates an existing company
-func (uc *CompanyUseCase) UpdateCompany(ctx context.Context, company *Company) (*Company, error) {
+// UpdateCompany updates an existing company (members only)
+func (uc *CompanyUseCase) UpdateCompany(ctx context.Context, actor *Actor, company *Company) (*Company, error) {
 
 	// Get existing company
 	existingCompany, err := uc.companyRepo.GetCompany(ctx, company.ID)
@@ -98,6 +128,11 @@ func (uc *CompanyUseCase) UpdateCompany(ctx context.Context, company *Company) (
 		return nil, ErrCompanyNotFound
 	}
 
+	// Only members of the company may edit its profile
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, company.ID); err != nil {
+		return nil, err
+	}
+
 	// Validate company data
 	if err := uc.validateCompany(company); err != nil {
 		return nil, err
@@ -113,12 +148,16 @@ func (uc *CompanyUseCase) UpdateCompany(ctx context.Context, company *Company) (
 	if err != nil {
 		return nil, err
 	}
+	if updatedCompany != nil {
+		before, after := auditDiff(companyAuditFields(existingCompany), companyAuditFields(updatedCompany))
+		uc.record(ctx, actor, AuditCompanyUpdated, company.ID, before, after)
+	}
 
 	return updatedCompany, nil
 }
 
-// DeleteCompany deletes a company
-func (uc *CompanyUseCase) DeleteCompany(ctx context.Context, id string) error {
+// DeleteCompany deletes a company (owners only)
+func (uc *CompanyUseCase) DeleteCompany(ctx context.Context, actor *Actor, id string) error {
 
 	// Get existing company
 	existingCompany, err := uc.companyRepo.GetCompany(ctx, id)
@@ -129,11 +168,17 @@ func (uc *CompanyUseCase) DeleteCompany(ctx context.Context, id string) error {
 		return ErrCompanyNotFound
 	}
 
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, id, MemberRoleOwner); err != nil {
+		return err
+	}
+
 	// Delete company
 	if err := uc.companyRepo.DeleteCompany(ctx, id); err != nil {
 		return err
 	}
 
+	uc.record(ctx, actor, AuditCompanyDeleted, id, companyAuditFields(existingCompany), nil)
+
 	return nil
 }
 
@@ -170,6 +215,32 @@ func (uc *CompanyUseCase) ListCompanies(ctx context.Context, filter *CompanyFilt
 	return companies, total, nil
 }
 
+// record writes an audit entry of a change made by actor to a company
+func (uc *CompanyUseCase) record(ctx context.Context, actor *Actor, action, companyID string, before, after map[string]interface{}) {
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actor.UserID,
+		Action:     action,
+		TargetType: AuditTargetCompany,
+		TargetID:   companyID,
+		Before:     before,
+		After:      after,
+	})
+}
+
+// companyAuditFields lists the company fields written to the audit log
+func companyAuditFields(company *Company) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         company.Name,
+		"description":  company.Description,
+		"website":      company.Website,
+		"logo_url":     company.LogoURL,
+		"industry":     company.Industry,
+		"company_size": company.CompanySize,
+		"location":     company.Location,
+		"founded_year": company.FoundedYear,
+	}
+}
+
 // validateCompany validates company data
 func (uc *CompanyUseCase) validateCompany(company *Company) error {
 	if company.Name == "" {
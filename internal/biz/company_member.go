@@ -0,0 +1,248 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrCompanyMemberNotFound      = errors.New("company member not found")
+	ErrCompanyMemberAlreadyExists = errors.New("company member already exists")
+	ErrLastCompanyOwner           = errors.New("company must keep at least one owner")
+)
+
+// MemberRole là vai trò của user trong một công ty
+type MemberRole string
+
+const (
+	MemberRoleOwner     MemberRole = "OWNER"
+	MemberRoleRecruiter MemberRole = "RECRUITER"
+)
+
+// MemberStatus là trạng thái membership
+type MemberStatus string
+
+const (
+	MemberStatusPending MemberStatus = "PENDING" // Đã được mời, chưa chấp nhận
+	MemberStatusActive  MemberStatus = "ACTIVE"
+)
+
+// CompanyMember links a user to a company
+type CompanyMember struct {
+	ID         string
+	CompanyID  string
+	UserID     string
+	Email      string
+	Role       MemberRole
+	Status     MemberStatus
+	InvitedBy  string
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// CompanyMemberRepo interface
+type CompanyMemberRepo interface {
+	CreateMember(ctx context.Context, member *CompanyMember) (*CompanyMember, error)
+	GetMember(ctx context.Context, companyID, userID string) (*CompanyMember, error)
+	ListMembers(ctx context.Context, companyID string) ([]*CompanyMember, error)
+	ActivateMember(ctx context.Context, id string) (*CompanyMember, error)
+	DeleteMember(ctx context.Context, id string) error
+	CountActiveOwners(ctx context.Context, companyID string) (int64, error)
+}
+
+// authorizeCompanyAction trả về ErrUnauthorizedJobAction nếu actor không phải thành viên
+// ACTIVE của công ty với một trong các roles (rỗng = mọi role). Admin luôn được phép.
+func authorizeCompanyAction(ctx context.Context, memberRepo CompanyMemberRepo, actor *Actor, companyID string, roles ...MemberRole) error {
+	if actor == nil || actor.UserID == "" {
+		return ErrUnauthorizedJobAction
+	}
+	if actor.IsAdmin() {
+		return nil
+	}
+
+	member, err := memberRepo.GetMember(ctx, companyID, actor.UserID)
+	if err != nil {
+		return err
+	}
+	if member == nil || member.Status != MemberStatusActive {
+		return ErrUnauthorizedJobAction
+	}
+	if len(roles) == 0 {
+		return nil
+	}
+	for _, role := range roles {
+		if member.Role == role {
+			return nil
+		}
+	}
+
+	return ErrUnauthorizedJobAction
+}
+
+// InviteMember mời user đã đăng ký (theo email) vào công ty. Chỉ owner được mời.
+func (uc *CompanyUseCase) InviteMember(ctx context.Context, actor *Actor, companyID, email string, role MemberRole) (*CompanyMember, error) {
+	uc.log.WithContext(ctx).Infof("InviteMember: %s -> %s", email, companyID)
+
+	if err := uc.requireCompany(ctx, companyID); err != nil {
+		return nil, err
+	}
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, companyID, MemberRoleOwner); err != nil {
+		return nil, err
+	}
+
+	if role == "" {
+		role = MemberRoleRecruiter
+	}
+	if role != MemberRoleOwner && role != MemberRoleRecruiter {
+		return nil, ErrInvalidCompanyData
+	}
+
+	user, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	existing, err := uc.memberRepo.GetMember(ctx, companyID, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrCompanyMemberAlreadyExists
+	}
+
+	member := &CompanyMember{
+		CompanyID: companyID,
+		UserID:    user.UserID,
+		Email:     user.Email,
+		Role:      role,
+		Status:    MemberStatusPending,
+		InvitedBy: actor.UserID,
+	}
+
+	createdMember, err := uc.memberRepo.CreateMember(ctx, member)
+	if err != nil {
+		uc.log.Errorf("failed to create company member: %v", err)
+		return nil, err
+	}
+
+	return createdMember, nil
+}
+
+// AcceptInvitation chấp nhận lời mời vào công ty của chính actor
+func (uc *CompanyUseCase) AcceptInvitation(ctx context.Context, actor *Actor, companyID string) (*CompanyMember, error) {
+	uc.log.WithContext(ctx).Infof("AcceptInvitation: %s -> %s", actor.UserID, companyID)
+
+	member, err := uc.memberRepo.GetMember(ctx, companyID, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.Status != MemberStatusPending {
+		return nil, ErrCompanyMemberNotFound
+	}
+
+	activeMember, err := uc.memberRepo.ActivateMember(ctx, member.ID)
+	if err != nil {
+		uc.log.Errorf("failed to activate company member: %v", err)
+		return nil, err
+	}
+	if activeMember == nil {
+		return nil, ErrCompanyMemberNotFound
+	}
+
+	if err := uc.promoteToRecruiter(ctx, actor.UserID); err != nil {
+		return nil, err
+	}
+
+	return activeMember, nil
+}
+
+// RemoveMember xóa thành viên hoặc hủy lời mời. Owner xóa được mọi người, member tự rời được.
+func (uc *CompanyUseCase) RemoveMember(ctx context.Context, actor *Actor, companyID, userID string) error {
+	uc.log.WithContext(ctx).Infof("RemoveMember: %s from %s", userID, companyID)
+
+	if actor == nil || actor.UserID != userID {
+		if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, companyID, MemberRoleOwner); err != nil {
+			return err
+		}
+	}
+
+	member, err := uc.memberRepo.GetMember(ctx, companyID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrCompanyMemberNotFound
+	}
+
+	// Không được xóa owner cuối cùng
+	if member.Role == MemberRoleOwner && member.Status == MemberStatusActive {
+		owners, err := uc.memberRepo.CountActiveOwners(ctx, companyID)
+		if err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return ErrLastCompanyOwner
+		}
+	}
+
+	if err := uc.memberRepo.DeleteMember(ctx, member.ID); err != nil {
+		uc.log.Errorf("failed to delete company member: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListMembers lists members and pending invitations of a company (members only)
+func (uc *CompanyUseCase) ListMembers(ctx context.Context, actor *Actor, companyID string) ([]*CompanyMember, error) {
+	uc.log.WithContext(ctx).Infof("ListMembers: %s", companyID)
+
+	if err := uc.requireCompany(ctx, companyID); err != nil {
+		return nil, err
+	}
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, companyID); err != nil {
+		return nil, err
+	}
+
+	return uc.memberRepo.ListMembers(ctx, companyID)
+}
+
+// requireCompany returns ErrCompanyNotFound if the company does not exist
+func (uc *CompanyUseCase) requireCompany(ctx context.Context, companyID string) error {
+	company, err := uc.companyRepo.GetCompany(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	if company == nil {
+		return ErrCompanyNotFound
+	}
+	return nil
+}
+
+// promoteToRecruiter nâng role USER thành RECRUITER khi user trở thành thành viên công ty.
+// Chỉ cập nhật role (và chỉ khi role vẫn là USER) để không ghi đè thay đổi đồng thời
+// của user (reset mật khẩu, admin deactivate). Token mới (sau khi refresh) sẽ mang role mới.
+func (uc *CompanyUseCase) promoteToRecruiter(ctx context.Context, userID string) error {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+	if user.Role != RoleUser {
+		return nil
+	}
+
+	if _, err := uc.userRepo.ChangeUserRole(ctx, userID, RoleUser, RoleRecruiter); err != nil {
+		uc.log.Errorf("failed to promote user to recruiter: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package biz
+
+import (
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/configx"
+	"JobblyBE/pkg/mailer"
+	"JobblyBE/pkg/middleware/auth"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrEmailAlreadyVerified     = errors.New("email is already verified")
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+)
+
+// EmailVerificationUseCase handles the email verification flow of new accounts
+type EmailVerificationUseCase struct {
+	userRepo   UserRepo
+	mailer     mailer.Mailer
+	keys       *auth.KeySet
+	appBaseURL string
+	log        *log.Helper
+}
+
+// NewEmailVerificationUseCase creates a new EmailVerificationUseCase
+func NewEmailVerificationUseCase(userRepo UserRepo, m mailer.Mailer, keys *auth.KeySet, c *conf.Server, logger log.Logger) *EmailVerificationUseCase {
+	return &EmailVerificationUseCase{
+		userRepo:   userRepo,
+		mailer:     m,
+		keys:       keys,
+		appBaseURL: strings.TrimRight(configx.GetEnvOrString("APP_BASE_URL", c.AppBaseUrl), "/"),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// SendVerification gửi link xác thực mới cho user, các link gửi trước đó hết hiệu lực
+func (uc *EmailVerificationUseCase) SendVerification(ctx context.Context, user *User) error {
+	uc.log.WithContext(ctx).Infof("SendVerification: %s", user.Email)
+
+	if user.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+
+	token, claims, err := auth.GenerateEmailVerificationToken(user.UserID, user.Email, uc.keys)
+	if err != nil {
+		uc.log.Errorf("failed to generate verification token: %v", err)
+		return err
+	}
+
+	// Chỉ link mới nhất được chấp nhận
+	if err := uc.userRepo.SetEmailVerificationToken(ctx, user.UserID, claims.ID); err != nil {
+		uc.log.Errorf("failed to store verification token: %v", err)
+		return err
+	}
+
+	link := uc.appBaseURL + "/verify-email?token=" + url.QueryEscape(token)
+	msg := &mailer.Message{
+		To:      []string{user.Email},
+		Subject: "Xác thực email tài khoản Jobbly",
+		TextBody: fmt.Sprintf(
+			"Xin chào %s,\n\nVui lòng xác thực email của bạn bằng cách mở link sau (hết hạn sau %d giờ):\n%s\n\nNếu bạn không đăng ký tài khoản Jobbly, hãy bỏ qua email này.\n",
+			user.FullName, int(auth.EmailVerificationTokenDuration.Hours()), link,
+		),
+	}
+	if err := uc.mailer.Send(ctx, msg); err != nil {
+		uc.log.Errorf("failed to send verification email: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ResendVerification gửi lại email xác thực cho user
+func (uc *EmailVerificationUseCase) ResendVerification(ctx context.Context, userID string) error {
+	uc.log.WithContext(ctx).Infof("ResendVerification: %s", userID)
+
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	return uc.SendVerification(ctx, user)
+}
+
+// VerifyEmail xác thực email bằng token trong link. Mỗi token chỉ dùng được một lần.
+func (uc *EmailVerificationUseCase) VerifyEmail(ctx context.Context, token string) (*User, error) {
+	claims, err := auth.ValidateToken(token, uc.keys, auth.EmailVerificationToken)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("invalid verification token: %v", err)
+		return nil, ErrInvalidVerificationToken
+	}
+
+	uc.log.WithContext(ctx).Infof("VerifyEmail: %s", claims.UserID)
+
+	user, err := uc.userRepo.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrInvalidVerificationToken
+	}
+	if user.EmailVerified {
+		return nil, ErrEmailAlreadyVerified
+	}
+	// Token cấp cho email cũ không xác thực được email hiện tại
+	if user.Email != claims.Email {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	verified, err := uc.userRepo.MarkEmailVerified(ctx, user.UserID, claims.ID)
+	if err != nil {
+		uc.log.Errorf("failed to mark email verified: %v", err)
+		return nil, err
+	}
+	if !verified {
+		// Token đã dùng hoặc đã có link mới hơn
+		return nil, ErrInvalidVerificationToken
+	}
+
+	user.EmailVerified = true
+	return user, nil
+}
@@ -0,0 +1,215 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// fakeClock is a settable clock for SetClock
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// memoryUserRepo stores users in memory, các method khác của UserRepo không được dùng trong test
+type memoryUserRepo struct {
+	UserRepo
+	users map[string]*User
+}
+
+func (r *memoryUserRepo) GetUserByID(ctx context.Context, id string) (*User, error) {
+	return r.users[id], nil
+}
+
+func (r *memoryUserRepo) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	for _, u := range r.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+// memoryTOTPRepo is an in-memory TOTPRepo
+type memoryTOTPRepo struct {
+	users map[string]*UserTOTP
+}
+
+func (r *memoryTOTPRepo) GetUserTOTP(ctx context.Context, userID string) (*UserTOTP, error) {
+	return r.users[userID], nil
+}
+
+func (r *memoryTOTPRepo) SavePendingSecret(ctx context.Context, userID, secret string) error {
+	r.users[userID] = &UserTOTP{UserID: userID, Secret: secret}
+	return nil
+}
+
+func (r *memoryTOTPRepo) Enable(ctx context.Context, userID string, recoveryCodeHashes []string, lastUsedStep int64) error {
+	t := r.users[userID]
+	t.Enabled = true
+	t.RecoveryCodeHashes = recoveryCodeHashes
+	t.LastUsedStep = lastUsedStep
+	return nil
+}
+
+func (r *memoryTOTPRepo) UseStep(ctx context.Context, userID string, step int64) (bool, error) {
+	t := r.users[userID]
+	if t == nil || step <= t.LastUsedStep {
+		return false, nil
+	}
+	t.LastUsedStep = step
+	return true, nil
+}
+
+func (r *memoryTOTPRepo) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	t := r.users[userID]
+	if t == nil {
+		return false, nil
+	}
+	for i, h := range t.RecoveryCodeHashes {
+		if h == codeHash {
+			t.RecoveryCodeHashes = append(t.RecoveryCodeHashes[:i], t.RecoveryCodeHashes[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// memoryLoginAttemptRepo is an in-memory LoginAttemptRepo
+type memoryLoginAttemptRepo struct {
+	mu       sync.Mutex
+	attempts map[string]*memoryLoginAttempt
+}
+
+type memoryLoginAttempt struct {
+	failures    []time.Time
+	lockouts    int
+	lockedUntil *time.Time
+}
+
+func newMemoryLoginAttemptRepo() *memoryLoginAttemptRepo {
+	return &memoryLoginAttemptRepo{attempts: make(map[string]*memoryLoginAttempt)}
+}
+
+func (r *memoryLoginAttemptRepo) GetLoginAttempt(ctx context.Context, key string) (*LoginAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, ok := r.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+	return a.toBiz(key), nil
+}
+
+func (r *memoryLoginAttemptRepo) AddLoginFailure(ctx context.Context, key string, at, windowStart, expiresAt time.Time) (*LoginAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, ok := r.attempts[key]
+	if !ok {
+		a = &memoryLoginAttempt{}
+		r.attempts[key] = a
+	}
+	failures := a.failures[:0]
+	for _, f := range a.failures {
+		if !f.Before(windowStart) {
+			failures = append(failures, f)
+		}
+	}
+	a.failures = append(failures, at)
+	return a.toBiz(key), nil
+}
+
+func (r *memoryLoginAttemptRepo) LockLogin(ctx context.Context, key string, at, until, expiresAt time.Time) (*LoginAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, ok := r.attempts[key]
+	if !ok {
+		a = &memoryLoginAttempt{}
+		r.attempts[key] = a
+	}
+	if a.lockedUntil != nil && a.lockedUntil.After(at) {
+		return nil, nil
+	}
+	a.lockouts++
+	a.lockedUntil = &until
+	a.failures = nil
+	return a.toBiz(key), nil
+}
+
+func (r *memoryLoginAttemptRepo) DeleteLoginAttempt(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.attempts, key)
+	return nil
+}
+
+func (a *memoryLoginAttempt) toBiz(key string) *LoginAttempt {
+	return &LoginAttempt{Key: key, Failures: len(a.failures), Lockouts: a.lockouts, LockedUntil: a.lockedUntil}
+}
+
+func (r *memoryUserRepo) CreateUser(ctx context.Context, user *User) (*User, error) {
+	created := *user
+	created.UserID = fmt.Sprintf("user-%d", len(r.users)+1)
+	r.users[created.UserID] = &created
+	return &created, nil
+}
+
+func (r *memoryUserRepo) UpdateLastLogin(ctx context.Context, userID string) error {
+	return nil
+}
+
+// memoryOAuthStateRepo is an in-memory OAuthStateRepo
+type memoryOAuthStateRepo struct {
+	now    func() time.Time
+	states map[string]*OAuthState
+}
+
+func (r *memoryOAuthStateRepo) CreateOAuthState(ctx context.Context, state *OAuthState) error {
+	r.states[state.StateHash] = state
+	return nil
+}
+
+func (r *memoryOAuthStateRepo) ConsumeOAuthState(ctx context.Context, stateHash string) (*OAuthState, error) {
+	state, ok := r.states[stateHash]
+	delete(r.states, stateHash)
+	if !ok || !state.ExpiresAt.After(r.now()) {
+		return nil, nil
+	}
+	return state, nil
+}
+
+// memoryIdentityRepo is an in-memory UserIdentityRepo
+type memoryIdentityRepo struct {
+	identities map[string]*UserIdentity
+}
+
+func (r *memoryIdentityRepo) GetIdentity(ctx context.Context, provider, subject string) (*UserIdentity, error) {
+	return r.identities[provider+"|"+subject], nil
+}
+
+func (r *memoryIdentityRepo) CreateIdentity(ctx context.Context, identity *UserIdentity) error {
+	key := identity.Provider + "|" + identity.Subject
+	if _, ok := r.identities[key]; ok {
+		return ErrIdentityAlreadyLinked
+	}
+	r.identities[key] = identity
+	return nil
+}
@@ -56,7 +56,10 @@ type JobPosting struct {
 	Requirements          string
 	Benefits              string
 	JobTech               []string
+	Status                JobStatus
+	ExpiresAt             *time.Time // nil = không hết hạn
 	CreatedAt             time.Time
+	Highlights            []JobHighlight // chỉ có khi list theo keyword
 }
 
 // JobPostingRepo interface
@@ -66,6 +69,17 @@ type JobPostingRepo interface {
 	DeleteJobPosting(ctx context.Context, id string) error
 	GetJobPosting(ctx context.Context, id string) (*JobPosting, error)
 	ListJobPostings(ctx context.Context, filter *JobFilter, page, pageSize int32) ([]*JobPosting, int32, error)
+	// JobFacets counts the jobs matching filter by facet, mỗi facet bỏ qua filter của chính nó
+	JobFacets(ctx context.Context, filter *JobFilter) (*JobFacets, error)
+	// UpdateJobStatus moves a job from status from to status to, trả về false nếu status đã bị đổi
+	UpdateJobStatus(ctx context.Context, id string, from, to JobStatus, postedAt *time.Time) (bool, error)
+	// ListExpiredJobPostings returns published or paused jobs whose expires_at is not after now
+	ListExpiredJobPostings(ctx context.Context, now time.Time, limit int) ([]*JobPosting, error)
+	// ListScheduledJobPostings returns unexpired drafts whose posted_at is not after now
+	ListScheduledJobPostings(ctx context.Context, now time.Time, limit int) ([]*JobPosting, error)
+	// ListPublishedJobPostingsSince returns published, unexpired jobs with posted_at up to until and
+	// (posted_at, id) after (since, afterID), ordered by (posted_at, id). afterID rỗng thì lấy từ since trở đi.
+	ListPublishedJobPostingsSince(ctx context.Context, since time.Time, afterID string, until time.Time, limit int) ([]*JobPosting, error)
 }
 
 // JobFilter for filtering and searching jobs
@@ -76,26 +90,35 @@ type JobFilter struct {
 	Level      Level
 	Keyword    string
 	JobTech    []string
+	Status     JobStatus // status hiện tại (đã tính hết hạn), rỗng = mọi status
+	PublicOnly bool      // chỉ job đang PUBLISHED và chưa hết hạn
+	Sort       JobSort   // rỗng = JobSortNewest
+	// SalaryBucket: key của SalaryBuckets, SalaryBucketNegotiable hoặc SalaryBucketOther
+	SalaryBucket string
 }
 
 // JobPostingUseCase handles job posting business logic
 type JobPostingUseCase struct {
 	jobRepo     JobPostingRepo
 	companyRepo CompanyRepo
+	memberRepo  CompanyMemberRepo
+	audit       AuditRecorder
 	log         *log.Helper
 }
 
 // NewJobPostingUseCase creates a new job posting use case
-func NewJobPostingUseCase(jobRepo JobPostingRepo, companyRepo CompanyRepo, logger log.Logger) *JobPostingUseCase {
+func NewJobPostingUseCase(jobRepo JobPostingRepo, companyRepo CompanyRepo, memberRepo CompanyMemberRepo, audit AuditRecorder, logger log.Logger) *JobPostingUseCase {
 	return &JobPostingUseCase{
 		jobRepo:     jobRepo,
 		companyRepo: companyRepo,
+		memberRepo:  memberRepo,
+		audit:       audit,
 		log:         log.NewHelper(logger),
 	}
 }
 
-// CreateJobPosting creates a new job posting
-func (uc *JobPostingUseCase) CreateJobPosting(ctx context.Context, job *JobPosting) (*JobPosting, error) {
+// CreateJobPosting creates a new job posting (company members only)
+func (uc *JobPostingUseCase) CreateJobPosting(ctx context.Context, actor *Actor, job *JobPosting) (*JobPosting, error) {
 	uc.log.WithContext(ctx).Infof("CreateJobPosting: %s", job.Title)
 
 	// Validate company exists
@@ -107,11 +130,40 @@ func (uc *JobPostingUseCase) CreateJobPosting(ctx context.Context, job *JobPosti
 		return nil, ErrCompanyNotFound
 	}
 
+	// Only members of the company may post its jobs
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, job.CompanyID); err != nil {
+		return nil, err
+	}
+
 	// Validate job data
 	if err := uc.validateJobPosting(job); err != nil {
 		return nil, err
 	}
 
+	// Job mới là bản nháp hoặc được publish ngay (mặc định, giữ hành vi cũ)
+	now := time.Now()
+	if job.Status == "" {
+		job.Status = JobStatusPublished
+	}
+	if job.Status != JobStatusDraft && job.Status != JobStatusPublished {
+		return nil, ErrInvalidJobStatus
+	}
+	if job.ExpiresAt != nil && !job.ExpiresAt.After(now) {
+		return nil, ErrInvalidJobData
+	}
+	// posted_at trong tương lai = hẹn giờ publish, job là bản nháp cho tới lúc đó
+	switch {
+	case job.PostedAt != nil && job.PostedAt.After(now):
+		if job.ExpiresAt != nil && !job.ExpiresAt.After(*job.PostedAt) {
+			return nil, ErrInvalidJobData
+		}
+		job.Status = JobStatusDraft
+	case job.Status == JobStatusPublished:
+		job.PostedAt = &now
+	default:
+		job.PostedAt = nil
+	}
+
 	// Create job posting
 	createdJob, err := uc.jobRepo.CreateJobPosting(ctx, job)
 	if err != nil {
@@ -122,11 +174,13 @@ func (uc *JobPostingUseCase) CreateJobPosting(ctx context.Context, job *JobPosti
 	// Attach company info
 	createdJob.Company = company
 
+	uc.record(ctx, actor, AuditJobCreated, createdJob.ID, nil, jobAuditFields(createdJob))
+
 	return createdJob, nil
 }
 
-// UpdateJobPosting updates an existing job posting
-func (uc *JobPostingUseCase) UpdateJobPosting(ctx context.Context, job *JobPosting) (*JobPosting, error) {
+// UpdateJobPosting updates an existing job posting (company members only)
+func (uc *JobPostingUseCase) UpdateJobPosting(ctx context.Context, actor *Actor, job *JobPosting) (*JobPosting, error) {
 	uc.log.WithContext(ctx).Infof("UpdateJobPosting: %s", job.ID)
 
 	// Get existing job
@@ -138,10 +192,35 @@ func (uc *JobPostingUseCase) UpdateJobPosting(ctx context.Context, job *JobPosti
 		return nil, ErrJobNotFound
 	}
 
+	// A job cannot move to another company
+	job.CompanyID = existingJob.CompanyID
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, existingJob.CompanyID); err != nil {
+		return nil, err
+	}
+
+	// Job đã đóng không được sửa nữa
+	if existingJob.Status == JobStatusClosed {
+		return nil, ErrInvalidJobStatus
+	}
+
 	// Validate job data
 	if err := uc.validateJobPosting(job); err != nil {
 		return nil, err
 	}
+	now := time.Now()
+	if job.ExpiresAt != nil && !job.ExpiresAt.After(now) {
+		return nil, ErrInvalidJobData
+	}
+
+	// Bản nháp có thể hẹn, đổi hoặc bỏ giờ publish (posted_at),
+	// các status khác giữ posted_at của lần publish đầu tiên
+	if existingJob.Status == JobStatusDraft {
+		if job.PostedAt != nil && !job.PostedAt.After(now) {
+			return nil, ErrInvalidJobData
+		}
+	} else {
+		job.PostedAt = existingJob.PostedAt
+	}
 
 	// Update job posting
 	if err := uc.jobRepo.UpdateJobPosting(ctx, job); err != nil {
@@ -154,12 +233,16 @@ func (uc *JobPostingUseCase) UpdateJobPosting(ctx context.Context, job *JobPosti
 	if err != nil {
 		return nil, err
 	}
+	if updatedJob != nil {
+		before, after := auditDiff(jobAuditFields(existingJob), jobAuditFields(updatedJob))
+		uc.record(ctx, actor, AuditJobUpdated, job.ID, before, after)
+	}
 
 	return updatedJob, nil
 }
 
-// DeleteJobPosting deletes a job posting
-func (uc *JobPostingUseCase) DeleteJobPosting(ctx context.Context, id string) error {
+// DeleteJobPosting deletes a job posting (company members only)
+func (uc *JobPostingUseCase) DeleteJobPosting(ctx context.Context, actor *Actor, id string) error {
 	uc.log.WithContext(ctx).Infof("DeleteJobPosting: %s", id)
 
 	// Get existing job
@@ -171,17 +254,24 @@ func (uc *JobPostingUseCase) DeleteJobPosting(ctx context.Context, id string) er
 		return ErrJobNotFound
 	}
 
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, existingJob.CompanyID); err != nil {
+		return err
+	}
+
 	// Delete job posting
 	if err := uc.jobRepo.DeleteJobPosting(ctx, id); err != nil {
 		uc.log.Errorf("failed to delete job posting: %v", err)
 		return err
 	}
 
+	uc.record(ctx, actor, AuditJobDeleted, id, jobAuditFields(existingJob), nil)
+
 	return nil
 }
 
-// GetJobPosting retrieves a job posting by ID
-func (uc *JobPostingUseCase) GetJobPosting(ctx context.Context, id string) (*JobPosting, error) {
+// GetJobPosting retrieves a job posting by ID.
+// Job chưa publish hoặc đã hết hạn chỉ hiện với thành viên công ty (actor có thể nil).
+func (uc *JobPostingUseCase) GetJobPosting(ctx context.Context, actor *Actor, id string) (*JobPosting, error) {
 	uc.log.WithContext(ctx).Infof("GetJobPosting: %s", id)
 
 	job, err := uc.jobRepo.GetJobPosting(ctx, id)
@@ -192,13 +282,29 @@ func (uc *JobPostingUseCase) GetJobPosting(ctx context.Context, id string) (*Job
 		return nil, ErrJobNotFound
 	}
 
+	if !job.IsPublic(time.Now()) {
+		member, err := uc.isCompanyMember(ctx, actor, job.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		if !member {
+			return nil, ErrJobNotFound
+		}
+	}
+
 	return job, nil
 }
 
-// ListJobPostings lists job postings with filters and pagination
-func (uc *JobPostingUseCase) ListJobPostings(ctx context.Context, filter *JobFilter, page, pageSize int32) ([]*JobPosting, int32, error) {
+// ListJobPostings lists job postings with filters and pagination.
+// Mọi người chỉ thấy job đang PUBLISHED và chưa hết hạn; thành viên công ty lọc theo
+// company_id của mình thấy mọi job của công ty và có thể lọc theo status (actor có thể nil).
+func (uc *JobPostingUseCase) ListJobPostings(ctx context.Context, actor *Actor, filter *JobFilter, page, pageSize int32) ([]*JobPosting, int32, error) {
 	uc.log.WithContext(ctx).Info("ListJobPostings")
 
+	if err := uc.authorizeJobFilter(ctx, actor, filter); err != nil {
+		return nil, 0, err
+	}
+
 	// Validate pagination
 	if page < 1 {
 		page = 1
@@ -213,9 +319,91 @@ func (uc *JobPostingUseCase) ListJobPostings(ctx context.Context, filter *JobFil
 		return nil, 0, err
 	}
 
+	if terms := SearchTerms(filter.Keyword); len(terms) > 0 {
+		for _, job := range jobs {
+			job.Highlights = HighlightJob(job, terms)
+		}
+	}
+
 	return jobs, total, nil
 }
 
+// authorizeJobFilter validates a listing filter and sets filter.PublicOnly for actor
+func (uc *JobPostingUseCase) authorizeJobFilter(ctx context.Context, actor *Actor, filter *JobFilter) error {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return ErrInvalidJobStatus
+	}
+	if filter.Sort == "" {
+		filter.Sort = JobSortNewest
+	}
+	if !filter.Sort.IsValid() {
+		return ErrInvalidJobSort
+	}
+	if filter.SalaryBucket != "" && !IsValidSalaryBucket(filter.SalaryBucket) {
+		return ErrInvalidSalaryBucket
+	}
+
+	member := false
+	if filter.CompanyID != "" {
+		var err error
+		if member, err = uc.isCompanyMember(ctx, actor, filter.CompanyID); err != nil {
+			return err
+		}
+	}
+	filter.PublicOnly = !member
+	if filter.PublicOnly && filter.Status != "" && filter.Status != JobStatusPublished {
+		return ErrUnauthorizedJobAction
+	}
+	return nil
+}
+
+// isCompanyMember reports whether actor may see every job of the company
+func (uc *JobPostingUseCase) isCompanyMember(ctx context.Context, actor *Actor, companyID string) (bool, error) {
+	if actor == nil {
+		return false, nil
+	}
+	err := authorizeCompanyAction(ctx, uc.memberRepo, actor, companyID)
+	if errors.Is(err, ErrUnauthorizedJobAction) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// record writes an audit entry of a change made by actor to a job posting
+func (uc *JobPostingUseCase) record(ctx context.Context, actor *Actor, action, jobID string, before, after map[string]interface{}) {
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actor.UserID,
+		Action:     action,
+		TargetType: AuditTargetJob,
+		TargetID:   jobID,
+		Before:     before,
+		After:      after,
+	})
+}
+
+// jobAuditFields lists the job posting fields written to the audit log
+func jobAuditFields(job *JobPosting) map[string]interface{} {
+	return map[string]interface{}{
+		"company_id":             job.CompanyID,
+		"title":                  job.Title,
+		"level":                  string(job.Level),
+		"job_type":               string(job.JobType),
+		"salary_min":             job.SalaryMin,
+		"salary_max":             job.SalaryMax,
+		"salary_currency":        job.SalaryCurrency,
+		"location":               job.Location,
+		"experience_requirement": job.ExperienceRequirement,
+		"description":            job.Description,
+		"responsibilities":       job.Responsibilities,
+		"requirements":           job.Requirements,
+		"benefits":               job.Benefits,
+		"job_tech":               job.JobTech,
+		"status":                 string(job.Status),
+		"posted_at":              job.PostedAt,
+		"expires_at":             job.ExpiresAt,
+	}
+}
+
 // validateJobPosting validates job posting data
 func (uc *JobPostingUseCase) validateJobPosting(job *JobPosting) error {
 	if job.Title == "" {
@@ -0,0 +1,555 @@
+package biz
+
+import (
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/configx"
+	"JobblyBE/pkg/textnorm"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrSavedSearchNotFound     = errors.New("saved search not found")
+	ErrInvalidSavedSearch      = errors.New("invalid saved search")
+	ErrTooManySavedSearches    = errors.New("too many saved searches")
+	ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe token")
+)
+
+// Giới hạn của saved search và job alert
+const (
+	MaxSavedSearches         = 20
+	MaxSavedSearchNameLength = 100
+	MaxAlertJobsPerDigest    = 20
+	// alertMatchOverlap: mỗi lần match xem lại các job publish trong khoảng này trước lần chạy trước,
+	// để không bỏ sót job hẹn giờ được scheduler publish trễ (match trùng bị bỏ qua)
+	alertMatchOverlap = 5 * time.Minute
+	alertSearchBatch  = 500
+)
+
+// AlertFrequency là tần suất gửi job alert của một saved search
+type AlertFrequency string
+
+const (
+	AlertFrequencyInstant AlertFrequency = "INSTANT" // ngay khi job được publish (trong vòng một phút)
+	AlertFrequencyDaily   AlertFrequency = "DAILY"
+	AlertFrequencyWeekly  AlertFrequency = "WEEKLY"
+)
+
+// IsValid reports whether f is a known frequency
+func (f AlertFrequency) IsValid() bool {
+	return f == AlertFrequencyInstant || f == AlertFrequencyDaily || f == AlertFrequencyWeekly
+}
+
+// AlertChannel là kênh nhận job alert
+type AlertChannel string
+
+const (
+	AlertChannelEmail AlertChannel = "EMAIL"
+	AlertChannelInApp AlertChannel = "IN_APP"
+)
+
+// SavedSearch is a named job filter a candidate gets alerts for
+type SavedSearch struct {
+	ID               string
+	UserID           string
+	Name             string
+	Filter           *JobFilter // chỉ dùng CompanyID, Location, JobType, Level, Keyword, JobTech
+	Frequency        AlertFrequency
+	Channels         []AlertChannel
+	Muted            bool
+	UnsubscribeToken string // token trong link hủy đăng ký của email
+	LastNotifiedAt   *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// SavedSearchRepo interface
+type SavedSearchRepo interface {
+	CreateSavedSearch(ctx context.Context, search *SavedSearch) (*SavedSearch, error)
+	GetSavedSearch(ctx context.Context, id string) (*SavedSearch, error)
+	GetSavedSearchByUnsubscribeToken(ctx context.Context, token string) (*SavedSearch, error)
+	// ListSavedSearches returns the saved searches of a user, oldest first
+	ListSavedSearches(ctx context.Context, userID string) ([]*SavedSearch, error)
+	CountSavedSearches(ctx context.Context, userID string) (int64, error)
+	// UpdateSavedSearch updates the name, filter, frequency and channels of a saved search
+	UpdateSavedSearch(ctx context.Context, search *SavedSearch) error
+	SetSavedSearchMuted(ctx context.Context, id string, muted bool) error
+	SetSavedSearchNotified(ctx context.Context, id string, at time.Time) error
+	DeleteSavedSearch(ctx context.Context, id string) error
+	// ListActiveSavedSearches returns at most limit unmuted saved searches with ID after afterID, ordered by ID
+	ListActiveSavedSearches(ctx context.Context, afterID string, limit int) ([]*SavedSearch, error)
+}
+
+// AlertMatch is a job matching a saved search, chờ gửi cho tới khi có NotifiedAt
+type AlertMatch struct {
+	ID         string
+	SearchID   string
+	UserID     string
+	JobID      string
+	NotifiedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// AlertMatchRepo stores the matches of saved searches until they are delivered
+type AlertMatchRepo interface {
+	// CreateAlertMatches stores new matches, bỏ qua cặp (search, job) đã có. Trả về số match mới.
+	CreateAlertMatches(ctx context.Context, matches []*AlertMatch) (int, error)
+	// PendingAlertSearchIDs returns the IDs of the saved searches with frequency having undelivered matches,
+	// kèm các saved search đã bị xóa còn match sót lại
+	PendingAlertSearchIDs(ctx context.Context, frequency AlertFrequency) ([]string, error)
+	// ListPendingAlertMatches returns the undelivered matches of a saved search, oldest first
+	ListPendingAlertMatches(ctx context.Context, searchID string) ([]*AlertMatch, error)
+	MarkAlertMatchesNotified(ctx context.Context, ids []string, at time.Time) error
+	DeletePendingAlertMatches(ctx context.Context, searchID string) error
+	DeleteAlertMatches(ctx context.Context, searchID string) error
+	// GetAlertWatermark returns the position from which the next run looks for new jobs, nil nếu chưa chạy lần nào
+	GetAlertWatermark(ctx context.Context) (*AlertWatermark, error)
+	SetAlertWatermark(ctx context.Context, w AlertWatermark) error
+}
+
+// AlertWatermark is the (posted_at, job ID) position the job matcher continues from.
+// JobID rỗng nghĩa là lấy mọi job có posted_at từ PostedAt trở đi.
+type AlertWatermark struct {
+	PostedAt time.Time
+	JobID    string
+}
+
+// JobAlert is a digest of the new jobs matching a saved search
+type JobAlert struct {
+	User           *User
+	Search         *SavedSearch
+	Jobs           []*JobPosting // tối đa MaxAlertJobsPerDigest job, mới nhất sau
+	More           int           // số job khớp khác không có trong Jobs
+	UnsubscribeURL string
+	AppBaseURL     string
+}
+
+// AlertNotifier delivers job alerts through one channel
+type AlertNotifier interface {
+	Channel() AlertChannel
+	Notify(ctx context.Context, alert *JobAlert) error
+}
+
+// AlertNotifiers lists the notifier of each supported channel
+type AlertNotifiers []AlertNotifier
+
+// JobAlertUseCase handles saved searches and the job alerts generated from them
+type JobAlertUseCase struct {
+	searchRepo SavedSearchRepo
+	matchRepo  AlertMatchRepo
+	jobRepo    JobPostingRepo
+	userRepo   UserRepo
+	notifiers  AlertNotifiers
+	appBaseURL string
+	log        *log.Helper
+}
+
+// NewJobAlertUseCase creates a new job alert use case
+func NewJobAlertUseCase(searchRepo SavedSearchRepo, matchRepo AlertMatchRepo, jobRepo JobPostingRepo, userRepo UserRepo, notifiers AlertNotifiers, c *conf.Server, logger log.Logger) *JobAlertUseCase {
+	return &JobAlertUseCase{
+		searchRepo: searchRepo,
+		matchRepo:  matchRepo,
+		jobRepo:    jobRepo,
+		userRepo:   userRepo,
+		notifiers:  notifiers,
+		appBaseURL: strings.TrimRight(configx.GetEnvOrString("APP_BASE_URL", c.AppBaseUrl), "/"),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// CreateSavedSearch saves a named job filter of actor.
+// Mặc định gửi DAILY qua mọi kênh.
+func (uc *JobAlertUseCase) CreateSavedSearch(ctx context.Context, actor *Actor, search *SavedSearch) (*SavedSearch, error) {
+	uc.log.WithContext(ctx).Infof("CreateSavedSearch: %s", actor.UserID)
+
+	if err := validateSavedSearch(search); err != nil {
+		return nil, err
+	}
+
+	count, err := uc.searchRepo.CountSavedSearches(ctx, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxSavedSearches {
+		return nil, ErrTooManySavedSearches
+	}
+
+	token, err := newResetToken()
+	if err != nil {
+		return nil, err
+	}
+	search.UserID = actor.UserID
+	search.UnsubscribeToken = token
+	search.Muted = false
+
+	return uc.searchRepo.CreateSavedSearch(ctx, search)
+}
+
+// ListSavedSearches lists the saved searches of actor
+func (uc *JobAlertUseCase) ListSavedSearches(ctx context.Context, actor *Actor) ([]*SavedSearch, error) {
+	return uc.searchRepo.ListSavedSearches(ctx, actor.UserID)
+}
+
+// GetSavedSearch returns a saved search of actor
+func (uc *JobAlertUseCase) GetSavedSearch(ctx context.Context, actor *Actor, id string) (*SavedSearch, error) {
+	search, err := uc.searchRepo.GetSavedSearch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if search == nil || search.UserID != actor.UserID {
+		return nil, ErrSavedSearchNotFound
+	}
+	return search, nil
+}
+
+// UpdateSavedSearch updates the name, filter, frequency and channels of a saved search of actor.
+// Match chưa gửi sẽ được gửi theo tần suất mới.
+func (uc *JobAlertUseCase) UpdateSavedSearch(ctx context.Context, actor *Actor, search *SavedSearch) (*SavedSearch, error) {
+	uc.log.WithContext(ctx).Infof("UpdateSavedSearch: %s", search.ID)
+
+	if _, err := uc.GetSavedSearch(ctx, actor, search.ID); err != nil {
+		return nil, err
+	}
+	if err := validateSavedSearch(search); err != nil {
+		return nil, err
+	}
+
+	if err := uc.searchRepo.UpdateSavedSearch(ctx, search); err != nil {
+		return nil, err
+	}
+	return uc.searchRepo.GetSavedSearch(ctx, search.ID)
+}
+
+// DeleteSavedSearch deletes a saved search of actor and its matches
+func (uc *JobAlertUseCase) DeleteSavedSearch(ctx context.Context, actor *Actor, id string) error {
+	uc.log.WithContext(ctx).Infof("DeleteSavedSearch: %s", id)
+
+	if _, err := uc.GetSavedSearch(ctx, actor, id); err != nil {
+		return err
+	}
+	if err := uc.searchRepo.DeleteSavedSearch(ctx, id); err != nil {
+		return err
+	}
+	return uc.matchRepo.DeleteAlertMatches(ctx, id)
+}
+
+// MuteSavedSearch stops or resumes the alerts of a saved search of actor.
+// Job khớp trong lúc tắt thông báo sẽ không được gửi.
+func (uc *JobAlertUseCase) MuteSavedSearch(ctx context.Context, actor *Actor, id string, muted bool) (*SavedSearch, error) {
+	uc.log.WithContext(ctx).Infof("MuteSavedSearch: %s %v", id, muted)
+
+	if _, err := uc.GetSavedSearch(ctx, actor, id); err != nil {
+		return nil, err
+	}
+	if err := uc.setMuted(ctx, id, muted); err != nil {
+		return nil, err
+	}
+	return uc.searchRepo.GetSavedSearch(ctx, id)
+}
+
+// Unsubscribe mutes the saved search of an unsubscribe link (không cần đăng nhập)
+func (uc *JobAlertUseCase) Unsubscribe(ctx context.Context, token string) (*SavedSearch, error) {
+	if token == "" {
+		return nil, ErrInvalidUnsubscribeToken
+	}
+	search, err := uc.searchRepo.GetSavedSearchByUnsubscribeToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if search == nil {
+		return nil, ErrInvalidUnsubscribeToken
+	}
+
+	uc.log.WithContext(ctx).Infof("Unsubscribe: %s", search.ID)
+
+	if err := uc.setMuted(ctx, search.ID, true); err != nil {
+		return nil, err
+	}
+	search.Muted = true
+	return search, nil
+}
+
+func (uc *JobAlertUseCase) setMuted(ctx context.Context, id string, muted bool) error {
+	if err := uc.searchRepo.SetSavedSearchMuted(ctx, id, muted); err != nil {
+		return err
+	}
+	if muted {
+		return uc.matchRepo.DeletePendingAlertMatches(ctx, id)
+	}
+	return nil
+}
+
+// MatchNewJobs matches at most limit newly published jobs against every active saved search
+// and returns the number of new matches (chạy bởi scheduler)
+func (uc *JobAlertUseCase) MatchNewJobs(ctx context.Context, now time.Time, limit int) (int, error) {
+	from := AlertWatermark{PostedAt: now.Add(-alertMatchOverlap)}
+	watermark, err := uc.matchRepo.GetAlertWatermark(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// Watermark có JobID là chỗ lần trước dừng lại vì đủ limit: tiếp tục đúng từ job đó
+	if watermark != nil && (watermark.JobID != "" || watermark.PostedAt.Before(from.PostedAt)) {
+		from = *watermark
+	}
+
+	jobs, err := uc.jobRepo.ListPublishedJobPostingsSince(ctx, from.PostedAt, from.JobID, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	afterID := ""
+	for len(jobs) > 0 {
+		searches, err := uc.searchRepo.ListActiveSavedSearches(ctx, afterID, alertSearchBatch)
+		if err != nil {
+			return created, err
+		}
+
+		var matches []*AlertMatch
+		for _, search := range searches {
+			for _, job := range jobs {
+				if search.Filter.Matches(job) {
+					matches = append(matches, &AlertMatch{SearchID: search.ID, UserID: search.UserID, JobID: job.ID})
+				}
+			}
+		}
+		if len(matches) > 0 {
+			n, err := uc.matchRepo.CreateAlertMatches(ctx, matches)
+			if err != nil {
+				return created, err
+			}
+			created += n
+		}
+
+		if len(searches) < alertSearchBatch {
+			break
+		}
+		afterID = searches[len(searches)-1].ID
+	}
+
+	// Còn job chưa xem (đủ limit): lần sau tiếp tục sau job cuối cùng theo (posted_at, id),
+	// để nhiều job cùng posted_at không làm matcher đứng yên
+	next := AlertWatermark{PostedAt: now.Add(-alertMatchOverlap)}
+	if len(jobs) == limit && jobs[len(jobs)-1].PostedAt != nil {
+		last := jobs[len(jobs)-1]
+		next = AlertWatermark{PostedAt: *last.PostedAt, JobID: last.ID}
+	}
+	if err := uc.matchRepo.SetAlertWatermark(ctx, next); err != nil {
+		return created, err
+	}
+
+	return created, nil
+}
+
+// SendJobAlerts delivers the pending matches of the saved searches with frequency
+// as one digest per search and returns the number of digests sent (chạy bởi scheduler)
+func (uc *JobAlertUseCase) SendJobAlerts(ctx context.Context, now time.Time, frequency AlertFrequency) (int, error) {
+	ids, err := uc.matchRepo.PendingAlertSearchIDs(ctx, frequency)
+	if err != nil {
+		return 0, err
+	}
+
+	sent, failed := 0, 0
+	for _, id := range ids {
+		search, err := uc.searchRepo.GetSavedSearch(ctx, id)
+		if err != nil {
+			return sent, err
+		}
+		switch {
+		case search == nil:
+			err = uc.matchRepo.DeleteAlertMatches(ctx, id)
+		case search.Muted:
+			err = uc.matchRepo.DeletePendingAlertMatches(ctx, id)
+		default:
+			var ok bool
+			ok, err = uc.sendAlert(ctx, search, now)
+			if ok {
+				sent++
+			}
+		}
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("failed to send job alert of saved search %s: %v", id, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return sent, fmt.Errorf("%d job alerts failed", failed)
+	}
+	return sent, nil
+}
+
+// sendAlert sends the pending matches of a saved search through its channels.
+// Match chỉ được đánh dấu đã gửi khi ít nhất một kênh gửi thành công, nếu không sẽ thử lại lần sau.
+func (uc *JobAlertUseCase) sendAlert(ctx context.Context, search *SavedSearch, now time.Time) (bool, error) {
+	matches, err := uc.matchRepo.ListPendingAlertMatches(ctx, search.ID)
+	if err != nil || len(matches) == 0 {
+		return false, err
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, search.UserID)
+	if err != nil {
+		return false, err
+	}
+	if user == nil || !user.Active {
+		return false, uc.matchRepo.DeletePendingAlertMatches(ctx, search.ID)
+	}
+
+	ids := make([]string, 0, len(matches))
+	alert := &JobAlert{
+		User:           user,
+		Search:         search,
+		UnsubscribeURL: uc.appBaseURL + "/job-alerts/unsubscribe?token=" + url.QueryEscape(search.UnsubscribeToken),
+		AppBaseURL:     uc.appBaseURL,
+	}
+	for _, match := range matches {
+		ids = append(ids, match.ID)
+		if len(alert.Jobs) == MaxAlertJobsPerDigest {
+			alert.More++
+			continue
+		}
+		// Job đã bị đóng, tạm dừng hoặc xóa từ lúc khớp thì không gửi
+		job, err := uc.jobRepo.GetJobPosting(ctx, match.JobID)
+		if err != nil {
+			return false, err
+		}
+		if job != nil && job.IsPublic(now) {
+			alert.Jobs = append(alert.Jobs, job)
+		}
+	}
+
+	if len(alert.Jobs) > 0 {
+		delivered := false
+		for _, channel := range search.Channels {
+			notifier := uc.notifier(channel)
+			if notifier == nil {
+				continue
+			}
+			if err := notifier.Notify(ctx, alert); err != nil {
+				uc.log.WithContext(ctx).Errorf("failed to send job alert through %s: %v", channel, err)
+				continue
+			}
+			delivered = true
+		}
+		if !delivered {
+			return false, fmt.Errorf("no channel delivered the alert")
+		}
+	}
+
+	if err := uc.matchRepo.MarkAlertMatchesNotified(ctx, ids, now); err != nil {
+		return false, err
+	}
+	if len(alert.Jobs) == 0 {
+		return false, nil
+	}
+	return true, uc.searchRepo.SetSavedSearchNotified(ctx, search.ID, now)
+}
+
+func (uc *JobAlertUseCase) notifier(channel AlertChannel) AlertNotifier {
+	for _, n := range uc.notifiers {
+		if n.Channel() == channel {
+			return n
+		}
+	}
+	return nil
+}
+
+// validateSavedSearch validates a saved search and fills the defaults
+func validateSavedSearch(search *SavedSearch) error {
+	search.Name = strings.TrimSpace(search.Name)
+	if search.Name == "" || utf8.RuneCountInString(search.Name) > MaxSavedSearchNameLength {
+		return ErrInvalidSavedSearch
+	}
+
+	if search.Frequency == "" {
+		search.Frequency = AlertFrequencyDaily
+	}
+	if !search.Frequency.IsValid() {
+		return ErrInvalidSavedSearch
+	}
+
+	if len(search.Channels) == 0 {
+		search.Channels = []AlertChannel{AlertChannelEmail, AlertChannelInApp}
+	}
+	seen := map[AlertChannel]bool{}
+	for _, channel := range search.Channels {
+		if (channel != AlertChannelEmail && channel != AlertChannelInApp) || seen[channel] {
+			return ErrInvalidSavedSearch
+		}
+		seen[channel] = true
+	}
+
+	f := search.Filter
+	if f == nil {
+		return ErrInvalidSavedSearch
+	}
+	f.Location = strings.TrimSpace(f.Location)
+	f.Keyword = strings.TrimSpace(f.Keyword)
+	f.JobType = JobType(strings.ToUpper(string(f.JobType)))
+	f.Level = Level(strings.ToUpper(string(f.Level)))
+	switch f.JobType {
+	case "", FullTime, PartTime, Contract, Internship:
+	default:
+		return ErrInvalidSavedSearch
+	}
+	switch f.Level {
+	case "", Entry, Junior, Mid, Senior, Lead:
+	default:
+		return ErrInvalidSavedSearch
+	}
+	// Filter rỗng khớp mọi job
+	if f.CompanyID == "" && f.Location == "" && f.JobType == "" && f.Level == "" && f.Keyword == "" && len(f.JobTech) == 0 {
+		return ErrInvalidSavedSearch
+	}
+	f.Status = ""
+	f.PublicOnly = false
+
+	return nil
+}
+
+// Matches reports whether a job matches the filter, cùng cách so khớp với danh sách job
+// (không phân biệt hoa thường và dấu, location khớp một phần, keyword khớp một từ bất kỳ như text index)
+func (f *JobFilter) Matches(job *JobPosting) bool {
+	if f.CompanyID != "" && f.CompanyID != job.CompanyID {
+		return false
+	}
+	if f.Location != "" && !containsFold(job.Location, f.Location) {
+		return false
+	}
+	if f.JobType != "" && !strings.EqualFold(string(f.JobType), string(job.JobType)) {
+		return false
+	}
+	if f.Level != "" && !strings.EqualFold(string(f.Level), string(job.Level)) {
+		return false
+	}
+	if terms := SearchTerms(f.Keyword); len(terms) > 0 &&
+		!containsTerm(job.Title, terms) && !containsTerm(strings.Join(job.JobTech, " "), terms) &&
+		!containsTerm(job.Requirements, terms) && !containsTerm(job.Description, terms) {
+		return false
+	}
+	if len(f.JobTech) > 0 {
+		found := false
+		for _, want := range f.JobTech {
+			for _, tech := range job.JobTech {
+				if strings.EqualFold(want, tech) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(textnorm.Normalize(s), textnorm.Normalize(substr))
+}
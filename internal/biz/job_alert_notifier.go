@@ -0,0 +1,87 @@
+package biz
+
+import (
+	"JobblyBE/pkg/mailer"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// NewAlertNotifiers creates the notifiers of the email and in-app channels
+func NewAlertNotifiers(m mailer.Mailer, notifications NotificationRepo) AlertNotifiers {
+	return AlertNotifiers{
+		&emailAlertNotifier{mailer: m},
+		&inAppAlertNotifier{repo: notifications},
+	}
+}
+
+// emailAlertNotifier gửi job alert qua email, kèm link hủy đăng ký
+type emailAlertNotifier struct {
+	mailer mailer.Mailer
+}
+
+func (n *emailAlertNotifier) Channel() AlertChannel {
+	return AlertChannelEmail
+}
+
+func (n *emailAlertNotifier) Notify(ctx context.Context, alert *JobAlert) error {
+	// Không gửi tới email chưa xác thực
+	if !alert.User.EmailVerified {
+		return nil
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Xin chào %s,\n\nCó %d việc làm mới khớp với tìm kiếm \"%s\" của bạn:\n\n",
+		alert.User.FullName, len(alert.Jobs)+alert.More, alert.Search.Name)
+	for _, job := range alert.Jobs {
+		company := ""
+		if job.Company != nil {
+			company = " - " + job.Company.Name
+		}
+		fmt.Fprintf(&body, "- %s%s (%s)\n  %s/jobs/%s\n", job.Title, company, job.Location, alert.AppBaseURL, job.ID)
+	}
+	if alert.More > 0 {
+		fmt.Fprintf(&body, "\nvà %d việc làm khác.\n", alert.More)
+	}
+	fmt.Fprintf(&body, "\nKhông muốn nhận thông báo cho tìm kiếm này nữa? Hủy đăng ký tại:\n%s\n", alert.UnsubscribeURL)
+
+	return n.mailer.Send(ctx, &mailer.Message{
+		To:       []string{alert.User.Email},
+		Subject:  fmt.Sprintf("%d việc làm mới cho \"%s\"", len(alert.Jobs)+alert.More, alert.Search.Name),
+		TextBody: body.String(),
+	})
+}
+
+// inAppAlertNotifier lưu job alert thành thông báo in-app
+type inAppAlertNotifier struct {
+	repo NotificationRepo
+}
+
+func (n *inAppAlertNotifier) Channel() AlertChannel {
+	return AlertChannelInApp
+}
+
+func (n *inAppAlertNotifier) Notify(ctx context.Context, alert *JobAlert) error {
+	jobIDs := make([]string, 0, len(alert.Jobs))
+	for _, job := range alert.Jobs {
+		jobIDs = append(jobIDs, job.ID)
+	}
+
+	title := fmt.Sprintf("%d việc làm mới cho \"%s\"", len(alert.Jobs)+alert.More, alert.Search.Name)
+	body := alert.Jobs[0].Title
+	if len(alert.Jobs)+alert.More > 1 {
+		body = fmt.Sprintf("%s và %d việc làm khác", alert.Jobs[0].Title, len(alert.Jobs)+alert.More-1)
+	}
+
+	_, err := n.repo.CreateNotification(ctx, &Notification{
+		UserID: alert.User.UserID,
+		Type:   NotificationJobAlert,
+		Title:  title,
+		Body:   body,
+		Data: map[string]string{
+			"saved_search_id": alert.Search.ID,
+			"job_ids":         strings.Join(jobIDs, ","),
+		},
+	})
+	return err
+}
@@ -0,0 +1,117 @@
+package biz
+
+import (
+	"JobblyBE/internal/conf"
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// memoryJobRepo serves published jobs in (posted_at, id) order
+type memoryJobRepo struct {
+	JobPostingRepo
+	jobs []*JobPosting
+}
+
+func (r *memoryJobRepo) ListPublishedJobPostingsSince(ctx context.Context, since time.Time, afterID string, until time.Time, limit int) ([]*JobPosting, error) {
+	jobs := append([]*JobPosting(nil), r.jobs...)
+	sort.Slice(jobs, func(i, j int) bool {
+		if !jobs[i].PostedAt.Equal(*jobs[j].PostedAt) {
+			return jobs[i].PostedAt.Before(*jobs[j].PostedAt)
+		}
+		return jobs[i].ID < jobs[j].ID
+	})
+
+	var result []*JobPosting
+	for _, job := range jobs {
+		at := *job.PostedAt
+		if at.Before(since) || at.After(until) || (afterID != "" && at.Equal(since) && job.ID <= afterID) {
+			continue
+		}
+		if len(result) == limit {
+			break
+		}
+		result = append(result, job)
+	}
+	return result, nil
+}
+
+type memorySavedSearchRepo struct {
+	SavedSearchRepo
+	searches []*SavedSearch
+}
+
+func (r *memorySavedSearchRepo) ListActiveSavedSearches(ctx context.Context, afterID string, limit int) ([]*SavedSearch, error) {
+	var result []*SavedSearch
+	for _, s := range r.searches {
+		if s.ID > afterID && !s.Muted && len(result) < limit {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+type memoryAlertMatchRepo struct {
+	AlertMatchRepo
+	matches   map[string]bool // search ID + "/" + job ID
+	watermark *AlertWatermark
+}
+
+func (r *memoryAlertMatchRepo) CreateAlertMatches(ctx context.Context, matches []*AlertMatch) (int, error) {
+	created := 0
+	for _, m := range matches {
+		key := m.SearchID + "/" + m.JobID
+		if !r.matches[key] {
+			r.matches[key] = true
+			created++
+		}
+	}
+	return created, nil
+}
+
+func (r *memoryAlertMatchRepo) GetAlertWatermark(ctx context.Context) (*AlertWatermark, error) {
+	return r.watermark, nil
+}
+
+func (r *memoryAlertMatchRepo) SetAlertWatermark(ctx context.Context, w AlertWatermark) error {
+	r.watermark = &w
+	return nil
+}
+
+func TestMatchNewJobsContinuesPastJobsWithSamePostedAt(t *testing.T) {
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	postedAt := now.Add(-time.Hour)
+
+	jobRepo := &memoryJobRepo{}
+	for i := 0; i < 5; i++ {
+		jobRepo.jobs = append(jobRepo.jobs, &JobPosting{ID: fmt.Sprintf("job%d", i), PostedAt: &postedAt})
+	}
+	matchRepo := &memoryAlertMatchRepo{
+		matches:   map[string]bool{},
+		watermark: &AlertWatermark{PostedAt: postedAt.Add(-time.Minute)},
+	}
+	searchRepo := &memorySavedSearchRepo{searches: []*SavedSearch{{ID: "search1", UserID: "user1", Filter: &JobFilter{}}}}
+	uc := NewJobAlertUseCase(searchRepo, matchRepo, jobRepo, nil, nil, &conf.Server{}, log.DefaultLogger)
+
+	// Limit 2 với 5 job cùng posted_at: mỗi lần chạy phải đi tiếp thay vì đọc lại 2 job đầu
+	wantCreated := []int{2, 2, 1, 0}
+	for run, want := range wantCreated {
+		created, err := uc.MatchNewJobs(context.Background(), now, 2)
+		if err != nil {
+			t.Fatalf("run %d: MatchNewJobs() error = %v", run, err)
+		}
+		if created != want {
+			t.Errorf("run %d: MatchNewJobs() created = %d, want %d", run, created, want)
+		}
+	}
+	if len(matchRepo.matches) != 5 {
+		t.Errorf("matched %d jobs, want 5", len(matchRepo.matches))
+	}
+	if matchRepo.watermark.JobID != "" || !matchRepo.watermark.PostedAt.Equal(now.Add(-alertMatchOverlap)) {
+		t.Errorf("watermark = %+v, want overlap window without job cursor", *matchRepo.watermark)
+	}
+}
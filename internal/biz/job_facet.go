@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidSalaryBucket = errors.New("invalid salary bucket")
+
+// JobFacetLimit là số giá trị nhiều job nhất trả về cho location, job_tech và company
+const JobFacetLimit = 20
+
+const (
+	SalaryBucketNegotiable = "NEGOTIABLE" // không có salary_min/salary_max
+	SalaryBucketOther      = "OTHER"      // currency không có bucket
+)
+
+// SalaryBucket is a salary range [Min, Max) of a currency, Max = 0 là không giới hạn trên.
+// Job được xếp theo salary_max, hoặc salary_min nếu không có salary_max.
+type SalaryBucket struct {
+	Key      string
+	Currency string
+	Min      float64
+	Max      float64
+}
+
+// SalaryBuckets lists the salary buckets in display order
+var SalaryBuckets = []SalaryBucket{
+	{Key: "VND_UNDER_10M", Currency: "VND", Min: 0, Max: 10_000_000},
+	{Key: "VND_10M_20M", Currency: "VND", Min: 10_000_000, Max: 20_000_000},
+	{Key: "VND_20M_40M", Currency: "VND", Min: 20_000_000, Max: 40_000_000},
+	{Key: "VND_40M_PLUS", Currency: "VND", Min: 40_000_000},
+	{Key: "USD_UNDER_1000", Currency: "USD", Min: 0, Max: 1000},
+	{Key: "USD_1000_2000", Currency: "USD", Min: 1000, Max: 2000},
+	{Key: "USD_2000_4000", Currency: "USD", Min: 2000, Max: 4000},
+	{Key: "USD_4000_PLUS", Currency: "USD", Min: 4000},
+}
+
+// IsValidSalaryBucket reports whether key is a value of the salary_bucket facet
+func IsValidSalaryBucket(key string) bool {
+	if key == SalaryBucketNegotiable || key == SalaryBucketOther {
+		return true
+	}
+	for _, b := range SalaryBuckets {
+		if b.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Label returns a short description of the bucket, e.g. "10M-20M VND"
+func (b SalaryBucket) Label() string {
+	switch {
+	case b.Max == 0:
+		return fmt.Sprintf("%s+ %s", formatSalary(b.Min), b.Currency)
+	case b.Min == 0:
+		return fmt.Sprintf("< %s %s", formatSalary(b.Max), b.Currency)
+	}
+	return fmt.Sprintf("%s-%s %s", formatSalary(b.Min), formatSalary(b.Max), b.Currency)
+}
+
+func formatSalary(v float64) string {
+	if v >= 1_000_000 {
+		return fmt.Sprintf("%gM", v/1_000_000)
+	}
+	return fmt.Sprintf("%g", v)
+}
+
+// FacetCount is the number of jobs having a value of a facet
+type FacetCount struct {
+	Value string // giá trị dùng làm filter
+	Label string // tên hiển thị (tên company, cách viết location/job_tech phổ biến)
+	Count int32
+}
+
+// JobFacets are the counts of the job listing sidebars.
+// Mỗi facet tính theo mọi filter khác, bỏ qua filter của chính nó.
+type JobFacets struct {
+	Level        []FacetCount
+	JobType      []FacetCount
+	Location     []FacetCount
+	JobTech      []FacetCount
+	Company      []FacetCount
+	SalaryBucket []FacetCount
+}
+
+// JobFacets counts the jobs of a listing by level, job type, location, job tech, company and salary bucket
+func (uc *JobPostingUseCase) JobFacets(ctx context.Context, actor *Actor, filter *JobFilter) (*JobFacets, error) {
+	uc.log.WithContext(ctx).Info("JobFacets")
+
+	if err := uc.authorizeJobFilter(ctx, actor, filter); err != nil {
+		return nil, err
+	}
+
+	facets, err := uc.jobRepo.JobFacets(ctx, filter)
+	if err != nil {
+		uc.log.Errorf("failed to count job facets: %v", err)
+		return nil, err
+	}
+	return facets, nil
+}
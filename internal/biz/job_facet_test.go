@@ -0,0 +1,39 @@
+package biz
+
+import "testing"
+
+func TestIsValidSalaryBucket(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"VND_10M_20M", true},
+		{"USD_4000_PLUS", true},
+		{SalaryBucketNegotiable, true},
+		{SalaryBucketOther, true},
+		{"vnd_10m_20m", false},
+		{"EUR_UNDER_1000", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := IsValidSalaryBucket(tt.key); got != tt.want {
+			t.Errorf("IsValidSalaryBucket(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestSalaryBucketLabel(t *testing.T) {
+	want := map[string]string{
+		"VND_UNDER_10M":  "< 10M VND",
+		"VND_10M_20M":    "10M-20M VND",
+		"VND_40M_PLUS":   "40M+ VND",
+		"USD_1000_2000":  "1000-2000 USD",
+		"USD_4000_PLUS":  "4000+ USD",
+		"USD_UNDER_1000": "< 1000 USD",
+	}
+	for _, b := range SalaryBuckets {
+		if label, ok := want[b.Key]; ok && b.Label() != label {
+			t.Errorf("%s.Label() = %q, want %q", b.Key, b.Label(), label)
+		}
+	}
+}
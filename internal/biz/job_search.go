@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"errors"
+	"html"
+	"strings"
+	"unicode"
+
+	"JobblyBE/pkg/textnorm"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var ErrInvalidJobSort = errors.New("invalid job sort")
+
+// JobSort is the order of job listings
+type JobSort string
+
+const (
+	JobSortNewest    JobSort = "newest"    // created_at giảm dần (mặc định)
+	JobSortRelevance JobSort = "relevance" // theo textScore của keyword, cần keyword
+)
+
+// IsValid reports whether s is a known sort
+func (s JobSort) IsValid() bool {
+	return s == JobSortNewest || s == JobSortRelevance
+}
+
+const (
+	// snippetRadius là số ký tự giữ lại trước từ khớp đầu tiên
+	snippetRadius = 60
+	// snippetLength là độ dài tối đa của một snippet
+	snippetLength = 200
+)
+
+// JobHighlight is a snippet of a job field with the matched terms wrapped in <em></em>.
+// Phần còn lại của snippet đã được HTML escape.
+type JobHighlight struct {
+	Field   string // title, job_tech, requirements, description
+	Snippet string
+}
+
+// SearchTerms splits a keyword into the normalized terms (textnorm) matched by the text index.
+// Chỉ giữ chữ và số nên keyword không thể tạo phrase ("...") hay phủ định (-term) của $text.
+func SearchTerms(keyword string) []string {
+	seen := map[string]bool{}
+	var terms []string
+	for _, term := range strings.FieldsFunc(textnorm.Normalize(keyword), isNotWordRune) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// HighlightJob returns a snippet for every field of job containing one of terms,
+// theo thứ tự trọng số của text index
+func HighlightJob(job *JobPosting, terms []string) []JobHighlight {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	fields := []struct {
+		name string
+		text string
+	}{
+		{"title", job.Title},
+		{"job_tech", strings.Join(job.JobTech, ", ")},
+		{"requirements", job.Requirements},
+		{"description", job.Description},
+	}
+
+	var highlights []JobHighlight
+	for _, f := range fields {
+		if snippet, ok := highlight(f.text, terms); ok {
+			highlights = append(highlights, JobHighlight{Field: f.name, Snippet: snippet})
+		}
+	}
+	return highlights
+}
+
+// containsTerm reports whether text contains one of terms as a whole word
+func containsTerm(text string, terms []string) bool {
+	return len(findTerms(foldRunes([]rune(norm.NFC.String(text))), terms)) > 0
+}
+
+// highlight cuts a snippet of text around the first matched term
+func highlight(text string, terms []string) (string, bool) {
+	// Gộp xuống dòng và khoảng trắng liên tiếp, NFC để mỗi chữ có dấu là một rune
+	runes := []rune(norm.NFC.String(strings.Join(strings.Fields(text), " ")))
+	matches := findTerms(foldRunes(runes), terms)
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	start := 0
+	if matches[0][0] > snippetRadius {
+		start = matches[0][0] - snippetRadius
+		// Không cắt giữa một từ
+		for start < matches[0][0] && runes[start-1] != ' ' {
+			start++
+		}
+	}
+	end := len(runes)
+	if end-start > snippetLength {
+		end = start + snippetLength
+		if end < matches[0][1] {
+			end = matches[0][1]
+		}
+		for end > matches[0][1] && runes[end] != ' ' {
+			end--
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, m := range matches {
+		if m[0] < start {
+			continue
+		}
+		if m[1] > end {
+			break
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:m[0]])))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(string(runes[m[0]:m[1]])))
+		b.WriteString("</em>")
+		pos = m[1]
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
+
+// findTerms returns the [start, end) rune ranges of the whole-word occurrences of terms,
+// không chồng lên nhau, ưu tiên term dài hơn
+func findTerms(folded []rune, terms []string) [][2]int {
+	var matches [][2]int
+	for i := 0; i < len(folded); {
+		if i > 0 && !isNotWordRune(folded[i-1]) {
+			i++
+			continue
+		}
+		best := 0
+		for _, term := range terms {
+			n := len([]rune(term))
+			if n <= best || i+n > len(folded) || string(folded[i:i+n]) != term {
+				continue
+			}
+			if i+n < len(folded) && !isNotWordRune(folded[i+n]) {
+				continue
+			}
+			best = n
+		}
+		if best == 0 {
+			i++
+			continue
+		}
+		matches = append(matches, [2]int{i, i + best})
+		i += best
+	}
+	return matches
+}
+
+// foldRunes normalizes every rune, giữ nguyên vị trí của từng ký tự
+func foldRunes(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = textnorm.FoldRune(r)
+	}
+	return folded
+}
+
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r)
+}
@@ -0,0 +1,126 @@
+package biz
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSearchTerms(t *testing.T) {
+	tests := []struct {
+		keyword string
+		want    []string
+	}{
+		{"", nil},
+		{"Golang", []string{"golang"}},
+		{"Lập trình viên", []string{"lap", "trinh", "vien"}},
+		{"go GO Go", []string{"go"}},
+		// Không tạo được phrase hay phủ định của $text
+		{`"senior" -junior`, []string{"senior", "junior"}},
+		{"C++/Node.js", []string{"c", "node", "js"}},
+	}
+	for _, tt := range tests {
+		if got := SearchTerms(tt.keyword); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SearchTerms(%q) = %q, want %q", tt.keyword, got, tt.want)
+		}
+	}
+}
+
+func TestHighlightJob(t *testing.T) {
+	job := &JobPosting{
+		Title:        "Lập trình viên Backend Golang",
+		JobTech:      []string{"Go", "MongoDB"},
+		Requirements: "3+ năm kinh nghiệm <backend> & microservices",
+		Description:  "Phát triển hệ thống tuyển dụng.\n\nLàm việc tại   Hà Nội.",
+	}
+
+	tests := []struct {
+		name    string
+		keyword string
+		want    []JobHighlight
+	}{
+		{
+			name:    "no terms",
+			keyword: "",
+			want:    nil,
+		},
+		{
+			name:    "diacritic insensitive, giữ nguyên cách viết gốc",
+			keyword: "lap trinh",
+			want:    []JobHighlight{{Field: "title", Snippet: "<em>Lập</em> <em>trình</em> viên Backend Golang"}},
+		},
+		{
+			name:    "whole words only",
+			keyword: "go",
+			want:    []JobHighlight{{Field: "job_tech", Snippet: "<em>Go</em>, MongoDB"}},
+		},
+		{
+			name:    "fields in index weight order, html escaped",
+			keyword: "backend",
+			want: []JobHighlight{
+				{Field: "title", Snippet: "Lập trình viên <em>Backend</em> Golang"},
+				{Field: "requirements", Snippet: "3+ năm kinh nghiệm &lt;<em>backend</em>&gt; &amp; microservices"},
+			},
+		},
+		{
+			name:    "whitespace collapsed",
+			keyword: "ha noi",
+			want:    []JobHighlight{{Field: "description", Snippet: "Phát triển hệ thống tuyển dụng. Làm việc tại <em>Hà</em> <em>Nội</em>."}},
+		},
+		{
+			name:    "no match",
+			keyword: "python",
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HighlightJob(job, SearchTerms(tt.keyword)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HighlightJob() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	before := strings.Repeat("lorem ipsum ", 20)
+	after := strings.Repeat(" dolor sit amet", 30)
+	text := before + "Kubernetes" + after
+
+	snippet, ok := highlight(text, []string{"kubernetes"})
+	if !ok {
+		t.Fatal("highlight() found no match")
+	}
+	if !strings.HasPrefix(snippet, "…") || !strings.HasSuffix(snippet, "…") {
+		t.Errorf("snippet is not cut on both sides: %q", snippet)
+	}
+	if !strings.Contains(snippet, "<em>Kubernetes</em>") {
+		t.Errorf("snippet has no highlighted term: %q", snippet)
+	}
+	// Không cắt giữa một từ
+	body := strings.TrimSuffix(strings.TrimPrefix(snippet, "…"), "…")
+	if !strings.HasPrefix(body, "lorem ") && !strings.HasPrefix(body, "ipsum ") {
+		t.Errorf("snippet starts inside a word: %q", snippet)
+	}
+	if n := len([]rune(body)) - len("<em></em>"); n > snippetLength {
+		t.Errorf("snippet length = %d, want <= %d", n, snippetLength)
+	}
+}
+
+func TestContainsTerm(t *testing.T) {
+	tests := []struct {
+		text  string
+		terms []string
+		want  bool
+	}{
+		{"Senior Go Developer", []string{"go"}, true},
+		{"Senior Golang Developer", []string{"go"}, false},
+		{"Kỹ sư Đà Nẵng", []string{"da", "nang"}, true},
+		{"", []string{"go"}, false},
+	}
+	for _, tt := range tests {
+		if got := containsTerm(tt.text, tt.terms); got != tt.want {
+			t.Errorf("containsTerm(%q, %q) = %v, want %v", tt.text, tt.terms, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrInvalidJobStatus = errors.New("invalid job status transition")
+
+// Job posting statuses
+type JobStatus string
+
+const (
+	JobStatusDraft     JobStatus = "DRAFT"
+	JobStatusPublished JobStatus = "PUBLISHED"
+	JobStatusPaused    JobStatus = "PAUSED"
+	JobStatusClosed    JobStatus = "CLOSED"
+	JobStatusExpired   JobStatus = "EXPIRED"
+)
+
+// jobStatusTransitions lists the statuses a job may move to from each status.
+// CLOSED là trạng thái cuối, job EXPIRED chỉ publish lại được khi đã gia hạn expires_at.
+var jobStatusTransitions = map[JobStatus][]JobStatus{
+	JobStatusDraft:     {JobStatusPublished, JobStatusClosed},
+	JobStatusPublished: {JobStatusPaused, JobStatusClosed, JobStatusExpired},
+	JobStatusPaused:    {JobStatusPublished, JobStatusClosed, JobStatusExpired},
+	JobStatusExpired:   {JobStatusPublished, JobStatusClosed},
+	JobStatusClosed:    {},
+}
+
+// IsValid reports whether s is a known job status
+func (s JobStatus) IsValid() bool {
+	_, ok := jobStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo reports whether a job in status s may move to status to
+func (s JobStatus) CanTransitionTo(to JobStatus) bool {
+	for _, next := range jobStatusTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CurrentStatus returns the status of the job at now: job đang PUBLISHED/PAUSED
+// đã qua expires_at được coi là EXPIRED kể cả khi chưa được cập nhật trong DB
+func (j *JobPosting) CurrentStatus(now time.Time) JobStatus {
+	if (j.Status == JobStatusPublished || j.Status == JobStatusPaused) && j.isExpiredAt(now) {
+		return JobStatusExpired
+	}
+	return j.Status
+}
+
+// IsPublic reports whether job is visible to everyone at now
+func (j *JobPosting) IsPublic(now time.Time) bool {
+	return j.CurrentStatus(now) == JobStatusPublished
+}
+
+func (j *JobPosting) isExpiredAt(now time.Time) bool {
+	return j.ExpiresAt != nil && !j.ExpiresAt.After(now)
+}
+
+// PublishJobPosting makes a draft, paused or expired job visible (company members only).
+// Job đã qua expires_at phải được gia hạn (UpdateJobPosting) trước khi publish lại.
+func (uc *JobPostingUseCase) PublishJobPosting(ctx context.Context, actor *Actor, id string) (*JobPosting, error) {
+	uc.log.WithContext(ctx).Infof("PublishJobPosting: %s", id)
+	return uc.changeJobStatus(ctx, actor, id, JobStatusPublished)
+}
+
+// PauseJobPosting hides a published job until it is published again (company members only)
+func (uc *JobPostingUseCase) PauseJobPosting(ctx context.Context, actor *Actor, id string) (*JobPosting, error) {
+	uc.log.WithContext(ctx).Infof("PauseJobPosting: %s", id)
+	return uc.changeJobStatus(ctx, actor, id, JobStatusPaused)
+}
+
+// CloseJobPosting closes a job for good (company members only)
+func (uc *JobPostingUseCase) CloseJobPosting(ctx context.Context, actor *Actor, id string) (*JobPosting, error) {
+	uc.log.WithContext(ctx).Infof("CloseJobPosting: %s", id)
+	return uc.changeJobStatus(ctx, actor, id, JobStatusClosed)
+}
+
+// changeJobStatus moves a job to status to if the transition is allowed
+func (uc *JobPostingUseCase) changeJobStatus(ctx context.Context, actor *Actor, id string, to JobStatus) (*JobPosting, error) {
+	job, err := uc.jobRepo.GetJobPosting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrJobNotFound
+	}
+
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, job.CompanyID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from := job.CurrentStatus(now)
+	if !from.CanTransitionTo(to) {
+		return nil, ErrInvalidJobStatus
+	}
+
+	var postedAt *time.Time
+	if to == JobStatusPublished {
+		if job.isExpiredAt(now) {
+			return nil, ErrJobExpired
+		}
+		// posted_at là lần publish đầu tiên (hoặc sớm hơn giờ đã hẹn)
+		if job.PostedAt == nil || job.PostedAt.After(now) {
+			postedAt = &now
+		}
+	}
+
+	// So sánh với status đang lưu (không phải status đã tính hết hạn) để tránh ghi đè thay đổi đồng thời
+	ok, err := uc.jobRepo.UpdateJobStatus(ctx, id, job.Status, to, postedAt)
+	if err != nil {
+		uc.log.Errorf("failed to update job status: %v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidJobStatus
+	}
+
+	uc.recordStatusChange(ctx, actor.UserID, id, from, to)
+
+	return uc.GetJobPosting(ctx, actor, id)
+}
+
+// ExpireJobPostings marks at most limit published or paused jobs past their expires_at
+// as EXPIRED and returns the number of expired jobs (chạy bởi scheduler)
+func (uc *JobPostingUseCase) ExpireJobPostings(ctx context.Context, now time.Time, limit int) (int, error) {
+	jobs, err := uc.jobRepo.ListExpiredJobPostings(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, job := range jobs {
+		ok, err := uc.jobRepo.UpdateJobStatus(ctx, job.ID, job.Status, JobStatusExpired, nil)
+		if err != nil {
+			return expired, err
+		}
+		if !ok {
+			continue // status vừa bị đổi bởi request khác
+		}
+		uc.recordStatusChange(ctx, AuditActorSystem, job.ID, job.Status, JobStatusExpired)
+		expired++
+	}
+
+	return expired, nil
+}
+
+// PublishScheduledJobPostings publishes at most limit drafts whose posted_at has come
+// and returns the number of published jobs (chạy bởi scheduler)
+func (uc *JobPostingUseCase) PublishScheduledJobPostings(ctx context.Context, now time.Time, limit int) (int, error) {
+	jobs, err := uc.jobRepo.ListScheduledJobPostings(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, job := range jobs {
+		// Giữ posted_at là giờ đã hẹn
+		ok, err := uc.jobRepo.UpdateJobStatus(ctx, job.ID, JobStatusDraft, JobStatusPublished, nil)
+		if err != nil {
+			return published, err
+		}
+		if !ok {
+			continue
+		}
+		uc.recordStatusChange(ctx, AuditActorSystem, job.ID, JobStatusDraft, JobStatusPublished)
+		published++
+	}
+
+	return published, nil
+}
+
+// recordStatusChange writes an audit entry of a job status change made by actorID
+func (uc *JobPostingUseCase) recordStatusChange(ctx context.Context, actorID, jobID string, from, to JobStatus) {
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actorID,
+		Action:     AuditJobStatusChanged,
+		TargetType: AuditTargetJob,
+		TargetID:   jobID,
+		Before:     map[string]interface{}{"status": string(from)},
+		After:      map[string]interface{}{"status": string(to)},
+	})
+}
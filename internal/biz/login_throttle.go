@@ -0,0 +1,250 @@
+package biz
+
+import (
+	"JobblyBE/internal/conf"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrTooManyLoginAttempts = errors.New("too many failed login attempts")
+
+// LoginLockedError is returned while an account or client IP is locked out
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyLoginAttempts, e.RetryAfter)
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrTooManyLoginAttempts
+}
+
+// Default brute-force protection settings (overridable in conf.Server.login_throttle)
+const (
+	DefaultMaxAccountLoginFailures = 5
+	DefaultMaxIPLoginFailures      = 20
+	DefaultLoginFailureWindow      = 15 * time.Minute
+	DefaultBaseLoginLockout        = time.Minute
+	DefaultMaxLoginLockout         = time.Hour
+
+	// Số lần bị khóa được nhớ thêm chừng này sau lần khóa/thất bại cuối (backoff giảm dần về 0)
+	loginAttemptMemory = 24 * time.Hour
+)
+
+// LoginAttempt is the failed-attempt state of one key (account or client IP)
+type LoginAttempt struct {
+	Key         string
+	Failures    int // số lần thất bại trong sliding window
+	Lockouts    int // số lần đã bị khóa, quyết định thời gian khóa tiếp theo
+	LockedUntil *time.Time
+}
+
+// LoginAttemptRepo persists failed login attempts
+type LoginAttemptRepo interface {
+	GetLoginAttempt(ctx context.Context, key string) (*LoginAttempt, error)
+	// AddLoginFailure ghi nhận một lần thất bại tại at và bỏ các lần trước windowStart
+	AddLoginFailure(ctx context.Context, key string, at, windowStart, expiresAt time.Time) (*LoginAttempt, error)
+	// LockLogin khóa key đến until nếu key chưa bị khóa tại at, tăng Lockouts và xóa các lần thất bại.
+	// Trả về nil nếu key đã bị khóa bởi request khác.
+	LockLogin(ctx context.Context, key string, at, until, expiresAt time.Time) (*LoginAttempt, error)
+	DeleteLoginAttempt(ctx context.Context, key string) error
+}
+
+// LoginThrottle limits password guesses per account and per client IP.
+// Vượt quá số lần thất bại trong window sẽ bị khóa tạm thời, mỗi lần khóa sau dài gấp đôi.
+type LoginThrottle struct {
+	repo               LoginAttemptRepo
+	maxAccountFailures int
+	maxIPFailures      int
+	window             time.Duration
+	baseLockout        time.Duration
+	maxLockout         time.Duration
+	now                func() time.Time
+	log                *log.Helper
+}
+
+// NewLoginThrottle creates a new LoginThrottle
+func NewLoginThrottle(repo LoginAttemptRepo, c *conf.Server, logger log.Logger) *LoginThrottle {
+	t := &LoginThrottle{
+		repo:               repo,
+		maxAccountFailures: DefaultMaxAccountLoginFailures,
+		maxIPFailures:      DefaultMaxIPLoginFailures,
+		window:             DefaultLoginFailureWindow,
+		baseLockout:        DefaultBaseLoginLockout,
+		maxLockout:         DefaultMaxLoginLockout,
+		now:                time.Now,
+		log:                log.NewHelper(logger),
+	}
+
+	lc := c.GetLoginThrottle()
+	if lc.GetMaxAccountFailures() > 0 {
+		t.maxAccountFailures = int(lc.GetMaxAccountFailures())
+	}
+	if lc.GetMaxIpFailures() > 0 {
+		t.maxIPFailures = int(lc.GetMaxIpFailures())
+	}
+	if lc.GetWindow() != nil {
+		t.window = lc.GetWindow().AsDuration()
+	}
+	if lc.GetBaseLockout() != nil {
+		t.baseLockout = lc.GetBaseLockout().AsDuration()
+	}
+	if lc.GetMaxLockout() != nil {
+		t.maxLockout = lc.GetMaxLockout().AsDuration()
+	}
+
+	return t
+}
+
+// SetClock thay đồng hồ của throttle (dùng fake clock khi test)
+func (t *LoginThrottle) SetClock(now func() time.Time) {
+	t.now = now
+}
+
+// AccountKey returns the throttle key of an account
+func AccountKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// IPKey returns the throttle key of a client IP, empty if the IP is unknown
+func IPKey(ip string) string {
+	if ip == "" {
+		return ""
+	}
+	return "ip:" + ip
+}
+
+// TOTPKey returns the throttle key of the second factor (TOTP / recovery code) of a user
+func TOTPKey(userID string) string {
+	return "totp:" + userID
+}
+
+// Check returns a *LoginLockedError if any of keys is locked out
+func (t *LoginThrottle) Check(ctx context.Context, keys ...string) error {
+	now := t.now()
+	var retryAfter time.Duration
+
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		attempt, err := t.repo.GetLoginAttempt(ctx, key)
+		if err != nil {
+			return err
+		}
+		if attempt != nil && attempt.LockedUntil != nil && attempt.LockedUntil.After(now) {
+			if wait := attempt.LockedUntil.Sub(now); wait > retryAfter {
+				retryAfter = wait
+			}
+		}
+	}
+
+	if retryAfter > 0 {
+		return &LoginLockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// RecordFailure records a failed password check for keys.
+// Returns a *LoginLockedError if this failure locked one of them out.
+func (t *LoginThrottle) RecordFailure(ctx context.Context, keys ...string) error {
+	now := t.now()
+	var retryAfter time.Duration
+
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+
+		attempt, err := t.repo.AddLoginFailure(ctx, key, now, now.Add(-t.window), now.Add(t.window+loginAttemptMemory))
+		if err != nil {
+			t.log.Errorf("failed to record login failure: %v", err)
+			return err
+		}
+		if attempt.Failures < t.maxFailures(key) {
+			continue
+		}
+
+		lockout := t.lockoutDuration(attempt.Lockouts)
+		locked, err := t.repo.LockLogin(ctx, key, now, now.Add(lockout), now.Add(lockout+loginAttemptMemory))
+		if err != nil {
+			t.log.Errorf("failed to lock login: %v", err)
+			return err
+		}
+		if locked == nil {
+			// Request khác đã khóa key này
+			continue
+		}
+
+		t.log.WithContext(ctx).Warnf("login locked for %s during %s after %d failures", key, lockout, attempt.Failures)
+		if lockout > retryAfter {
+			retryAfter = lockout
+		}
+	}
+
+	if retryAfter > 0 {
+		return &LoginLockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// Limit counts a request on key and locks the key for window once it exceeds limit requests in window.
+// Dùng cho các endpoint gửi mail (quên mật khẩu), chung store với các lần đăng nhập sai.
+// Trả về *LoginLockedError khi key đang bị khóa hoặc vừa bị khóa.
+func (t *LoginThrottle) Limit(ctx context.Context, key string, limit int, window time.Duration) error {
+	if err := t.Check(ctx, key); err != nil {
+		return err
+	}
+
+	now := t.now()
+	attempt, err := t.repo.AddLoginFailure(ctx, key, now, now.Add(-window), now.Add(window))
+	if err != nil {
+		t.log.Errorf("failed to record request: %v", err)
+		return err
+	}
+	if attempt.Failures <= limit {
+		return nil
+	}
+
+	if _, err := t.repo.LockLogin(ctx, key, now, now.Add(window), now.Add(window)); err != nil {
+		t.log.Errorf("failed to lock %s: %v", key, err)
+		return err
+	}
+	t.log.WithContext(ctx).Warnf("%s locked during %s after %d requests", key, window, attempt.Failures)
+	return &LoginLockedError{RetryAfter: window}
+}
+
+// RecordSuccess clears the failures and lockout history of an account.
+// IP keys are not cleared: logging into one's own account must not reset a password spraying IP.
+func (t *LoginThrottle) RecordSuccess(ctx context.Context, accountKey string) {
+	if err := t.repo.DeleteLoginAttempt(ctx, accountKey); err != nil {
+		t.log.Errorf("failed to reset login attempts: %v", err)
+	}
+}
+
+// maxFailures returns how many failures a key may have in the window
+func (t *LoginThrottle) maxFailures(key string) int {
+	if strings.HasPrefix(key, "ip:") {
+		return t.maxIPFailures
+	}
+	return t.maxAccountFailures
+}
+
+// lockoutDuration doubles the base lockout for every previous lockout, up to maxLockout
+func (t *LoginThrottle) lockoutDuration(previousLockouts int) time.Duration {
+	lockout := t.baseLockout
+	for i := 0; i < previousLockouts && lockout < t.maxLockout; i++ {
+		lockout *= 2
+	}
+	if lockout > t.maxLockout {
+		lockout = t.maxLockout
+	}
+	return lockout
+}
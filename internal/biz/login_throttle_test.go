@@ -0,0 +1,173 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"JobblyBE/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func newTestThrottle(c *conf.Server) (*LoginThrottle, *fakeClock) {
+	clock := newFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	throttle := NewLoginThrottle(newMemoryLoginAttemptRepo(), c, log.DefaultLogger)
+	throttle.SetClock(clock.Now)
+	return throttle, clock
+}
+
+// lockOut records failures on key until it is locked, returns the lockout duration
+func lockOut(t *testing.T, throttle *LoginThrottle, key string, maxFailures int) time.Duration {
+	t.Helper()
+	ctx := context.Background()
+	for i := 1; i < maxFailures; i++ {
+		if err := throttle.RecordFailure(ctx, key); err != nil {
+			t.Fatalf("failure %d: unexpected error %v", i, err)
+		}
+	}
+	var locked *LoginLockedError
+	if err := throttle.RecordFailure(ctx, key); !errors.As(err, &locked) {
+		t.Fatalf("failure %d: error = %v, want *LoginLockedError", maxFailures, err)
+	}
+	return locked.RetryAfter
+}
+
+func TestLoginThrottleBackoff(t *testing.T) {
+	tests := []struct {
+		name string
+		conf *conf.Server
+		want []time.Duration // thời gian khóa của các lần khóa liên tiếp
+	}{
+		{
+			name: "defaults",
+			conf: &conf.Server{},
+			want: []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute, 16 * time.Minute, 32 * time.Minute, time.Hour, time.Hour},
+		},
+		{
+			name: "configured",
+			conf: &conf.Server{LoginThrottle: &conf.Server_LoginThrottle{
+				MaxAccountFailures: 3,
+				BaseLockout:        durationpb.New(10 * time.Second),
+				MaxLockout:         durationpb.New(30 * time.Second),
+			}},
+			want: []time.Duration{10 * time.Second, 20 * time.Second, 30 * time.Second, 30 * time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttle, clock := newTestThrottle(tt.conf)
+			key := AccountKey("user@example.com")
+
+			for i, want := range tt.want {
+				if got := lockOut(t, throttle, key, throttle.maxAccountFailures); got != want {
+					t.Fatalf("lockout %d = %s, want %s", i+1, got, want)
+				}
+				clock.Advance(want)
+			}
+		})
+	}
+}
+
+func TestLoginThrottleCheck(t *testing.T) {
+	ctx := context.Background()
+	throttle, clock := newTestThrottle(&conf.Server{})
+	account, ip := AccountKey(" User@Example.com "), IPKey("203.0.113.5")
+
+	lockOut(t, throttle, account, DefaultMaxAccountLoginFailures)
+
+	var locked *LoginLockedError
+	if err := throttle.Check(ctx, account, ip); !errors.As(err, &locked) || locked.RetryAfter != time.Minute {
+		t.Fatalf("Check() = %v, want lockout of 1m", err)
+	}
+	// Key không phân biệt hoa thường và khoảng trắng
+	if err := throttle.Check(ctx, AccountKey("user@example.com")); !errors.Is(err, ErrTooManyLoginAttempts) {
+		t.Errorf("Check() with normalized email = %v, want %v", err, ErrTooManyLoginAttempts)
+	}
+
+	clock.Advance(40 * time.Second)
+	if err := throttle.Check(ctx, account); !errors.As(err, &locked) || locked.RetryAfter != 20*time.Second {
+		t.Errorf("Check() after 40s = %v, want lockout of 20s", err)
+	}
+
+	clock.Advance(20 * time.Second)
+	if err := throttle.Check(ctx, account, ip); err != nil {
+		t.Errorf("Check() after lockout = %v", err)
+	}
+}
+
+func TestLoginThrottleWindow(t *testing.T) {
+	ctx := context.Background()
+	throttle, clock := newTestThrottle(&conf.Server{})
+	key := AccountKey("user@example.com")
+
+	// Thất bại cũ hơn window không được tính
+	for i := 0; i < DefaultMaxAccountLoginFailures-1; i++ {
+		if err := throttle.RecordFailure(ctx, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clock.Advance(DefaultLoginFailureWindow + time.Second)
+	if err := throttle.RecordFailure(ctx, key); err != nil {
+		t.Errorf("failure after window = %v, want nil", err)
+	}
+}
+
+func TestLoginThrottleIPLimit(t *testing.T) {
+	ctx := context.Background()
+	throttle, _ := newTestThrottle(&conf.Server{})
+	ip := IPKey("203.0.113.5")
+
+	// IP có ngưỡng riêng, cao hơn account
+	lockOut(t, throttle, ip, DefaultMaxIPLoginFailures)
+
+	// Đăng nhập thành công không xóa lịch sử của IP
+	throttle.RecordSuccess(ctx, AccountKey("user@example.com"))
+	if err := throttle.Check(ctx, ip); !errors.Is(err, ErrTooManyLoginAttempts) {
+		t.Errorf("Check(ip) = %v, want %v", err, ErrTooManyLoginAttempts)
+	}
+}
+
+func TestLoginThrottleRecordSuccess(t *testing.T) {
+	ctx := context.Background()
+	throttle, clock := newTestThrottle(&conf.Server{})
+	key := AccountKey("user@example.com")
+
+	lockOut(t, throttle, key, DefaultMaxAccountLoginFailures)
+	clock.Advance(time.Minute)
+	throttle.RecordSuccess(ctx, key)
+
+	// Lịch sử khóa bị xóa nên lần khóa tiếp theo quay về base lockout
+	if got := lockOut(t, throttle, key, DefaultMaxAccountLoginFailures); got != DefaultBaseLoginLockout {
+		t.Errorf("lockout after success = %s, want %s", got, DefaultBaseLoginLockout)
+	}
+}
+
+func TestLoginThrottleLimit(t *testing.T) {
+	ctx := context.Background()
+	throttle, clock := newTestThrottle(&conf.Server{})
+	key := "reset:" + IPKey("203.0.113.5")
+
+	for i := 1; i <= 3; i++ {
+		if err := throttle.Limit(ctx, key, 3, time.Hour); err != nil {
+			t.Fatalf("request %d: unexpected error %v", i, err)
+		}
+		clock.Advance(time.Minute)
+	}
+
+	var locked *LoginLockedError
+	if err := throttle.Limit(ctx, key, 3, time.Hour); !errors.As(err, &locked) || locked.RetryAfter != time.Hour {
+		t.Fatalf("request over limit: error = %v, want lockout of 1h", err)
+	}
+	clock.Advance(30 * time.Minute)
+	if err := throttle.Limit(ctx, key, 3, time.Hour); !errors.As(err, &locked) || locked.RetryAfter != 30*time.Minute {
+		t.Errorf("request while locked: error = %v, want lockout of 30m", err)
+	}
+
+	clock.Advance(30 * time.Minute)
+	if err := throttle.Limit(ctx, key, 3, time.Hour); err != nil {
+		t.Errorf("request after lockout: %v", err)
+	}
+}
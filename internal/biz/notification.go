@@ -0,0 +1,84 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// NotificationType là loại thông báo in-app
+type NotificationType string
+
+const (
+	NotificationJobAlert NotificationType = "JOB_ALERT"
+)
+
+// Notification is an in-app notification of a user
+type Notification struct {
+	ID        string
+	UserID    string
+	Type      NotificationType
+	Title     string
+	Body      string
+	Data      map[string]string // vd: saved_search_id, job_ids
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// NotificationRepo interface
+type NotificationRepo interface {
+	CreateNotification(ctx context.Context, n *Notification) (*Notification, error)
+	// ListNotifications returns the notifications of a user, newest first, cùng số thông báo chưa đọc
+	ListNotifications(ctx context.Context, userID string, unreadOnly bool, page, pageSize int32) ([]*Notification, int32, int32, error)
+	// MarkNotificationRead marks a notification of a user as read, trả về false nếu không có
+	MarkNotificationRead(ctx context.Context, userID, id string, at time.Time) (bool, error)
+	MarkAllNotificationsRead(ctx context.Context, userID string, at time.Time) error
+}
+
+// NotificationUseCase handles the in-app notifications of users
+type NotificationUseCase struct {
+	repo NotificationRepo
+	log  *log.Helper
+}
+
+// NewNotificationUseCase creates a new notification use case
+func NewNotificationUseCase(repo NotificationRepo, logger log.Logger) *NotificationUseCase {
+	return &NotificationUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListNotifications lists the notifications of actor with the number of unread ones
+func (uc *NotificationUseCase) ListNotifications(ctx context.Context, actor *Actor, unreadOnly bool, page, pageSize int32) ([]*Notification, int32, int32, error) {
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return uc.repo.ListNotifications(ctx, actor.UserID, unreadOnly, page, pageSize)
+}
+
+// MarkNotificationRead marks a notification of actor as read
+func (uc *NotificationUseCase) MarkNotificationRead(ctx context.Context, actor *Actor, id string) error {
+	ok, err := uc.repo.MarkNotificationRead(ctx, actor.UserID, id, time.Now())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
+
+// MarkAllNotificationsRead marks every notification of actor as read
+func (uc *NotificationUseCase) MarkAllNotificationsRead(ctx context.Context, actor *Actor) error {
+	return uc.repo.MarkAllNotificationsRead(ctx, actor.UserID, time.Now())
+}
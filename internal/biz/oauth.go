@@ -0,0 +1,263 @@
+package biz
+
+import (
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/oidc"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const OAuthStateDuration = 10 * time.Minute // Thời gian tối đa để hoàn tất đăng nhập tại provider
+
+var (
+	ErrOAuthProviderNotFound = errors.New("oauth provider not found")
+	ErrInvalidOAuthState     = errors.New("invalid or expired oauth state")
+	ErrOAuthExchangeFailed   = errors.New("oauth sign in failed")
+	ErrOAuthEmailNotVerified = errors.New("oauth provider email is not verified")
+	ErrOAuthAccountConflict  = errors.New("an account with this email exists but its email is not verified")
+	ErrIdentityAlreadyLinked = errors.New("provider identity is already linked")
+)
+
+// OAuthState là một lần đăng nhập đang chờ callback, chỉ lưu hash của state
+type OAuthState struct {
+	StateHash    string
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+// OAuthStateRepo persists pending social logins
+type OAuthStateRepo interface {
+	CreateOAuthState(ctx context.Context, state *OAuthState) error
+	// ConsumeOAuthState xóa và trả về state chưa hết hạn (chỉ dùng được một lần).
+	// Trả về nil nếu không có state hợp lệ.
+	ConsumeOAuthState(ctx context.Context, stateHash string) (*OAuthState, error)
+}
+
+// UserIdentity links an account at an identity provider to a user
+type UserIdentity struct {
+	ID        string
+	UserID    string
+	Provider  string
+	Subject   string // ID của user tại provider
+	Email     string
+	CreatedAt time.Time
+}
+
+// UserIdentityRepo persists linked provider identities
+type UserIdentityRepo interface {
+	GetIdentity(ctx context.Context, provider, subject string) (*UserIdentity, error)
+	// CreateIdentity trả về ErrIdentityAlreadyLinked nếu (provider, subject) đã được liên kết
+	CreateIdentity(ctx context.Context, identity *UserIdentity) error
+}
+
+// OAuthUseCase handles social login with the authorization-code flow and PKCE
+type OAuthUseCase struct {
+	providers    map[string]oidc.Provider
+	stateRepo    OAuthStateRepo
+	identityRepo UserIdentityRepo
+	userRepo     UserRepo
+	log          *log.Helper
+}
+
+// NewOAuthUseCase creates a new OAuthUseCase with the providers of conf.Server.oauth_providers
+func NewOAuthUseCase(c *conf.Server, stateRepo OAuthStateRepo, identityRepo UserIdentityRepo, userRepo UserRepo, logger log.Logger) (*OAuthUseCase, error) {
+	providers := make(map[string]oidc.Provider, len(c.GetOauthProviders()))
+	for name, pc := range c.GetOauthProviders() {
+		provider, err := oidc.NewProvider(oidc.Config{
+			Type:         pc.Type,
+			Issuer:       pc.Issuer,
+			ClientID:     pc.ClientId,
+			ClientSecret: pc.ClientSecret,
+			RedirectURL:  pc.RedirectUrl,
+			Scopes:       pc.Scopes,
+			AuthURL:      pc.AuthUrl,
+			TokenURL:     pc.TokenUrl,
+			JWKSURL:      pc.JwksUrl,
+			UserInfoURL:  pc.UserinfoUrl,
+		}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("oauth provider %s: %w", name, err)
+		}
+		providers[name] = provider
+	}
+
+	return &OAuthUseCase{
+		providers:    providers,
+		stateRepo:    stateRepo,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		log:          log.NewHelper(logger),
+	}, nil
+}
+
+// SetProvider thay hoặc thêm provider (dùng với stub provider khi test)
+func (uc *OAuthUseCase) SetProvider(name string, provider oidc.Provider) {
+	uc.providers[name] = provider
+}
+
+// Start creates a pending login and returns the provider authorization URL and its state
+func (uc *OAuthUseCase) Start(ctx context.Context, providerName string) (string, string, error) {
+	uc.log.WithContext(ctx).Infof("StartOAuth: %s", providerName)
+
+	provider, ok := uc.providers[providerName]
+	if !ok {
+		return "", "", ErrOAuthProviderNotFound
+	}
+
+	req := oidc.NewAuthRequest()
+	authURL, err := provider.AuthCodeURL(ctx, req)
+	if err != nil {
+		uc.log.Errorf("failed to build %s authorization url: %v", providerName, err)
+		return "", "", err
+	}
+
+	now := time.Now()
+	state := &OAuthState{
+		StateHash:    hashOAuthState(req.State),
+		Provider:     providerName,
+		CodeVerifier: req.CodeVerifier,
+		Nonce:        req.Nonce,
+		ExpiresAt:    now.Add(OAuthStateDuration),
+		CreatedAt:    now,
+	}
+	if err := uc.stateRepo.CreateOAuthState(ctx, state); err != nil {
+		uc.log.Errorf("failed to save oauth state: %v", err)
+		return "", "", err
+	}
+
+	return authURL, req.State, nil
+}
+
+// Callback finishes the login: verifies state, exchanges the code and returns the linked user.
+//
+// Quy tắc liên kết tài khoản:
+//   - identity (provider, subject) đã liên kết: đăng nhập vào user đó
+//   - chưa liên kết: email phải được provider xác thực. Nếu đã có user với email này thì
+//     chỉ liên kết khi email của user cũng đã xác thực (tránh chiếm tài khoản đăng ký trước
+//     bằng email của người khác), ngược lại tạo user mới không có mật khẩu.
+func (uc *OAuthUseCase) Callback(ctx context.Context, providerName, code, rawState string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("OAuthCallback: %s", providerName)
+
+	provider, ok := uc.providers[providerName]
+	if !ok {
+		return nil, ErrOAuthProviderNotFound
+	}
+
+	state, err := uc.stateRepo.ConsumeOAuthState(ctx, hashOAuthState(rawState))
+	if err != nil {
+		return nil, err
+	}
+	if state == nil || state.Provider != providerName {
+		return nil, ErrInvalidOAuthState
+	}
+
+	identity, err := provider.Exchange(ctx, code, &oidc.AuthRequest{
+		State:        rawState,
+		CodeVerifier: state.CodeVerifier,
+		Nonce:        state.Nonce,
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("oauth exchange with %s failed: %v", providerName, err)
+		if errors.Is(err, oidc.ErrNoEmail) {
+			return nil, ErrOAuthEmailNotVerified
+		}
+		return nil, ErrOAuthExchangeFailed
+	}
+
+	user, err := uc.linkedUser(ctx, providerName, identity)
+	if err != nil {
+		return nil, err
+	}
+	if !user.Active {
+		return nil, ErrUserInactive
+	}
+
+	_ = uc.userRepo.UpdateLastLogin(ctx, user.UserID)
+
+	return user, nil
+}
+
+// linkedUser finds or creates the user of a provider identity
+func (uc *OAuthUseCase) linkedUser(ctx context.Context, providerName string, identity *oidc.Identity) (*User, error) {
+	linked, err := uc.identityRepo.GetIdentity(ctx, providerName, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if linked != nil {
+		user, err := uc.userRepo.GetUserByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, ErrUserNotFound
+		}
+		return user, nil
+	}
+
+	if !identity.EmailVerified {
+		return nil, ErrOAuthEmailNotVerified
+	}
+	email := strings.TrimSpace(identity.Email)
+
+	user, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil && !user.EmailVerified {
+		return nil, ErrOAuthAccountConflict
+	}
+
+	if user == nil {
+		fullName := identity.Name
+		if fullName == "" {
+			fullName = strings.Split(email, "@")[0]
+		}
+		// User đăng nhập bằng provider không có mật khẩu, có thể đặt qua reset password
+		user, err = uc.userRepo.CreateUser(ctx, &User{
+			FullName:      fullName,
+			Email:         email,
+			Role:          RoleUser,
+			Active:        true,
+			EmailVerified: true,
+		})
+		if err != nil {
+			uc.log.Errorf("failed to create user: %v", err)
+			return nil, err
+		}
+		uc.log.WithContext(ctx).Infof("created user %s from %s identity", user.UserID, providerName)
+	}
+
+	err = uc.identityRepo.CreateIdentity(ctx, &UserIdentity{
+		UserID:   user.UserID,
+		Provider: providerName,
+		Subject:  identity.Subject,
+		Email:    email,
+	})
+	if errors.Is(err, ErrIdentityAlreadyLinked) {
+		// Callback song song của cùng identity đã liên kết trước
+		return uc.linkedUser(ctx, providerName, identity)
+	}
+	if err != nil {
+		uc.log.Errorf("failed to link identity: %v", err)
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("linked %s identity to user %s", providerName, user.UserID)
+
+	return user, nil
+}
+
+// hashOAuthState hashes a state before it is stored or looked up
+func hashOAuthState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/oidc"
+	"JobblyBE/pkg/oidc/oidctest"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type oauthFixture struct {
+	uc        *OAuthUseCase
+	states    *memoryOAuthStateRepo
+	users     *memoryUserRepo
+	clock     *fakeClock
+	provider  *oidctest.Server
+	callbacks *http.Client
+}
+
+// newOAuthFixture creates a use case with the stub provider registered as "stub"
+func newOAuthFixture(t *testing.T) *oauthFixture {
+	t.Helper()
+
+	srv := oidctest.NewServer("client", "secret")
+	t.Cleanup(srv.Close)
+
+	provider, err := oidc.NewProvider(oidc.Config{
+		Issuer:       srv.Issuer(),
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:3000/oauth/callback",
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clock := newFakeClock(time.Now())
+	states := &memoryOAuthStateRepo{now: clock.Now, states: map[string]*OAuthState{}}
+	users := &memoryUserRepo{users: map[string]*User{}}
+	uc, err := NewOAuthUseCase(&conf.Server{}, states, &memoryIdentityRepo{identities: map[string]*UserIdentity{}}, users, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc.SetProvider("stub", provider)
+
+	return &oauthFixture{
+		uc:       uc,
+		states:   states,
+		users:    users,
+		clock:    clock,
+		provider: srv,
+		callbacks: &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}},
+	}
+}
+
+// signIn starts a login and returns the code and state sent to the callback page
+func (f *oauthFixture) signIn(t *testing.T) (string, string) {
+	t.Helper()
+	authURL, state, err := f.uc.Start(context.Background(), "stub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := f.callbacks.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if location.Query().Get("state") != state {
+		t.Fatalf("callback state = %q, want %q", location.Query().Get("state"), state)
+	}
+	return location.Query().Get("code"), state
+}
+
+func TestOAuthCallbackState(t *testing.T) {
+	tests := []struct {
+		name     string
+		prepare  func(f *oauthFixture, state string) string // trả về state gửi lên callback
+		provider string
+		wantErr  error
+	}{
+		{
+			name:    "valid",
+			prepare: func(f *oauthFixture, state string) string { return state },
+		},
+		{
+			name:    "unknown state",
+			prepare: func(f *oauthFixture, state string) string { return "forged-state" },
+			wantErr: ErrInvalidOAuthState,
+		},
+		{
+			name:    "empty state",
+			prepare: func(f *oauthFixture, state string) string { return "" },
+			wantErr: ErrInvalidOAuthState,
+		},
+		{
+			name: "expired state",
+			prepare: func(f *oauthFixture, state string) string {
+				f.clock.Advance(OAuthStateDuration + time.Second)
+				return state
+			},
+			wantErr: ErrInvalidOAuthState,
+		},
+		{
+			name: "nonce mismatch",
+			prepare: func(f *oauthFixture, state string) string {
+				f.states.states[hashOAuthState(state)].Nonce = "other-nonce"
+				return state
+			},
+			wantErr: ErrOAuthExchangeFailed,
+		},
+		{
+			name: "state of another provider",
+			prepare: func(f *oauthFixture, state string) string {
+				f.states.states[hashOAuthState(state)].Provider = "google"
+				return state
+			},
+			wantErr: ErrInvalidOAuthState,
+		},
+		{
+			name:     "unknown provider",
+			prepare:  func(f *oauthFixture, state string) string { return state },
+			provider: "unknown",
+			wantErr:  ErrOAuthProviderNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOAuthFixture(t)
+			code, state := f.signIn(t)
+			provider := tt.provider
+			if provider == "" {
+				provider = "stub"
+			}
+
+			user, err := f.uc.Callback(context.Background(), provider, code, tt.prepare(f, state))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Callback() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (user == nil || user.Email != "oidctest@example.com" || !user.EmailVerified) {
+				t.Errorf("Callback() user = %+v", user)
+			}
+		})
+	}
+}
+
+func TestOAuthCallbackStateSingleUse(t *testing.T) {
+	ctx := context.Background()
+	f := newOAuthFixture(t)
+	code, state := f.signIn(t)
+
+	first, err := f.uc.Callback(ctx, "stub", code, state)
+	if err != nil {
+		t.Fatalf("first callback: %v", err)
+	}
+	if _, err := f.uc.Callback(ctx, "stub", code, state); !errors.Is(err, ErrInvalidOAuthState) {
+		t.Errorf("replayed callback: error = %v, want %v", err, ErrInvalidOAuthState)
+	}
+
+	// Lần đăng nhập sau dùng lại identity đã liên kết
+	code, state = f.signIn(t)
+	second, err := f.uc.Callback(ctx, "stub", code, state)
+	if err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	if second.UserID != first.UserID || len(f.users.users) != 1 {
+		t.Errorf("second login user = %s, want %s (users: %d)", second.UserID, first.UserID, len(f.users.users))
+	}
+}
+
+func TestOAuthCallbackUnverifiedEmail(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing *User
+		identity oidctest.User
+		wantErr  error
+	}{
+		{
+			name:     "provider email not verified",
+			identity: oidctest.User{Subject: "s1", Email: "new@example.com", EmailVerified: false},
+			wantErr:  ErrOAuthEmailNotVerified,
+		},
+		{
+			name:     "existing account with unverified email",
+			existing: &User{UserID: "user-existing", Email: "taken@example.com", Active: true},
+			identity: oidctest.User{Subject: "s2", Email: "taken@example.com", EmailVerified: true},
+			wantErr:  ErrOAuthAccountConflict,
+		},
+		{
+			name:     "existing account with verified email",
+			existing: &User{UserID: "user-existing", Email: "taken@example.com", Active: true, EmailVerified: true},
+			identity: oidctest.User{Subject: "s3", Email: "taken@example.com", EmailVerified: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOAuthFixture(t)
+			if tt.existing != nil {
+				f.users.users[tt.existing.UserID] = tt.existing
+			}
+			f.provider.SetUser(tt.identity)
+			code, state := f.signIn(t)
+
+			user, err := f.uc.Callback(context.Background(), "stub", code, state)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Callback() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && tt.existing != nil && user.UserID != tt.existing.UserID {
+				t.Errorf("Callback() linked to %s, want %s", user.UserID, tt.existing.UserID)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package biz
+
+import (
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/configx"
+	"JobblyBE/pkg/mailer"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	PasswordResetTokenDuration = time.Hour // Link reset mật khẩu hết hạn sau 1 giờ
+	// Không gửi link mới nếu link trước (chưa dùng) được gửi trong khoảng này
+	PasswordResetResendInterval = 5 * time.Minute
+
+	// Giới hạn số yêu cầu quên mật khẩu theo email và theo IP trong PasswordResetRequestWindow
+	MaxPasswordResetRequestsPerEmail = 3
+	MaxPasswordResetRequestsPerIP    = 10
+	PasswordResetRequestWindow       = time.Hour
+)
+
+var (
+	ErrInvalidPasswordResetToken    = errors.New("invalid or expired password reset token")
+	ErrTooManyPasswordResetRequests = errors.New("too many password reset requests")
+)
+
+// PasswordResetToken là token reset mật khẩu, chỉ lưu hash của token
+type PasswordResetToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// PasswordResetRepo persists password reset tokens
+type PasswordResetRepo interface {
+	CreateResetToken(ctx context.Context, token *PasswordResetToken) error
+	// ConsumeResetToken đánh dấu token đã dùng nếu token chưa dùng và chưa hết hạn.
+	// Trả về nil nếu không có token hợp lệ.
+	ConsumeResetToken(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+	// DeleteUserResetTokens xóa các token còn lại của user
+	DeleteUserResetTokens(ctx context.Context, userID string) error
+	// HasRecentResetToken reports whether the user has an unused, unexpired token created after since
+	HasRecentResetToken(ctx context.Context, userID string, since time.Time) (bool, error)
+}
+
+// PasswordResetUseCase handles the forgot-password / reset-password flow
+type PasswordResetUseCase struct {
+	authUC     *AuthUseCase
+	userRepo   UserRepo
+	resetRepo  PasswordResetRepo
+	sessionUC  *SessionUseCase
+	throttle   *LoginThrottle
+	mailer     mailer.Mailer
+	appBaseURL string
+	log        *log.Helper
+}
+
+// NewPasswordResetUseCase creates a new PasswordResetUseCase
+func NewPasswordResetUseCase(authUC *AuthUseCase, userRepo UserRepo, resetRepo PasswordResetRepo, sessionUC *SessionUseCase, throttle *LoginThrottle, m mailer.Mailer, c *conf.Server, logger log.Logger) *PasswordResetUseCase {
+	return &PasswordResetUseCase{
+		authUC:     authUC,
+		userRepo:   userRepo,
+		resetRepo:  resetRepo,
+		sessionUC:  sessionUC,
+		throttle:   throttle,
+		mailer:     m,
+		appBaseURL: strings.TrimRight(configx.GetEnvOrString("APP_BASE_URL", c.AppBaseUrl), "/"),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// RequestPasswordReset gửi link reset mật khẩu nếu email tồn tại.
+// Việc tra cứu và gửi mail chạy nền để response (nội dung và thời gian) không
+// tiết lộ email có được đăng ký hay không.
+// clientIP (có thể rỗng) quá giới hạn trả về *LoginLockedError (ErrTooManyPasswordResetRequests).
+// Email quá giới hạn thì bỏ qua mà không báo lỗi, như với email chưa đăng ký.
+func (uc *PasswordResetUseCase) RequestPasswordReset(ctx context.Context, email, clientIP string) error {
+	uc.log.WithContext(ctx).Infof("RequestPasswordReset: %s", email)
+
+	if ipKey := IPKey(clientIP); ipKey != "" {
+		if err := uc.throttle.Limit(ctx, "reset:"+ipKey, MaxPasswordResetRequestsPerIP, PasswordResetRequestWindow); err != nil {
+			var locked *LoginLockedError
+			if errors.As(err, &locked) {
+				return fmt.Errorf("%w: %w", ErrTooManyPasswordResetRequests, locked)
+			}
+			return err
+		}
+	}
+
+	err := uc.throttle.Limit(ctx, "reset:"+AccountKey(email), MaxPasswordResetRequestsPerEmail, PasswordResetRequestWindow)
+	if errors.Is(err, ErrTooManyLoginAttempts) {
+		uc.log.WithContext(ctx).Warnf("password reset requests for %s are rate limited", email)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	bgCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+	go func() {
+		defer cancel()
+		if err := uc.sendResetEmail(bgCtx, email); err != nil {
+			uc.log.WithContext(bgCtx).Errorf("failed to send password reset email: %v", err)
+		}
+	}()
+	return nil
+}
+
+// sendResetEmail tạo token reset và gửi mail cho user có email tương ứng
+func (uc *PasswordResetUseCase) sendResetEmail(ctx context.Context, email string) error {
+	user, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.Active {
+		uc.log.WithContext(ctx).Infof("password reset requested for unknown or inactive email: %s", email)
+		return nil
+	}
+
+	// Link vừa gửi vẫn dùng được, không gửi thêm mail
+	recent, err := uc.resetRepo.HasRecentResetToken(ctx, user.UserID, time.Now().Add(-PasswordResetResendInterval))
+	if err != nil {
+		return err
+	}
+	if recent {
+		uc.log.WithContext(ctx).Infof("password reset link already sent recently to user %s", user.UserID)
+		return nil
+	}
+
+	token, err := newResetToken()
+	if err != nil {
+		return err
+	}
+
+	resetToken := &PasswordResetToken{
+		UserID:    user.UserID,
+		TokenHash: hashResetToken(token),
+		ExpiresAt: time.Now().Add(PasswordResetTokenDuration),
+	}
+	if err := uc.resetRepo.CreateResetToken(ctx, resetToken); err != nil {
+		return err
+	}
+
+	link := uc.appBaseURL + "/reset-password?token=" + url.QueryEscape(token)
+	msg := &mailer.Message{
+		To:      []string{user.Email},
+		Subject: "Đặt lại mật khẩu Jobbly",
+		TextBody: fmt.Sprintf(
+			"Xin chào %s,\n\nChúng tôi nhận được yêu cầu đặt lại mật khẩu cho tài khoản của bạn. Mở link sau để đặt mật khẩu mới (hết hạn sau %d phút, chỉ dùng được một lần):\n%s\n\nNếu bạn không yêu cầu, hãy bỏ qua email này, mật khẩu của bạn sẽ không thay đổi.\n",
+			user.FullName, int(PasswordResetTokenDuration.Minutes()), link,
+		),
+	}
+
+	return uc.mailer.Send(ctx, msg)
+}
+
+// ResetPassword đặt mật khẩu mới bằng token trong link, sau đó đăng xuất mọi thiết bị
+func (uc *PasswordResetUseCase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	// Validate trước để không tiêu token khi mật khẩu mới không hợp lệ
+	if err := uc.authUC.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	resetToken, err := uc.resetRepo.ConsumeResetToken(ctx, hashResetToken(token))
+	if err != nil {
+		uc.log.Errorf("failed to consume password reset token: %v", err)
+		return err
+	}
+	if resetToken == nil {
+		return ErrInvalidPasswordResetToken
+	}
+
+	uc.log.WithContext(ctx).Infof("ResetPassword: %s", resetToken.UserID)
+
+	if err := uc.authUC.ResetPassword(ctx, resetToken.UserID, newPassword); err != nil {
+		return err
+	}
+
+	// Các link reset khác của user không còn cần thiết
+	if err := uc.resetRepo.DeleteUserResetTokens(ctx, resetToken.UserID); err != nil {
+		uc.log.Errorf("failed to delete password reset tokens: %v", err)
+	}
+
+	// Thu hồi tất cả session (và refresh token) hiện có
+	if _, err := uc.sessionUC.RevokeOtherSessions(ctx, resetToken.UserID, "", SessionRevokePasswordReset); err != nil {
+		uc.log.Errorf("failed to revoke sessions after password reset: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// newResetToken sinh token ngẫu nhiên 256 bit
+func newResetToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashResetToken trả về SHA-256 của token, chỉ hash được lưu vào database
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
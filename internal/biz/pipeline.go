@@ -0,0 +1,339 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidPipeline        = errors.New("invalid hiring pipeline")
+	ErrPipelineStageInUse     = errors.New("pipeline stage still has applications")
+	ErrInvalidStageTransition = errors.New("invalid stage transition")
+)
+
+// Giới hạn của một pipeline
+const (
+	MaxPipelineStages     = 20
+	MaxStageChangeReason  = 1000
+	pipelineStageKeyLimit = 32
+)
+
+var pipelineStageKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// PipelineStage is a stage of a hiring pipeline
+type PipelineStage struct {
+	Key         string   // vd: "screening", lưu trong application
+	Name        string   // tên hiển thị
+	Transitions []string // key của các stage có thể chuyển tới, rỗng = stage cuối
+}
+
+// HiringPipeline lists the stages applications to the jobs of a company go through.
+// Stage đầu tiên là stage của đơn mới nộp.
+type HiringPipeline struct {
+	CompanyID string
+	Stages    []*PipelineStage
+	UpdatedBy string
+	UpdatedAt *time.Time // nil = pipeline mặc định
+}
+
+// DefaultHiringPipeline returns the pipeline of companies that did not configure one
+func DefaultHiringPipeline(companyID string) *HiringPipeline {
+	return &HiringPipeline{
+		CompanyID: companyID,
+		Stages: []*PipelineStage{
+			{Key: "applied", Name: "Applied", Transitions: []string{"screening", "rejected"}},
+			{Key: "screening", Name: "Screening", Transitions: []string{"interview", "rejected"}},
+			{Key: "interview", Name: "Interview", Transitions: []string{"offer", "rejected"}},
+			{Key: "offer", Name: "Offer", Transitions: []string{"hired", "rejected"}},
+			{Key: "hired", Name: "Hired"},
+			{Key: "rejected", Name: "Rejected"},
+		},
+	}
+}
+
+// InitialStage returns the stage of new applications
+func (p *HiringPipeline) InitialStage() string {
+	return p.Stages[0].Key
+}
+
+// Stage returns the stage with key, nil nếu không có
+func (p *HiringPipeline) Stage(key string) *PipelineStage {
+	for _, stage := range p.Stages {
+		if stage.Key == key {
+			return stage
+		}
+	}
+	return nil
+}
+
+// CanMove reports whether an application may move from stage from to stage to
+func (p *HiringPipeline) CanMove(from, to string) bool {
+	stage := p.Stage(from)
+	if stage == nil {
+		return false
+	}
+	for _, next := range stage.Transitions {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// validate checks the stages: key hợp lệ và không trùng, transition trỏ tới stage có thật
+func (p *HiringPipeline) validate() error {
+	if len(p.Stages) < 2 || len(p.Stages) > MaxPipelineStages {
+		return ErrInvalidPipeline
+	}
+
+	keys := make(map[string]bool, len(p.Stages))
+	for _, stage := range p.Stages {
+		stage.Key = strings.TrimSpace(stage.Key)
+		stage.Name = strings.TrimSpace(stage.Name)
+		if len(stage.Key) > pipelineStageKeyLimit || !pipelineStageKeyPattern.MatchString(stage.Key) || keys[stage.Key] {
+			return ErrInvalidPipeline
+		}
+		if stage.Name == "" {
+			stage.Name = stage.Key
+		}
+		keys[stage.Key] = true
+	}
+	for _, stage := range p.Stages {
+		seen := map[string]bool{}
+		for _, next := range stage.Transitions {
+			if next == stage.Key || !keys[next] || seen[next] {
+				return ErrInvalidPipeline
+			}
+			seen[next] = true
+		}
+	}
+
+	return nil
+}
+
+// StageChange is an entry of the stage history of an application
+type StageChange struct {
+	From      string // rỗng cho lần nộp đơn
+	To        string
+	Reason    string
+	ActorID   string
+	ChangedAt time.Time
+}
+
+// PipelineRepo stores the hiring pipeline of each company
+type PipelineRepo interface {
+	// GetPipeline returns the pipeline configured by a company, nil nếu dùng pipeline mặc định
+	GetPipeline(ctx context.Context, companyID string) (*HiringPipeline, error)
+	SavePipeline(ctx context.Context, pipeline *HiringPipeline) error
+}
+
+// StageCount is the number of applications of a job in a stage
+type StageCount struct {
+	Stage string
+	Name  string
+	Count int32
+}
+
+// JobPipelineStats are the funnel metrics of a job
+type JobPipelineStats struct {
+	JobID     string
+	Stages    []*StageCount // theo thứ tự của pipeline, chỉ tính đơn chưa rút
+	Withdrawn int32
+	Total     int32
+}
+
+// GetHiringPipeline returns the pipeline of a company (company members only)
+func (uc *ApplicationUseCase) GetHiringPipeline(ctx context.Context, actor *Actor, companyID string) (*HiringPipeline, error) {
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, companyID); err != nil {
+		return nil, err
+	}
+	return uc.pipeline(ctx, companyID)
+}
+
+// UpdateHiringPipeline replaces the stages of a company pipeline (owner only).
+// Không thể bỏ stage còn đơn ứng tuyển đang nằm ở đó.
+func (uc *ApplicationUseCase) UpdateHiringPipeline(ctx context.Context, actor *Actor, companyID string, stages []*PipelineStage) (*HiringPipeline, error) {
+	uc.log.WithContext(ctx).Infof("UpdateHiringPipeline: %s", companyID)
+
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, companyID, MemberRoleOwner); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.pipeline(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	pipeline := &HiringPipeline{
+		CompanyID: companyID,
+		Stages:    stages,
+		UpdatedBy: actor.UserID,
+		UpdatedAt: &now,
+	}
+	if err := pipeline.validate(); err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.appRepo.CountApplicationsByStage(ctx, &ApplicationFilter{CompanyID: companyID, Status: ApplicationStatusSubmitted})
+	if err != nil {
+		return nil, err
+	}
+	for key, count := range counts {
+		if key == "" {
+			key = existing.InitialStage()
+		}
+		if count > 0 && pipeline.Stage(key) == nil {
+			return nil, ErrPipelineStageInUse
+		}
+	}
+	// Đơn cũ chưa có stage thuộc stage đầu của pipeline cũ, nên stage này phải giữ nguyên vị trí
+	if counts[""] > 0 && pipeline.InitialStage() != existing.InitialStage() {
+		return nil, ErrPipelineStageInUse
+	}
+
+	if err := uc.pipelineRepo.SavePipeline(ctx, pipeline); err != nil {
+		return nil, err
+	}
+
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actor.UserID,
+		Action:     AuditCompanyPipelineUpdated,
+		TargetType: AuditTargetCompany,
+		TargetID:   companyID,
+		Before:     map[string]interface{}{"stages": pipelineStageKeys(existing)},
+		After:      map[string]interface{}{"stages": pipelineStageKeys(pipeline)},
+	})
+
+	return pipeline, nil
+}
+
+// MoveApplication moves a submitted application to another stage of the company pipeline
+// (company members only) and appends the change to its stage history
+func (uc *ApplicationUseCase) MoveApplication(ctx context.Context, actor *Actor, id, toStage, reason string) (*Application, error) {
+	uc.log.WithContext(ctx).Infof("MoveApplication: %s -> %s", id, toStage)
+
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > MaxStageChangeReason {
+		return nil, ErrInvalidApplication
+	}
+
+	app, err := uc.GetApplication(ctx, actor, id)
+	if err != nil {
+		return nil, err
+	}
+	// Ứng viên không tự chuyển stage đơn của mình
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, app.CompanyID); err != nil {
+		return nil, err
+	}
+	if app.Status != ApplicationStatusSubmitted {
+		return nil, ErrInvalidApplicationStatus
+	}
+
+	pipeline, err := uc.pipeline(ctx, app.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	from := app.Stage
+	if from == "" {
+		from = pipeline.InitialStage()
+	}
+	if !pipeline.CanMove(from, toStage) {
+		return nil, ErrInvalidStageTransition
+	}
+
+	change := &StageChange{
+		From:      from,
+		To:        toStage,
+		Reason:    reason,
+		ActorID:   actor.UserID,
+		ChangedAt: time.Now(),
+	}
+	// So sánh với stage đang lưu để không ghi đè thay đổi đồng thời
+	ok, err := uc.appRepo.MoveApplicationStage(ctx, id, app.Stage, change)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidStageTransition
+	}
+
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actor.UserID,
+		Action:     AuditApplicationStageChanged,
+		TargetType: AuditTargetApplication,
+		TargetID:   id,
+		Before:     map[string]interface{}{"stage": from},
+		After:      map[string]interface{}{"stage": toStage},
+		Reason:     reason,
+	})
+
+	return uc.appRepo.GetApplication(ctx, id)
+}
+
+// GetJobPipelineStats counts the applications of a job in each stage (company members only)
+func (uc *ApplicationUseCase) GetJobPipelineStats(ctx context.Context, actor *Actor, jobID string) (*JobPipelineStats, error) {
+	job, err := uc.jobRepo.GetJobPosting(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrJobNotFound
+	}
+	if err := authorizeCompanyAction(ctx, uc.memberRepo, actor, job.CompanyID); err != nil {
+		return nil, err
+	}
+
+	pipeline, err := uc.pipeline(ctx, job.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	active, err := uc.appRepo.CountApplicationsByStage(ctx, &ApplicationFilter{JobID: jobID, Status: ApplicationStatusSubmitted})
+	if err != nil {
+		return nil, err
+	}
+	withdrawn, err := uc.appRepo.CountApplicationsByStage(ctx, &ApplicationFilter{JobID: jobID, Status: ApplicationStatusWithdrawn})
+	if err != nil {
+		return nil, err
+	}
+
+	// Đơn chưa có stage (nộp trước khi có pipeline) nằm ở stage đầu
+	active[pipeline.InitialStage()] += active[""]
+	delete(active, "")
+
+	stats := &JobPipelineStats{JobID: jobID}
+	for _, stage := range pipeline.Stages {
+		stats.Stages = append(stats.Stages, &StageCount{Stage: stage.Key, Name: stage.Name, Count: active[stage.Key]})
+		stats.Total += active[stage.Key]
+	}
+	for _, count := range withdrawn {
+		stats.Withdrawn += count
+	}
+	stats.Total += stats.Withdrawn
+
+	return stats, nil
+}
+
+// pipeline returns the pipeline of a company, or the default pipeline
+func (uc *ApplicationUseCase) pipeline(ctx context.Context, companyID string) (*HiringPipeline, error) {
+	pipeline, err := uc.pipelineRepo.GetPipeline(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if pipeline == nil || len(pipeline.Stages) == 0 {
+		return DefaultHiringPipeline(companyID), nil
+	}
+	return pipeline, nil
+}
+
+func pipelineStageKeys(p *HiringPipeline) []string {
+	keys := make([]string, 0, len(p.Stages))
+	for _, stage := range p.Stages {
+		keys = append(keys, stage.Key)
+	}
+	return keys
+}
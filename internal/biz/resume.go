@@ -54,15 +54,19 @@ type ResumeRepo interface {
 
 // ResumeUseCase is the use case for resume operations
 type ResumeUseCase struct {
-	repo ResumeRepo
-	log  *log.Helper
+	repo     ResumeRepo
+	userRepo UserRepo
+	audit    AuditRecorder
+	log      *log.Helper
 }
 
 // NewResumeUseCase creates a new resume use case
-func NewResumeUseCase(repo ResumeRepo, logger log.Logger) *ResumeUseCase {
+func NewResumeUseCase(repo ResumeRepo, userRepo UserRepo, audit AuditRecorder, logger log.Logger) *ResumeUseCase {
 	return &ResumeUseCase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:     repo,
+		userRepo: userRepo,
+		audit:    audit,
+		log:      log.NewHelper(logger),
 	}
 }
 
@@ -73,6 +77,18 @@ func (uc *ResumeUseCase) CreateResume(ctx context.Context, resume *Resume) (*Res
 		return nil, err
 	}
 
+	// Only users with a verified email may create a resume
+	user, err := uc.userRepo.GetUserByID(ctx, resume.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUnauthorized
+	}
+	if !user.EmailVerified {
+		return nil, ErrResumeEmailNotVerified
+	}
+
 	// Check if user already has a resume
 	existingResumes, _, err := uc.repo.ListResumes(ctx, resume.UserID, 1, 1)
 	if err != nil {
@@ -86,7 +102,14 @@ func (uc *ResumeUseCase) CreateResume(ctx context.Context, resume *Resume) (*Res
 	resume.CreatedAt = time.Now()
 	resume.Version = 1
 
-	return uc.repo.CreateResume(ctx, resume)
+	created, err := uc.repo.CreateResume(ctx, resume)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, created.UserID, AuditResumeCreated, created.ID, nil, resumeAuditFields(created))
+
+	return created, nil
 }
 
 // UpdateResume updates an existing resume
@@ -114,7 +137,15 @@ func (uc *ResumeUseCase) UpdateResume(ctx context.Context, resume *Resume) (*Res
 	resume.Version = existing.Version + 1
 	resume.CreatedAt = existing.CreatedAt
 
-	return uc.repo.UpdateResume(ctx, resume)
+	updated, err := uc.repo.UpdateResume(ctx, resume)
+	if err != nil {
+		return nil, err
+	}
+
+	before, after := auditDiff(resumeAuditFields(existing), resumeAuditFields(updated))
+	uc.record(ctx, resume.UserID, AuditResumeUpdated, resume.ID, before, after)
+
+	return updated, nil
 }
 
 // GetResume retrieves a resume by ID
@@ -163,7 +194,38 @@ func (uc *ResumeUseCase) DeleteResume(ctx context.Context, id, userID string) er
 		return ErrUnauthorized
 	}
 
-	return uc.repo.DeleteResume(ctx, id)
+	if err := uc.repo.DeleteResume(ctx, id); err != nil {
+		return err
+	}
+
+	uc.record(ctx, userID, AuditResumeDeleted, id, resumeAuditFields(existing), nil)
+
+	return nil
+}
+
+// record writes an audit entry of a change made by a user to their resume
+func (uc *ResumeUseCase) record(ctx context.Context, userID, action, resumeID string, before, after map[string]interface{}) {
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    userID,
+		Action:     action,
+		TargetType: AuditTargetResume,
+		TargetID:   resumeID,
+		Before:     before,
+		After:      after,
+	})
+}
+
+// resumeAuditFields lists the resume fields compared for the audit log.
+// Chỉ ghi version và các field định danh, không chép toàn bộ nội dung CV vào audit log;
+// giá trị của name, email, phone bị recordAudit thay bằng AuditRedacted.
+func resumeAuditFields(resume *Resume) map[string]interface{} {
+	fields := map[string]interface{}{"version": resume.Version}
+	if resume.ResumeDetail != nil {
+		fields["name"] = resume.ResumeDetail.Name
+		fields["email"] = resume.ResumeDetail.Email
+		fields["phone"] = resume.ResumeDetail.Phone
+	}
+	return fields
 }
 
 // validateResume validates resume data
@@ -189,4 +251,6 @@ var (
 	ErrInvalidResume       = errors.BadRequest("INVALID_RESUME", "Invalid resume data")
 	ErrUnauthorized        = errors.Forbidden("UNAUTHORIZED", "You don't have permission to access this resume")
 	ErrResumeAlreadyExists = errors.BadRequest("RESUME_ALREADY_EXISTS", "You already have a resume. Please update it instead of creating a new one")
+	// ErrResumeEmailNotVerified uses the same reason as the auth EMAIL_NOT_VERIFIED error
+	ErrResumeEmailNotVerified = errors.Forbidden("EMAIL_NOT_VERIFIED", "Please verify your email before creating a resume")
 )
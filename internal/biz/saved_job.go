@@ -0,0 +1,97 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SavedJob is a job posting bookmarked by a candidate
+type SavedJob struct {
+	UserID  string
+	JobID   string
+	Job     *JobPosting // kèm company info, status hiện tại cho biết job đã đóng/hết hạn chưa
+	SavedAt time.Time
+}
+
+// SavedJobRepo interface
+type SavedJobRepo interface {
+	// SaveJob bookmarks a job, lưu lại job đã lưu thì giữ nguyên thời điểm lưu ban đầu
+	SaveJob(ctx context.Context, userID, jobID string, at time.Time) error
+	DeleteSavedJob(ctx context.Context, userID, jobID string) error
+	// ListSavedJobs returns the saved jobs of a user with job and company info, newest first.
+	// Job đã bị xóa không được trả về.
+	ListSavedJobs(ctx context.Context, userID string, page, pageSize int32) ([]*SavedJob, int32, error)
+	// SavedJobIDs returns which of jobIDs the user has saved
+	SavedJobIDs(ctx context.Context, userID string, jobIDs []string) (map[string]bool, error)
+}
+
+// SavedJobUseCase handles the saved jobs of candidates
+type SavedJobUseCase struct {
+	savedRepo SavedJobRepo
+	jobRepo   JobPostingRepo
+	log       *log.Helper
+}
+
+// NewSavedJobUseCase creates a new saved job use case
+func NewSavedJobUseCase(savedRepo SavedJobRepo, jobRepo JobPostingRepo, logger log.Logger) *SavedJobUseCase {
+	return &SavedJobUseCase{
+		savedRepo: savedRepo,
+		jobRepo:   jobRepo,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// SaveJob bookmarks a published job for actor
+func (uc *SavedJobUseCase) SaveJob(ctx context.Context, actor *Actor, jobID string) (*JobPosting, error) {
+	uc.log.WithContext(ctx).Infof("SaveJob: %s -> %s", actor.UserID, jobID)
+
+	job, err := uc.jobRepo.GetJobPosting(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	// Chỉ lưu được job đang hiển thị công khai
+	if job == nil || !job.IsPublic(time.Now()) {
+		return nil, ErrJobNotFound
+	}
+
+	if err := uc.savedRepo.SaveJob(ctx, actor.UserID, job.ID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// UnsaveJob removes a job from the saved jobs of actor, không lỗi nếu job chưa được lưu
+func (uc *SavedJobUseCase) UnsaveJob(ctx context.Context, actor *Actor, jobID string) error {
+	uc.log.WithContext(ctx).Infof("UnsaveJob: %s -> %s", actor.UserID, jobID)
+
+	return uc.savedRepo.DeleteSavedJob(ctx, actor.UserID, jobID)
+}
+
+// ListSavedJobs lists the saved jobs of actor, newest first, kể cả job đã đóng hoặc hết hạn
+func (uc *SavedJobUseCase) ListSavedJobs(ctx context.Context, actor *Actor, page, pageSize int32) ([]*SavedJob, int32, error) {
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return uc.savedRepo.ListSavedJobs(ctx, actor.UserID, page, pageSize)
+}
+
+// SavedJobIDs returns which of jobs actor has saved, rỗng cho request ẩn danh (actor nil)
+func (uc *SavedJobUseCase) SavedJobIDs(ctx context.Context, actor *Actor, jobs []*JobPosting) (map[string]bool, error) {
+	if actor == nil || len(jobs) == 0 {
+		return map[string]bool{}, nil
+	}
+
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+	return uc.savedRepo.SavedJobIDs(ctx, actor.UserID, ids)
+}
@@ -0,0 +1,294 @@
+package biz
+
+import (
+	"JobblyBE/pkg/middleware/auth"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrSessionRevoked     = errors.New("session has been revoked")
+	ErrRefreshTokenReused = errors.New("refresh token has already been used")
+)
+
+// Session revoke reasons
+const (
+	SessionRevokeLogout         = "logout"
+	SessionRevokeTokenReuse     = "refresh_token_reuse"
+	SessionRevokeByUser         = "revoked_by_user"
+	SessionRevokePasswordChange = "password_changed"
+	SessionRevokePasswordReset  = "password_reset"
+	SessionRevokeByAdmin        = "revoked_by_admin"
+	SessionRevokeAccountDeleted = "account_deleted"
+)
+
+// Session is a refresh-token family: every token issued for one login.
+// Only CurrentRefreshTokenID may be exchanged; any older token of the
+// family being presented again means it was stolen.
+type Session struct {
+	ID                    string
+	UserID                string
+	UserAgent             string
+	IPAddress             string
+	CurrentRefreshTokenID string
+	AccessTokenID         string
+	AccessExpiresAt       time.Time
+	Revoked               bool
+	RevokedAt             *time.Time
+	RevokeReason          string
+	ExpiresAt             time.Time
+	LastSeenAt            time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// SessionClient describes the device a session was opened from
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+// IssuedTokens describes a freshly signed token pair of a session
+type IssuedTokens struct {
+	RefreshTokenID   string
+	RefreshExpiresAt time.Time
+	AccessTokenID    string
+	AccessExpiresAt  time.Time
+}
+
+// SessionRepo persists sessions (refresh-token families)
+type SessionRepo interface {
+	CreateSession(ctx context.Context, session *Session) (*Session, error)
+	GetSession(ctx context.Context, id string) (*Session, error)
+	// ListActiveSessions returns the sessions of a user that are neither revoked nor expired
+	ListActiveSessions(ctx context.Context, userID string) ([]*Session, error)
+	// SetTokens records the token pair issued when the session is created
+	SetTokens(ctx context.Context, id string, tokens *IssuedTokens) error
+	// RotateTokens atomically replaces the current refresh token if it is still
+	// currentRefreshTokenID and the session is not revoked, and returns the session
+	// before the rotation. Returns nil otherwise.
+	RotateTokens(ctx context.Context, id, currentRefreshTokenID string, tokens *IssuedTokens) (*Session, error)
+	RevokeSession(ctx context.Context, id, reason string) (*Session, error)
+}
+
+// Security event types
+const (
+	SecurityEventRefreshTokenReuse = "refresh_token_reuse"
+)
+
+// SecurityEvent records a suspicious or security relevant action on an account
+type SecurityEvent struct {
+	ID        string
+	UserID    string
+	SessionID string
+	Type      string
+	Detail    string
+	CreatedAt time.Time
+}
+
+// SecurityEventRepo persists security events
+type SecurityEventRepo interface {
+	CreateSecurityEvent(ctx context.Context, event *SecurityEvent) error
+}
+
+// SessionUseCase handles sessions and refresh-token rotation
+type SessionUseCase struct {
+	sessionRepo       SessionRepo
+	securityEventRepo SecurityEventRepo
+	revocationStore   auth.RevocationStore
+	log               *log.Helper
+}
+
+// NewSessionUseCase creates a new session use case
+func NewSessionUseCase(sessionRepo SessionRepo, securityEventRepo SecurityEventRepo, revocationStore auth.RevocationStore, logger log.Logger) *SessionUseCase {
+	return &SessionUseCase{
+		sessionRepo:       sessionRepo,
+		securityEventRepo: securityEventRepo,
+		revocationStore:   revocationStore,
+		log:               log.NewHelper(logger),
+	}
+}
+
+// CreateSession starts a new token family for a login
+func (uc *SessionUseCase) CreateSession(ctx context.Context, userID string, client *SessionClient) (*Session, error) {
+	uc.log.WithContext(ctx).Infof("CreateSession: %s", userID)
+
+	session := &Session{
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(auth.RefreshTokenDuration),
+	}
+	if client != nil {
+		session.UserAgent = client.UserAgent
+		session.IPAddress = client.IPAddress
+	}
+
+	createdSession, err := uc.sessionRepo.CreateSession(ctx, session)
+	if err != nil {
+		uc.log.Errorf("failed to create session: %v", err)
+		return nil, err
+	}
+
+	return createdSession, nil
+}
+
+// SetTokens records the first token pair issued for a session
+func (uc *SessionUseCase) SetTokens(ctx context.Context, sessionID string, tokens *IssuedTokens) error {
+	if err := uc.sessionRepo.SetTokens(ctx, sessionID, tokens); err != nil {
+		uc.log.Errorf("failed to set session tokens: %v", err)
+		return err
+	}
+	return nil
+}
+
+// RotateRefreshToken retires the presented refresh token and makes tokens the
+// current pair of the session. Presenting a retired token revokes the whole family.
+func (uc *SessionUseCase) RotateRefreshToken(ctx context.Context, sessionID, presentedTokenID string, tokens *IssuedTokens) error {
+	uc.log.WithContext(ctx).Infof("RotateRefreshToken: %s", sessionID)
+
+	previous, err := uc.sessionRepo.RotateTokens(ctx, sessionID, presentedTokenID, tokens)
+	if err != nil {
+		uc.log.Errorf("failed to rotate refresh token: %v", err)
+		return err
+	}
+	if previous != nil {
+		// Access token cũ của session hết hiệu lực ngay khi refresh
+		return uc.revokeAccessToken(ctx, previous)
+	}
+
+	// Rotation failed: find out why
+	session, err := uc.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return ErrSessionNotFound
+	}
+	if session.Revoked {
+		return ErrSessionRevoked
+	}
+
+	// The session is alive but the presented token is not the current one:
+	// a retired refresh token is being replayed
+	uc.log.WithContext(ctx).Warnf("refresh token reuse detected for session %s (user %s)", sessionID, session.UserID)
+
+	if _, err := uc.revoke(ctx, sessionID, SessionRevokeTokenReuse); err != nil {
+		return err
+	}
+
+	event := &SecurityEvent{
+		UserID:    session.UserID,
+		SessionID: sessionID,
+		Type:      SecurityEventRefreshTokenReuse,
+		Detail:    "retired refresh token " + presentedTokenID + " was presented again, session revoked",
+	}
+	if err := uc.securityEventRepo.CreateSecurityEvent(ctx, event); err != nil {
+		uc.log.Errorf("failed to record security event: %v", err)
+	}
+
+	return ErrRefreshTokenReused
+}
+
+// ListSessions returns the active sessions (signed in devices) of a user
+func (uc *SessionUseCase) ListSessions(ctx context.Context, userID string) ([]*Session, error) {
+	uc.log.WithContext(ctx).Infof("ListSessions: %s", userID)
+
+	sessions, err := uc.sessionRepo.ListActiveSessions(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("failed to list sessions: %v", err)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// RevokeUserSession revokes one of the user's own sessions.
+// Sessions of other users are reported as not found.
+func (uc *SessionUseCase) RevokeUserSession(ctx context.Context, userID, sessionID string) error {
+	uc.log.WithContext(ctx).Infof("RevokeUserSession: %s (user %s)", sessionID, userID)
+
+	session, err := uc.sessionRepo.GetSession(ctx, sessionID)
+	if err != nil {
+		uc.log.Errorf("failed to get session: %v", err)
+		return err
+	}
+	if session == nil || session.UserID != userID || session.Revoked {
+		return ErrSessionNotFound
+	}
+
+	return uc.RevokeSession(ctx, sessionID, SessionRevokeByUser)
+}
+
+// RevokeOtherSessions revokes every active session of the user except
+// currentSessionID and returns how many were revoked
+func (uc *SessionUseCase) RevokeOtherSessions(ctx context.Context, userID, currentSessionID, reason string) (int, error) {
+	uc.log.WithContext(ctx).Infof("RevokeOtherSessions: %s (keep %s)", userID, currentSessionID)
+
+	sessions, err := uc.sessionRepo.ListActiveSessions(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("failed to list sessions: %v", err)
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.ID == currentSessionID {
+			continue
+		}
+		if _, err := uc.revoke(ctx, session.ID, reason); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+
+	return revoked, nil
+}
+
+// RevokeSession revokes a session and its latest access token.
+// Access token cũ hơn đã bị thu hồi khi refresh; middleware còn từ chối mọi token có sid của session bị thu hồi.
+func (uc *SessionUseCase) RevokeSession(ctx context.Context, sessionID, reason string) error {
+	uc.log.WithContext(ctx).Infof("RevokeSession: %s (%s)", sessionID, reason)
+
+	session, err := uc.revoke(ctx, sessionID, reason)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// revoke marks the session revoked and blacklists its current access token
+func (uc *SessionUseCase) revoke(ctx context.Context, sessionID, reason string) (*Session, error) {
+	session, err := uc.sessionRepo.RevokeSession(ctx, sessionID, reason)
+	if err != nil {
+		uc.log.Errorf("failed to revoke session: %v", err)
+		return nil, err
+	}
+	if session == nil {
+		return nil, nil
+	}
+
+	if err := uc.revokeAccessToken(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// revokeAccessToken blacklists the access token recorded on session
+func (uc *SessionUseCase) revokeAccessToken(ctx context.Context, session *Session) error {
+	if session.AccessTokenID == "" || uc.revocationStore == nil {
+		return nil
+	}
+	if err := uc.revocationStore.Revoke(ctx, session.AccessTokenID, session.AccessExpiresAt); err != nil {
+		uc.log.Errorf("failed to revoke session access token: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+package biz
+
+import (
+	"JobblyBE/pkg/middleware/auth"
+	"JobblyBE/pkg/totp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	TOTPIssuer = "Jobbly"
+	// Số step lệch cho phép mỗi phía (bù sai lệch đồng hồ của điện thoại)
+	TOTPSkew = 1
+	// Số recovery code sinh ra khi bật 2FA
+	RecoveryCodeCount = 10
+)
+
+var (
+	ErrTOTPAlreadyEnabled    = errors.New("totp is already enabled")
+	ErrTOTPNotEnrolled       = errors.New("totp is not enrolled")
+	ErrInvalidTOTPCode       = errors.New("invalid totp code")
+	ErrInvalidLoginChallenge = errors.New("invalid or expired login challenge")
+)
+
+// UserTOTP là cấu hình TOTP của một user
+type UserTOTP struct {
+	UserID             string
+	Secret             string
+	Enabled            bool
+	RecoveryCodeHashes []string
+	LastUsedStep       int64
+	ConfirmedAt        *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// TOTPRepo persists TOTP settings of users
+type TOTPRepo interface {
+	GetUserTOTP(ctx context.Context, userID string) (*UserTOTP, error)
+	// SavePendingSecret lưu secret mới (chưa bật) cho user chưa bật 2FA
+	SavePendingSecret(ctx context.Context, userID, secret string) error
+	// Enable bật 2FA với recovery codes đã hash, lastUsedStep là step của code xác nhận
+	Enable(ctx context.Context, userID string, recoveryCodeHashes []string, lastUsedStep int64) error
+	// UseStep ghi nhận step vừa dùng nếu lớn hơn step trước đó (chống dùng lại code). Trả về false nếu không.
+	UseStep(ctx context.Context, userID string, step int64) (bool, error)
+	// UseRecoveryCode xóa recovery code khỏi danh sách. Trả về false nếu code không tồn tại.
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+}
+
+// TOTPUseCase handles TOTP two-factor authentication
+type TOTPUseCase struct {
+	totpRepo        TOTPRepo
+	userRepo        UserRepo
+	throttle        *LoginThrottle
+	revocationStore auth.RevocationStore
+	keys            *auth.KeySet
+	now             func() time.Time
+	log             *log.Helper
+}
+
+// NewTOTPUseCase creates a new TOTPUseCase
+func NewTOTPUseCase(totpRepo TOTPRepo, userRepo UserRepo, throttle *LoginThrottle, revocationStore auth.RevocationStore, keys *auth.KeySet, logger log.Logger) *TOTPUseCase {
+	return &TOTPUseCase{
+		totpRepo:        totpRepo,
+		userRepo:        userRepo,
+		throttle:        throttle,
+		revocationStore: revocationStore,
+		keys:            keys,
+		now:             time.Now,
+		log:             log.NewHelper(logger),
+	}
+}
+
+// SetClock thay đồng hồ của use case (dùng fake clock khi test)
+func (uc *TOTPUseCase) SetClock(now func() time.Time) {
+	uc.now = now
+}
+
+// IsEnabled reports whether the user has 2FA enabled
+func (uc *TOTPUseCase) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	userTOTP, err := uc.totpRepo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return userTOTP != nil && userTOTP.Enabled, nil
+}
+
+// Enroll sinh secret mới cho user, trả về secret và otpauth URI.
+// 2FA chỉ được bật sau khi Confirm với một code hợp lệ.
+func (uc *TOTPUseCase) Enroll(ctx context.Context, userID string) (string, string, error) {
+	uc.log.WithContext(ctx).Infof("EnrollTOTP: %s", userID)
+
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if user == nil {
+		return "", "", ErrUserNotFound
+	}
+
+	enabled, err := uc.IsEnabled(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if enabled {
+		return "", "", ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		uc.log.Errorf("failed to generate totp secret: %v", err)
+		return "", "", err
+	}
+
+	if err := uc.totpRepo.SavePendingSecret(ctx, userID, secret); err != nil {
+		uc.log.Errorf("failed to save totp secret: %v", err)
+		return "", "", err
+	}
+
+	return secret, totp.URI(TOTPIssuer, user.Email, secret), nil
+}
+
+// Confirm bật 2FA nếu code khớp với secret đang chờ, trả về recovery codes (chỉ hiển thị một lần)
+func (uc *TOTPUseCase) Confirm(ctx context.Context, userID, code string) ([]string, error) {
+	uc.log.WithContext(ctx).Infof("ConfirmTOTP: %s", userID)
+
+	userTOTP, err := uc.totpRepo.GetUserTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if userTOTP == nil || userTOTP.Secret == "" {
+		return nil, ErrTOTPNotEnrolled
+	}
+	if userTOTP.Enabled {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+
+	step, ok := totp.Validate(userTOTP.Secret, code, uc.now(), TOTPSkew)
+	if !ok {
+		return nil, ErrInvalidTOTPCode
+	}
+
+	codes, hashes, err := newRecoveryCodes(RecoveryCodeCount)
+	if err != nil {
+		uc.log.Errorf("failed to generate recovery codes: %v", err)
+		return nil, err
+	}
+
+	if err := uc.totpRepo.Enable(ctx, userID, hashes, step); err != nil {
+		uc.log.Errorf("failed to enable totp: %v", err)
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// IssueLoginChallenge tạo challenge token sau khi user đã nhập đúng mật khẩu
+func (uc *TOTPUseCase) IssueLoginChallenge(ctx context.Context, user *User) (string, error) {
+	token, _, err := auth.GenerateLoginChallengeToken(user.UserID, user.Email, uc.keys, uc.now())
+	if err != nil {
+		uc.log.Errorf("failed to generate login challenge: %v", err)
+		return "", err
+	}
+	return token, nil
+}
+
+// VerifyLoginChallenge kiểm tra challenge token cùng TOTP code hoặc recovery code,
+// trả về user để phát hành token thật. Mỗi challenge chỉ dùng thành công được một lần.
+// Code sai được đếm theo user (LoginThrottle, key TOTPKey): khi bị khóa, challenge đang dùng
+// bị thu hồi và user phải đăng nhập lại bằng mật khẩu.
+func (uc *TOTPUseCase) VerifyLoginChallenge(ctx context.Context, challengeToken, code, recoveryCode string) (*User, error) {
+	claims, err := auth.ValidateTokenAt(challengeToken, uc.keys, auth.LoginChallengeToken, uc.now())
+	if err != nil {
+		return nil, ErrInvalidLoginChallenge
+	}
+	if err := auth.CheckRevoked(ctx, uc.revocationStore, claims); err != nil {
+		if errors.Is(err, auth.ErrRevokedToken) {
+			return nil, ErrInvalidLoginChallenge
+		}
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("VerifyLoginTOTP: %s", claims.UserID)
+
+	user, err := uc.userRepo.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrInvalidLoginChallenge
+	}
+	if !user.Active {
+		return nil, ErrUserInactive
+	}
+
+	userTOTP, err := uc.totpRepo.GetUserTOTP(ctx, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if userTOTP == nil || !userTOTP.Enabled {
+		return nil, ErrTOTPNotEnrolled
+	}
+
+	throttleKey := TOTPKey(user.UserID)
+	if err := uc.throttle.Check(ctx, throttleKey); err != nil {
+		return nil, err
+	}
+
+	var used bool
+	if recoveryCode != "" {
+		used, err = uc.totpRepo.UseRecoveryCode(ctx, user.UserID, hashRecoveryCode(recoveryCode))
+		if err != nil {
+			return nil, err
+		}
+	} else if step, ok := totp.Validate(userTOTP.Secret, code, uc.now(), TOTPSkew); ok {
+		// Mỗi code chỉ dùng được một lần
+		used, err = uc.totpRepo.UseStep(ctx, user.UserID, step)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !used {
+		return nil, uc.recordCodeFailure(ctx, claims, throttleKey)
+	}
+	uc.throttle.RecordSuccess(ctx, throttleKey)
+
+	// Challenge đã dùng xong thì thu hồi
+	if err := auth.RevokeClaims(ctx, uc.revocationStore, claims); err != nil {
+		uc.log.Errorf("failed to revoke login challenge: %v", err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// recordCodeFailure counts a wrong code of a login challenge.
+// Trả về ErrInvalidTOTPCode, hoặc *LoginLockedError và thu hồi challenge nếu user vừa bị khóa.
+func (uc *TOTPUseCase) recordCodeFailure(ctx context.Context, claims *auth.JWTClaims, throttleKey string) error {
+	err := uc.throttle.RecordFailure(ctx, throttleKey)
+	if err == nil {
+		return ErrInvalidTOTPCode
+	}
+	if errors.Is(err, ErrTooManyLoginAttempts) {
+		if revokeErr := auth.RevokeClaims(ctx, uc.revocationStore, claims); revokeErr != nil {
+			uc.log.Errorf("failed to revoke login challenge: %v", revokeErr)
+			return revokeErr
+		}
+	}
+	return err
+}
+
+// newRecoveryCodes sinh n recovery code dạng xxxxx-xxxxx cùng hash của chúng
+func newRecoveryCodes(n int) ([]string, []string, error) {
+	// Crockford base32: 32 ký tự, không có i, l, o, u dễ nhầm
+	const alphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+
+	codes := make([]string, 0, n)
+	hashes := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		for j := range b {
+			b[j] = alphabet[b[j]&31]
+		}
+		code := string(b[:5]) + "-" + string(b[5:])
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode chuẩn hóa (bỏ dấu gạch, khoảng trắng, chữ thường) rồi hash SHA-256
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,185 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/middleware/auth"
+	"JobblyBE/pkg/totp"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const testUserID = "user-1"
+
+type totpFixture struct {
+	uc     *TOTPUseCase
+	clock  *fakeClock
+	secret string
+	totp   *UserTOTP
+}
+
+// newTOTPFixture creates a use case with 2FA enabled for testUserID.
+// Đồng hồ neo theo giờ thật (giữa một step) vì MemoryRevocationStore dùng time.Now.
+func newTOTPFixture(t *testing.T) *totpFixture {
+	t.Helper()
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := newFakeClock(time.Now().Truncate(totp.Period * time.Second).Add(15 * time.Second))
+
+	userTOTP := &UserTOTP{UserID: testUserID, Secret: secret, Enabled: true, RecoveryCodeHashes: []string{hashRecoveryCode("abcde-fghjk")}}
+	totpRepo := &memoryTOTPRepo{users: map[string]*UserTOTP{testUserID: userTOTP}}
+	userRepo := &memoryUserRepo{users: map[string]*User{testUserID: {UserID: testUserID, Email: "user@example.com", Active: true}}}
+
+	throttle := NewLoginThrottle(newMemoryLoginAttemptRepo(), &conf.Server{}, log.DefaultLogger)
+	throttle.SetClock(clock.Now)
+
+	uc := NewTOTPUseCase(totpRepo, userRepo, throttle, auth.NewMemoryRevocationStore(), auth.NewHMACKeySet("test-secret"), log.DefaultLogger)
+	uc.SetClock(clock.Now)
+
+	return &totpFixture{uc: uc, clock: clock, secret: secret, totp: userTOTP}
+}
+
+func (f *totpFixture) challenge(t *testing.T) string {
+	t.Helper()
+	token, err := f.uc.IssueLoginChallenge(context.Background(), &User{UserID: testUserID, Email: "user@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func (f *totpFixture) code(t *testing.T, offset time.Duration) string {
+	t.Helper()
+	code, err := totp.Code(f.secret, f.clock.Now().Add(offset))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// wrongCode returns a code that is not valid in any step accepted now
+func (f *totpFixture) wrongCode(t *testing.T) string {
+	t.Helper()
+	for _, candidate := range []string{"000000", "111111", "222222", "333333"} {
+		if _, ok := totp.Validate(f.secret, candidate, f.clock.Now(), TOTPSkew); !ok {
+			return candidate
+		}
+	}
+	t.Fatal("no wrong code candidate")
+	return ""
+}
+
+func TestVerifyLoginChallengeSkew(t *testing.T) {
+	tests := []struct {
+		name    string
+		offset  time.Duration // thời điểm của đồng hồ điện thoại so với server
+		wantErr error
+	}{
+		{"current step", 0, nil},
+		{"one step behind", -30 * time.Second, nil},
+		{"one step ahead", 30 * time.Second, nil},
+		{"two steps behind", -60 * time.Second, ErrInvalidTOTPCode},
+		{"two steps ahead", 60 * time.Second, ErrInvalidTOTPCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTOTPFixture(t)
+			user, err := f.uc.VerifyLoginChallenge(context.Background(), f.challenge(t), f.code(t, tt.offset), "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyLoginChallenge() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (user == nil || user.UserID != testUserID) {
+				t.Errorf("VerifyLoginChallenge() user = %+v", user)
+			}
+		})
+	}
+}
+
+func TestVerifyLoginChallengeSingleUse(t *testing.T) {
+	ctx := context.Background()
+	f := newTOTPFixture(t)
+	challenge := f.challenge(t)
+	code := f.code(t, 0)
+
+	if _, err := f.uc.VerifyLoginChallenge(ctx, challenge, code, ""); err != nil {
+		t.Fatalf("first verification: %v", err)
+	}
+	// Challenge đã dùng bị thu hồi
+	if _, err := f.uc.VerifyLoginChallenge(ctx, challenge, code, ""); !errors.Is(err, ErrInvalidLoginChallenge) {
+		t.Errorf("reused challenge: error = %v, want %v", err, ErrInvalidLoginChallenge)
+	}
+	// Cùng code với challenge mới: step đã dùng
+	if _, err := f.uc.VerifyLoginChallenge(ctx, f.challenge(t), code, ""); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Errorf("replayed code: error = %v, want %v", err, ErrInvalidTOTPCode)
+	}
+	// Step tiếp theo dùng được
+	f.clock.Advance(30 * time.Second)
+	if _, err := f.uc.VerifyLoginChallenge(ctx, f.challenge(t), f.code(t, 0), ""); err != nil {
+		t.Errorf("next step: %v", err)
+	}
+}
+
+func TestVerifyLoginChallengeRecoveryCode(t *testing.T) {
+	ctx := context.Background()
+	f := newTOTPFixture(t)
+
+	if _, err := f.uc.VerifyLoginChallenge(ctx, f.challenge(t), "", "abcde-fghjk"); err != nil {
+		t.Fatalf("recovery code: %v", err)
+	}
+	if len(f.totp.RecoveryCodeHashes) != 0 {
+		t.Errorf("recovery code was not consumed")
+	}
+	if _, err := f.uc.VerifyLoginChallenge(ctx, f.challenge(t), "", "abcde-fghjk"); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Errorf("reused recovery code: error = %v, want %v", err, ErrInvalidTOTPCode)
+	}
+}
+
+func TestVerifyLoginChallengeExpired(t *testing.T) {
+	f := newTOTPFixture(t)
+	challenge := f.challenge(t)
+
+	f.clock.Advance(auth.LoginChallengeTokenDuration + time.Second)
+	if _, err := f.uc.VerifyLoginChallenge(context.Background(), challenge, f.code(t, 0), ""); !errors.Is(err, ErrInvalidLoginChallenge) {
+		t.Errorf("expired challenge: error = %v, want %v", err, ErrInvalidLoginChallenge)
+	}
+}
+
+func TestVerifyLoginChallengeLockout(t *testing.T) {
+	ctx := context.Background()
+	f := newTOTPFixture(t)
+	challenge := f.challenge(t)
+	wrong := f.wrongCode(t)
+
+	for i := 1; i < DefaultMaxAccountLoginFailures; i++ {
+		if _, err := f.uc.VerifyLoginChallenge(ctx, challenge, wrong, ""); !errors.Is(err, ErrInvalidTOTPCode) {
+			t.Fatalf("failure %d: error = %v, want %v", i, err, ErrInvalidTOTPCode)
+		}
+	}
+
+	_, err := f.uc.VerifyLoginChallenge(ctx, challenge, wrong, "")
+	var locked *LoginLockedError
+	if !errors.As(err, &locked) || locked.RetryAfter != DefaultBaseLoginLockout {
+		t.Fatalf("failure %d: error = %v, want lockout of %s", DefaultMaxAccountLoginFailures, err, DefaultBaseLoginLockout)
+	}
+
+	// Challenge bị thu hồi, kể cả với code đúng
+	if _, err := f.uc.VerifyLoginChallenge(ctx, challenge, f.code(t, 0), ""); !errors.Is(err, ErrInvalidLoginChallenge) {
+		t.Errorf("revoked challenge: error = %v, want %v", err, ErrInvalidLoginChallenge)
+	}
+	// Challenge mới vẫn bị khóa trong thời gian lockout
+	if _, err := f.uc.VerifyLoginChallenge(ctx, f.challenge(t), f.code(t, 0), ""); !errors.Is(err, ErrTooManyLoginAttempts) {
+		t.Errorf("new challenge while locked: error = %v, want %v", err, ErrTooManyLoginAttempts)
+	}
+
+	f.clock.Advance(DefaultBaseLoginLockout)
+	if _, err := f.uc.VerifyLoginChallenge(ctx, f.challenge(t), f.code(t, 0), ""); err != nil {
+		t.Errorf("after lockout: %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+package biz
+
+import (
+	"JobblyBE/pkg/middleware/auth"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrInvalidRole       = errors.New("invalid role")
+	ErrCannotModifySelf  = errors.New("admins can not deactivate, demote or delete themselves")
+	ErrCannotImpersonate = errors.New("admins can not impersonate themselves or other admins")
+)
+
+// IsValidRole reports whether role is a known user role
+func IsValidRole(role Role) bool {
+	switch role {
+	case RoleUser, RoleRecruiter, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// UserAdminUseCase handles user management by administrators.
+// Mọi thay đổi đều được ghi vào audit log.
+type UserAdminUseCase struct {
+	userRepo  UserRepo
+	sessionUC *SessionUseCase
+	accountUC *AccountDataUseCase
+	audit     AuditRecorder
+	keys      *auth.KeySet
+	log       *log.Helper
+}
+
+// Impersonation is a short-lived access token issued to an admin acting as a user
+type Impersonation struct {
+	AccessToken string
+	ExpiresAt   time.Time
+	User        *User
+}
+
+// NewUserAdminUseCase creates a new UserAdminUseCase
+func NewUserAdminUseCase(userRepo UserRepo, sessionUC *SessionUseCase, accountUC *AccountDataUseCase, audit AuditRecorder, keys *auth.KeySet, logger log.Logger) *UserAdminUseCase {
+	return &UserAdminUseCase{
+		userRepo:  userRepo,
+		sessionUC: sessionUC,
+		accountUC: accountUC,
+		audit:     audit,
+		keys:      keys,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// ListUsers lists and searches users
+func (uc *UserAdminUseCase) ListUsers(ctx context.Context, filter *UserFilter, page, pageSize int32) ([]*User, int32, error) {
+	// Validate pagination
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	if filter != nil && filter.Role != "" && !IsValidRole(filter.Role) {
+		return nil, 0, ErrInvalidRole
+	}
+
+	return uc.userRepo.ListUsers(ctx, filter, page, pageSize)
+}
+
+// GetUser returns a user
+func (uc *UserAdminUseCase) GetUser(ctx context.Context, userID string) (*User, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+// SetActive activates or deactivates a user. Deactivation also revokes every session.
+func (uc *UserAdminUseCase) SetActive(ctx context.Context, actor *Actor, userID string, active bool, reason string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("SetUserActive: %s -> %v by %s", userID, active, actor.UserID)
+
+	if !active && userID == actor.UserID {
+		return nil, ErrCannotModifySelf
+	}
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Active == active {
+		return user, nil
+	}
+
+	if err := uc.userRepo.SetUserActive(ctx, userID, active); err != nil {
+		return nil, err
+	}
+	if !active {
+		if _, err := uc.sessionUC.RevokeOtherSessions(ctx, userID, "", SessionRevokeByAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	action := AuditUserActivated
+	if !active {
+		action = AuditUserDeactivated
+	}
+	uc.record(ctx, actor, action, userID, reason,
+		map[string]interface{}{"active": user.Active},
+		map[string]interface{}{"active": active})
+
+	user.Active = active
+	return user, nil
+}
+
+// ChangeRole changes the role of a user and revokes their sessions,
+// so tokens carrying the old role stop working at once
+func (uc *UserAdminUseCase) ChangeRole(ctx context.Context, actor *Actor, userID string, role Role, reason string) (*User, error) {
+	uc.log.WithContext(ctx).Infof("ChangeUserRole: %s -> %s by %s", userID, role, actor.UserID)
+
+	if !IsValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+	if userID == actor.UserID && role != RoleAdmin {
+		return nil, ErrCannotModifySelf
+	}
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == role {
+		return user, nil
+	}
+
+	if err := uc.userRepo.SetUserRole(ctx, userID, role); err != nil {
+		return nil, err
+	}
+	if _, err := uc.sessionUC.RevokeOtherSessions(ctx, userID, "", SessionRevokeByAdmin); err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, actor, AuditUserRoleChanged, userID, reason,
+		map[string]interface{}{"role": string(user.Role)},
+		map[string]interface{}{"role": string(role)})
+
+	user.Role = role
+	return user, nil
+}
+
+// ForceLogout revokes every session of a user and returns how many were revoked
+func (uc *UserAdminUseCase) ForceLogout(ctx context.Context, actor *Actor, userID, reason string) (int, error) {
+	uc.log.WithContext(ctx).Infof("ForceLogoutUser: %s by %s", userID, actor.UserID)
+
+	if _, err := uc.GetUser(ctx, userID); err != nil {
+		return 0, err
+	}
+
+	revoked, err := uc.sessionUC.RevokeOtherSessions(ctx, userID, "", SessionRevokeByAdmin)
+	if err != nil {
+		return revoked, err
+	}
+
+	uc.record(ctx, actor, AuditUserLoggedOut, userID, reason, nil,
+		map[string]interface{}{"revoked_sessions": revoked})
+
+	return revoked, nil
+}
+
+// DeleteUser revokes the sessions of a user and purges all of its data at once (no grace period)
+func (uc *UserAdminUseCase) DeleteUser(ctx context.Context, actor *Actor, userID, reason string) error {
+	uc.log.WithContext(ctx).Infof("DeleteUser: %s by %s", userID, actor.UserID)
+
+	if userID == actor.UserID {
+		return ErrCannotModifySelf
+	}
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uc.sessionUC.RevokeOtherSessions(ctx, userID, "", SessionRevokeByAdmin); err != nil {
+		return err
+	}
+	if _, err := uc.accountUC.PurgeAccount(ctx, userID); err != nil {
+		return err
+	}
+
+	uc.record(ctx, actor, AuditUserDeleted, userID, reason,
+		map[string]interface{}{"email": user.Email, "full_name": user.FullName, "role": string(user.Role)}, nil)
+
+	return nil
+}
+
+// Impersonate issues an access token acting as the user, carrying the admin in the act claim.
+// Chỉ mạo danh được user đang active và không phải admin; token không thuộc session nào.
+func (uc *UserAdminUseCase) Impersonate(ctx context.Context, actor *Actor, userID, reason string) (*Impersonation, error) {
+	uc.log.WithContext(ctx).Infof("Impersonate: %s by %s", userID, actor.UserID)
+
+	if userID == actor.UserID {
+		return nil, ErrCannotImpersonate
+	}
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == RoleAdmin {
+		return nil, ErrCannotImpersonate
+	}
+	if !user.Active {
+		return nil, ErrUserInactive
+	}
+
+	admin, err := uc.GetUser(ctx, actor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, claims, err := auth.GenerateImpersonationToken(user.UserID, user.Email, user.FullName, user.PhoneNumber, string(user.Role),
+		&auth.ActorClaim{UserID: admin.UserID, Email: admin.Email}, uc.keys)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.record(ctx, actor, AuditUserImpersonated, userID, reason, nil,
+		map[string]interface{}{"token_id": claims.ID, "expires_at": claims.ExpiresAt.Time})
+
+	return &Impersonation{
+		AccessToken: token,
+		ExpiresAt:   claims.ExpiresAt.Time,
+		User:        user,
+	}, nil
+}
+
+// record writes an audit entry of a change made by actor to a user
+func (uc *UserAdminUseCase) record(ctx context.Context, actor *Actor, action, userID, reason string, before, after map[string]interface{}) {
+	recordAudit(ctx, uc.audit, uc.log, &AuditEntry{
+		ActorID:    actor.UserID,
+		Action:     action,
+		TargetType: AuditTargetUser,
+		TargetID:   userID,
+		Before:     before,
+		After:      after,
+		Reason:     reason,
+	})
+}
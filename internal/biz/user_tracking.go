@@ -22,8 +22,13 @@ type UserTracking struct {
 	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
 }
 
+// UserTrackingRetention là thời gian giữ dữ liệu tracking trước khi bị xóa
+const UserTrackingRetention = 180 * 24 * time.Hour
+
 type UserTrackingRepo interface {
 	CreateUserTracking(ctx context.Context, userTracking *UserTracking) (*UserTracking, error)
+	// DeleteUserTrackingBefore deletes the tracking created before t
+	DeleteUserTrackingBefore(ctx context.Context, t time.Time) (int64, error)
 }
 
 type UserTrackingUseCase struct {
@@ -84,3 +89,12 @@ func (uc *UserTrackingUseCase) CreateUserTrackingJobFilter(ctx context.Context,
 	}
 	return nil
 }
+
+// PurgeStaleTracking deletes the tracking older than UserTrackingRetention (chạy bởi scheduler)
+func (uc *UserTrackingUseCase) PurgeStaleTracking(ctx context.Context, now time.Time) (int, error) {
+	deleted, err := uc.UserTrackingRepo.DeleteUserTrackingBefore(ctx, now.Add(-UserTrackingRetention))
+	if err != nil {
+		return 0, err
+	}
+	return int(deleted), nil
+}
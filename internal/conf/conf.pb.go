@@ -80,8 +80,22 @@ type Server struct {
 	Grpc            *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
 	JwtSecret       string                 `protobuf:"bytes,3,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
 	ResumeParserUrl string                 `protobuf:"bytes,4,opt,name=resume_parser_url,json=resumeParserUrl,proto3" json:"resume_parser_url,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	Mail            *Server_Mail           `protobuf:"bytes,5,opt,name=mail,proto3" json:"mail,omitempty"`
+	// Public URL of the frontend, used to build links sent by email
+	AppBaseUrl string `protobuf:"bytes,6,opt,name=app_base_url,json=appBaseUrl,proto3" json:"app_base_url,omitempty"`
+	// Asymmetric token signing; without a signing key tokens are signed with jwt_secret (HS256)
+	Jwt *Server_JWT `protobuf:"bytes,7,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	// Brute-force protection of Login and ChangePassword
+	LoginThrottle *Server_LoginThrottle `protobuf:"bytes,8,opt,name=login_throttle,json=loginThrottle,proto3" json:"login_throttle,omitempty"`
+	// Social login providers by name (google, github, ...), used in /api/v1/auth/oauth/{provider}
+	OauthProviders map[string]*Server_OAuthProvider `protobuf:"bytes,9,rep,name=oauth_providers,json=oauthProviders,proto3" json:"oauth_providers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Background tasks (job expiry, scheduled publishing, cleanups)
+	Scheduler *Server_Scheduler `protobuf:"bytes,10,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	// IPs or CIDRs of the reverse proxies allowed to set X-Forwarded-For / X-Real-IP,
+	// empty = the client IP is always the remote address of the connection
+	TrustedProxies []string `protobuf:"bytes,11,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server) Reset() {
@@ -142,6 +156,55 @@ func (x *Server) GetResumeParserUrl() string {
 	return ""
 }
 
+func (x *Server) GetMail() *Server_Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+func (x *Server) GetAppBaseUrl() string {
+	if x != nil {
+		return x.AppBaseUrl
+	}
+	return ""
+}
+
+func (x *Server) GetJwt() *Server_JWT {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
+func (x *Server) GetLoginThrottle() *Server_LoginThrottle {
+	if x != nil {
+		return x.LoginThrottle
+	}
+	return nil
+}
+
+func (x *Server) GetOauthProviders() map[string]*Server_OAuthProvider {
+	if x != nil {
+		return x.OauthProviders
+	}
+	return nil
+}
+
+func (x *Server) GetScheduler() *Server_Scheduler {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+func (x *Server) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
@@ -306,6 +369,509 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Server_Mail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"` // smtp | file | log
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	From          string                 `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	Dir           string                 `protobuf:"bytes,7,opt,name=dir,proto3" json:"dir,omitempty"` // output directory of the file driver
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Mail) Reset() {
+	*x = Server_Mail{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Mail) ProtoMessage() {}
+
+func (x *Server_Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Mail.ProtoReflect.Descriptor instead.
+func (*Server_Mail) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Server_Mail) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Server_Mail) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Server_Mail) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Server_Mail) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Server_Mail) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Server_Mail) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Server_Mail) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type Server_JWT struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PEM private key (RSA >= 2048 bit or Ed25519) used to sign new tokens
+	SigningKey *Server_JWT_Key `protobuf:"bytes,1,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"`
+	// Public keys that are still accepted while keys are being rotated
+	VerificationKeys []*Server_JWT_Key `protobuf:"bytes,2,rep,name=verification_keys,json=verificationKeys,proto3" json:"verification_keys,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Server_JWT) Reset() {
+	*x = Server_JWT{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_JWT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_JWT) ProtoMessage() {}
+
+func (x *Server_JWT) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_JWT.ProtoReflect.Descriptor instead.
+func (*Server_JWT) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *Server_JWT) GetSigningKey() *Server_JWT_Key {
+	if x != nil {
+		return x.SigningKey
+	}
+	return nil
+}
+
+func (x *Server_JWT) GetVerificationKeys() []*Server_JWT_Key {
+	if x != nil {
+		return x.VerificationKeys
+	}
+	return nil
+}
+
+type Server_LoginThrottle struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MaxAccountFailures int32                  `protobuf:"varint,1,opt,name=max_account_failures,json=maxAccountFailures,proto3" json:"max_account_failures,omitempty"` // default 5
+	MaxIpFailures      int32                  `protobuf:"varint,2,opt,name=max_ip_failures,json=maxIpFailures,proto3" json:"max_ip_failures,omitempty"`                // default 20
+	Window             *durationpb.Duration   `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`                                                      // sliding window, default 15m
+	BaseLockout        *durationpb.Duration   `protobuf:"bytes,4,opt,name=base_lockout,json=baseLockout,proto3" json:"base_lockout,omitempty"`                         // first lockout, doubled on every new lockout, default 1m
+	MaxLockout         *durationpb.Duration   `protobuf:"bytes,5,opt,name=max_lockout,json=maxLockout,proto3" json:"max_lockout,omitempty"`                            // default 1h
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Server_LoginThrottle) Reset() {
+	*x = Server_LoginThrottle{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_LoginThrottle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_LoginThrottle) ProtoMessage() {}
+
+func (x *Server_LoginThrottle) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_LoginThrottle.ProtoReflect.Descriptor instead.
+func (*Server_LoginThrottle) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *Server_LoginThrottle) GetMaxAccountFailures() int32 {
+	if x != nil {
+		return x.MaxAccountFailures
+	}
+	return 0
+}
+
+func (x *Server_LoginThrottle) GetMaxIpFailures() int32 {
+	if x != nil {
+		return x.MaxIpFailures
+	}
+	return 0
+}
+
+func (x *Server_LoginThrottle) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Server_LoginThrottle) GetBaseLockout() *durationpb.Duration {
+	if x != nil {
+		return x.BaseLockout
+	}
+	return nil
+}
+
+func (x *Server_LoginThrottle) GetMaxLockout() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLockout
+	}
+	return nil
+}
+
+type Server_OAuthProvider struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Type         string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`     // oidc (default) | github
+	Issuer       string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"` // OIDC issuer, endpoints are discovered from /.well-known/openid-configuration
+	ClientId     string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUrl  string                 `protobuf:"bytes,5,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"` // frontend callback page that forwards code & state to /callback
+	Scopes       []string               `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Optional endpoint overrides
+	AuthUrl       string `protobuf:"bytes,7,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	TokenUrl      string `protobuf:"bytes,8,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	JwksUrl       string `protobuf:"bytes,9,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
+	UserinfoUrl   string `protobuf:"bytes,10,opt,name=userinfo_url,json=userinfoUrl,proto3" json:"userinfo_url,omitempty"` // github: API base URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_OAuthProvider) Reset() {
+	*x = Server_OAuthProvider{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_OAuthProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_OAuthProvider) ProtoMessage() {}
+
+func (x *Server_OAuthProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_OAuthProvider.ProtoReflect.Descriptor instead.
+func (*Server_OAuthProvider) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *Server_OAuthProvider) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Server_OAuthProvider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Server_OAuthProvider) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Server_OAuthProvider) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *Server_OAuthProvider) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *Server_OAuthProvider) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *Server_OAuthProvider) GetAuthUrl() string {
+	if x != nil {
+		return x.AuthUrl
+	}
+	return ""
+}
+
+func (x *Server_OAuthProvider) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *Server_OAuthProvider) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *Server_OAuthProvider) GetUserinfoUrl() string {
+	if x != nil {
+		return x.UserinfoUrl
+	}
+	return ""
+}
+
+type Server_Scheduler struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Disabled      bool                              `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`                                                                    // do not run any task on this replica
+	Tasks         map[string]*Server_Scheduler_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // overrides by task name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Scheduler) Reset() {
+	*x = Server_Scheduler{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Scheduler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Scheduler) ProtoMessage() {}
+
+func (x *Server_Scheduler) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Scheduler.ProtoReflect.Descriptor instead.
+func (*Server_Scheduler) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 6}
+}
+
+func (x *Server_Scheduler) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Server_Scheduler) GetTasks() map[string]*Server_Scheduler_Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type Server_JWT_Key struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // kid, empty = RFC 7638 thumbprint of the key
+	File          string                 `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"` // PEM file
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_JWT_Key) Reset() {
+	*x = Server_JWT_Key{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_JWT_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_JWT_Key) ProtoMessage() {}
+
+func (x *Server_JWT_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_JWT_Key.ProtoReflect.Descriptor instead.
+func (*Server_JWT_Key) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 3, 0}
+}
+
+func (x *Server_JWT_Key) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Server_JWT_Key) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type Server_Scheduler_Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      string                 `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"` // cron spec "m h dom mon dow" or "@every 5m", empty = default schedule
+	Disabled      bool                   `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	LeaseTtl      *durationpb.Duration   `protobuf:"bytes,3,opt,name=lease_ttl,json=leaseTtl,proto3" json:"lease_ttl,omitempty"` // also the timeout of a run, default 10m
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Scheduler_Task) Reset() {
+	*x = Server_Scheduler_Task{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Scheduler_Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Scheduler_Task) ProtoMessage() {}
+
+func (x *Server_Scheduler_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Scheduler_Task.ProtoReflect.Descriptor instead.
+func (*Server_Scheduler_Task) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 6, 0}
+}
+
+func (x *Server_Scheduler_Task) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *Server_Scheduler_Task) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Server_Scheduler_Task) GetLeaseTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LeaseTtl
+	}
+	return nil
+}
+
 type Data_Database struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
@@ -317,7 +883,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -329,7 +895,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,13 +940,22 @@ const file_conf_conf_proto_rawDesc = "" +
 	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"]\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\x83\x03\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xc8\x10\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12\x1d\n" +
 	"\n" +
 	"jwt_secret\x18\x03 \x01(\tR\tjwtSecret\x12*\n" +
-	"\x11resume_parser_url\x18\x04 \x01(\tR\x0fresumeParserUrl\x1ai\n" +
+	"\x11resume_parser_url\x18\x04 \x01(\tR\x0fresumeParserUrl\x12+\n" +
+	"\x04mail\x18\x05 \x01(\v2\x17.kratos.api.Server.MailR\x04mail\x12 \n" +
+	"\fapp_base_url\x18\x06 \x01(\tR\n" +
+	"appBaseUrl\x12(\n" +
+	"\x03jwt\x18\a \x01(\v2\x16.kratos.api.Server.JWTR\x03jwt\x12G\n" +
+	"\x0elogin_throttle\x18\b \x01(\v2 .kratos.api.Server.LoginThrottleR\rloginThrottle\x12O\n" +
+	"\x0foauth_providers\x18\t \x03(\v2&.kratos.api.Server.OauthProvidersEntryR\x0eoauthProviders\x12:\n" +
+	"\tscheduler\x18\n" +
+	" \x01(\v2\x1c.kratos.api.Server.SchedulerR\tscheduler\x12'\n" +
+	"\x0ftrusted_proxies\x18\v \x03(\tR\x0etrustedProxies\x1ai\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -388,7 +963,55 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x8d\x01\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xa4\x01\n" +
+	"\x04Mail\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04from\x18\x06 \x01(\tR\x04from\x12\x10\n" +
+	"\x03dir\x18\a \x01(\tR\x03dir\x1a\xb6\x01\n" +
+	"\x03JWT\x12;\n" +
+	"\vsigning_key\x18\x01 \x01(\v2\x1a.kratos.api.Server.JWT.KeyR\n" +
+	"signingKey\x12G\n" +
+	"\x11verification_keys\x18\x02 \x03(\v2\x1a.kratos.api.Server.JWT.KeyR\x10verificationKeys\x1a)\n" +
+	"\x03Key\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04file\x18\x02 \x01(\tR\x04file\x1a\x96\x02\n" +
+	"\rLoginThrottle\x120\n" +
+	"\x14max_account_failures\x18\x01 \x01(\x05R\x12maxAccountFailures\x12&\n" +
+	"\x0fmax_ip_failures\x18\x02 \x01(\x05R\rmaxIpFailures\x121\n" +
+	"\x06window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12<\n" +
+	"\fbase_lockout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vbaseLockout\x12:\n" +
+	"\vmax_lockout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxLockout\x1a\xae\x02\n" +
+	"\rOAuthProvider\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x04 \x01(\tR\fclientSecret\x12!\n" +
+	"\fredirect_url\x18\x05 \x01(\tR\vredirectUrl\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12\x19\n" +
+	"\bauth_url\x18\a \x01(\tR\aauthUrl\x12\x1b\n" +
+	"\ttoken_url\x18\b \x01(\tR\btokenUrl\x12\x19\n" +
+	"\bjwks_url\x18\t \x01(\tR\ajwksUrl\x12!\n" +
+	"\fuserinfo_url\x18\n" +
+	" \x01(\tR\vuserinfoUrl\x1a\xbb\x02\n" +
+	"\tScheduler\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x12=\n" +
+	"\x05tasks\x18\x02 \x03(\v2'.kratos.api.Server.Scheduler.TasksEntryR\x05tasks\x1av\n" +
+	"\x04Task\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x12\x1a\n" +
+	"\bdisabled\x18\x02 \x01(\bR\bdisabled\x126\n" +
+	"\tlease_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bleaseTtl\x1a[\n" +
+	"\n" +
+	"TasksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
+	"\x05value\x18\x02 \x01(\v2!.kratos.api.Server.Scheduler.TaskR\x05value:\x028\x01\x1ac\n" +
+	"\x13OauthProvidersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .kratos.api.Server.OAuthProviderR\x05value:\x028\x01\"\x8d\x01\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x1aN\n" +
 	"\bDatabase\x12\x16\n" +
@@ -408,29 +1031,52 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Server_HTTP)(nil),         // 3: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 4: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 5: kratos.api.Data.Database
-	(*durationpb.Duration)(nil), // 6: google.protobuf.Duration
+	(*Bootstrap)(nil),             // 0: kratos.api.Bootstrap
+	(*Server)(nil),                // 1: kratos.api.Server
+	(*Data)(nil),                  // 2: kratos.api.Data
+	(*Server_HTTP)(nil),           // 3: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),           // 4: kratos.api.Server.GRPC
+	(*Server_Mail)(nil),           // 5: kratos.api.Server.Mail
+	(*Server_JWT)(nil),            // 6: kratos.api.Server.JWT
+	(*Server_LoginThrottle)(nil),  // 7: kratos.api.Server.LoginThrottle
+	(*Server_OAuthProvider)(nil),  // 8: kratos.api.Server.OAuthProvider
+	(*Server_Scheduler)(nil),      // 9: kratos.api.Server.Scheduler
+	nil,                           // 10: kratos.api.Server.OauthProvidersEntry
+	(*Server_JWT_Key)(nil),        // 11: kratos.api.Server.JWT.Key
+	(*Server_Scheduler_Task)(nil), // 12: kratos.api.Server.Scheduler.Task
+	nil,                           // 13: kratos.api.Server.Scheduler.TasksEntry
+	(*Data_Database)(nil),         // 14: kratos.api.Data.Database
+	(*durationpb.Duration)(nil),   // 15: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	1, // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	2, // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	3, // 2: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	4, // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	5, // 4: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	6, // 5: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	6, // 6: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	3,  // 2: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	4,  // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	5,  // 4: kratos.api.Server.mail:type_name -> kratos.api.Server.Mail
+	6,  // 5: kratos.api.Server.jwt:type_name -> kratos.api.Server.JWT
+	7,  // 6: kratos.api.Server.login_throttle:type_name -> kratos.api.Server.LoginThrottle
+	10, // 7: kratos.api.Server.oauth_providers:type_name -> kratos.api.Server.OauthProvidersEntry
+	9,  // 8: kratos.api.Server.scheduler:type_name -> kratos.api.Server.Scheduler
+	14, // 9: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	15, // 10: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	15, // 11: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	11, // 12: kratos.api.Server.JWT.signing_key:type_name -> kratos.api.Server.JWT.Key
+	11, // 13: kratos.api.Server.JWT.verification_keys:type_name -> kratos.api.Server.JWT.Key
+	15, // 14: kratos.api.Server.LoginThrottle.window:type_name -> google.protobuf.Duration
+	15, // 15: kratos.api.Server.LoginThrottle.base_lockout:type_name -> google.protobuf.Duration
+	15, // 16: kratos.api.Server.LoginThrottle.max_lockout:type_name -> google.protobuf.Duration
+	13, // 17: kratos.api.Server.Scheduler.tasks:type_name -> kratos.api.Server.Scheduler.TasksEntry
+	8,  // 18: kratos.api.Server.OauthProvidersEntry.value:type_name -> kratos.api.Server.OAuthProvider
+	15, // 19: kratos.api.Server.Scheduler.Task.lease_ttl:type_name -> google.protobuf.Duration
+	12, // 20: kratos.api.Server.Scheduler.TasksEntry.value:type_name -> kratos.api.Server.Scheduler.Task
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -444,7 +1090,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// apiKeyTouchInterval giới hạn số lần ghi last_used_at của một key
+const apiKeyTouchInterval = time.Minute
+
+// APIKey struct for MongoDB
+type APIKey struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	Name       string             `bson:"name"`
+	LookupID   string             `bson:"lookup_id"`
+	KeyHash    string             `bson:"key_hash"`
+	Scopes     []string           `bson:"scopes"`
+	ExpiresAt  *time.Time         `bson:"expires_at,omitempty"`
+	LastUsedAt *time.Time         `bson:"last_used_at,omitempty"`
+	RevokedAt  *time.Time         `bson:"revoked_at,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+}
+
+type apiKeyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAPIKeyRepo creates a new API key repository
+func NewAPIKeyRepo(data *Data, logger log.Logger) biz.APIKeyRepo {
+	r := &apiKeyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionAPIKey).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "lookup_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create api key indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateAPIKey stores a new API key
+func (r *apiKeyRepo) CreateAPIKey(ctx context.Context, key *biz.APIKey) (*biz.APIKey, error) {
+	userObjID, err := primitive.ObjectIDFromHex(key.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbKey := &APIKey{
+		UserID:    userObjID,
+		Name:      key.Name,
+		LookupID:  key.LookupID,
+		KeyHash:   key.KeyHash,
+		Scopes:    key.Scopes,
+		ExpiresAt: key.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	result, err := r.data.db.Collection(CollectionAPIKey).InsertOne(ctx, dbKey)
+	if err != nil {
+		r.log.Errorf("failed to create api key: %v", err)
+		return nil, err
+	}
+
+	dbKey.ID = result.InsertedID.(primitive.ObjectID)
+	return r.toBiz(dbKey), nil
+}
+
+// GetAPIKeyByLookupID retrieves a key (revoked or not) by its lookup id
+func (r *apiKeyRepo) GetAPIKeyByLookupID(ctx context.Context, lookupID string) (*biz.APIKey, error) {
+	var key APIKey
+	err := r.data.db.Collection(CollectionAPIKey).FindOne(ctx, bson.M{"lookup_id": lookupID}).Decode(&key)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // key not found
+		}
+		r.log.Errorf("failed to get api key: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&key), nil
+}
+
+// ListUserAPIKeys lists the unrevoked keys of a user, newest first
+func (r *apiKeyRepo) ListUserAPIKeys(ctx context.Context, userID string) ([]*biz.APIKey, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.data.db.Collection(CollectionAPIKey).Find(
+		ctx,
+		bson.M{"user_id": userObjID, "revoked_at": bson.M{"$exists": false}},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		r.log.Errorf("failed to list api keys: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var keys []APIKey
+	if err := cursor.All(ctx, &keys); err != nil {
+		r.log.Errorf("failed to decode api keys: %v", err)
+		return nil, err
+	}
+
+	result := make([]*biz.APIKey, 0, len(keys))
+	for i := range keys {
+		result = append(result, r.toBiz(&keys[i]))
+	}
+	return result, nil
+}
+
+// RevokeAPIKey marks an unrevoked key of the user as revoked
+func (r *apiKeyRepo) RevokeAPIKey(ctx context.Context, userID, keyID string) (bool, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return false, err
+	}
+	keyObjID, err := primitive.ObjectIDFromHex(keyID)
+	if err != nil {
+		return false, nil // id không hợp lệ = không tìm thấy
+	}
+
+	result, err := r.data.db.Collection(CollectionAPIKey).UpdateOne(
+		ctx,
+		bson.M{"_id": keyObjID, "user_id": userObjID, "revoked_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revoked_at": time.Now()}},
+	)
+	if err != nil {
+		r.log.Errorf("failed to revoke api key: %v", err)
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+// TouchAPIKey records the last use of a key, at most once per apiKeyTouchInterval
+func (r *apiKeyRepo) TouchAPIKey(ctx context.Context, keyID string, at time.Time) error {
+	keyObjID, err := primitive.ObjectIDFromHex(keyID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.db.Collection(CollectionAPIKey).UpdateOne(
+		ctx,
+		bson.M{
+			"_id": keyObjID,
+			"$or": bson.A{
+				bson.M{"last_used_at": bson.M{"$exists": false}},
+				bson.M{"last_used_at": bson.M{"$lt": at.Add(-apiKeyTouchInterval)}},
+			},
+		},
+		bson.M{"$set": bson.M{"last_used_at": at}},
+	)
+	return err
+}
+
+// toBiz converts data layer APIKey to biz layer APIKey
+func (r *apiKeyRepo) toBiz(k *APIKey) *biz.APIKey {
+	return &biz.APIKey{
+		ID:         k.ID.Hex(),
+		UserID:     k.UserID.Hex(),
+		Name:       k.Name,
+		LookupID:   k.LookupID,
+		KeyHash:    k.KeyHash,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
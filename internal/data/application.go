@@ -0,0 +1,355 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Application struct for MongoDB
+type Application struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	JobID         primitive.ObjectID `bson:"job_id"`
+	CompanyID     primitive.ObjectID `bson:"company_id"`
+	UserID        primitive.ObjectID `bson:"user_id"`
+	JobTitle      string             `bson:"job_title"`
+	CompanyName   string             `bson:"company_name"`
+	ResumeID      primitive.ObjectID `bson:"resume_id"`
+	ResumeVersion int32              `bson:"resume_version"`
+	Resume        ResumeDetail       `bson:"resume"`
+	CoverLetter   string             `bson:"cover_letter"`
+	Status        string             `bson:"status"`
+	Stage         string             `bson:"stage,omitempty"`
+	StageHistory  []StageChange      `bson:"stage_history,omitempty"`
+	WithdrawnAt   *time.Time         `bson:"withdrawn_at,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+// StageChange struct for MongoDB
+type StageChange struct {
+	From      string    `bson:"from,omitempty"`
+	To        string    `bson:"to"`
+	Reason    string    `bson:"reason,omitempty"`
+	ActorID   string    `bson:"actor_id"`
+	ChangedAt time.Time `bson:"changed_at"`
+}
+
+type applicationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewApplicationRepo creates a new application repository
+func NewApplicationRepo(data *Data, logger log.Logger) biz.ApplicationRepo {
+	r := &applicationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionApplication).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// Một user chỉ ứng tuyển một lần vào mỗi job
+		{Keys: bson.D{{Key: "job_id", Value: 1}, {Key: "user_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "job_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		// Đếm đơn theo stage của công ty khi sửa pipeline
+		{Keys: bson.D{{Key: "company_id", Value: 1}, {Key: "status", Value: 1}, {Key: "stage", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create application indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateApplication stores a new application
+func (r *applicationRepo) CreateApplication(ctx context.Context, app *biz.Application) (*biz.Application, error) {
+	jobObjID, err := primitive.ObjectIDFromHex(app.JobID)
+	if err != nil {
+		return nil, err
+	}
+	companyObjID, err := primitive.ObjectIDFromHex(app.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	userObjID, err := primitive.ObjectIDFromHex(app.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resumeObjID, err := primitive.ObjectIDFromHex(app.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dbApp := &Application{
+		JobID:         jobObjID,
+		CompanyID:     companyObjID,
+		UserID:        userObjID,
+		JobTitle:      app.JobTitle,
+		CompanyName:   app.CompanyName,
+		ResumeID:      resumeObjID,
+		ResumeVersion: app.ResumeVersion,
+		CoverLetter:   app.CoverLetter,
+		Status:        string(app.Status),
+		Stage:         app.Stage,
+		StageHistory:  toStageChangeDocs(app.StageHistory),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if app.Resume != nil {
+		resumes := &resumeRepo{data: r.data, log: r.log}
+		dbApp.Resume = ResumeDetail{
+			Name:           app.Resume.Name,
+			Email:          app.Resume.Email,
+			Phone:          app.Resume.Phone,
+			Summary:        app.Resume.Summary,
+			Skills:         app.Resume.Skills,
+			Education:      resumes.toEducationDoc(app.Resume.Education),
+			Experience:     resumes.toExperienceDoc(app.Resume.Experience),
+			Certifications: app.Resume.Certifications,
+			Languages:      app.Resume.Languages,
+		}
+	}
+
+	result, err := r.data.db.Collection(CollectionApplication).InsertOne(ctx, dbApp)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, biz.ErrApplicationAlreadyExists
+		}
+		r.log.Errorf("failed to create application: %v", err)
+		return nil, err
+	}
+
+	dbApp.ID = result.InsertedID.(primitive.ObjectID)
+	return r.toBiz(dbApp), nil
+}
+
+// GetApplication retrieves an application by ID
+func (r *applicationRepo) GetApplication(ctx context.Context, id string) (*biz.Application, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil // Invalid ID, no such application
+	}
+
+	var app Application
+	err = r.data.db.Collection(CollectionApplication).FindOne(ctx, bson.M{"_id": objID}).Decode(&app)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Application not found
+		}
+		r.log.Errorf("failed to get application: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&app), nil
+}
+
+// ListApplications lists applications with filters and pagination, newest first
+func (r *applicationRepo) ListApplications(ctx context.Context, filter *biz.ApplicationFilter, page, pageSize int32) ([]*biz.Application, int32, error) {
+	query, ok := r.filterQuery(filter)
+	if !ok {
+		return nil, 0, nil
+	}
+
+	coll := r.data.db.Collection(CollectionApplication)
+	total, err := coll.CountDocuments(ctx, query)
+	if err != nil {
+		r.log.Errorf("failed to count applications: %v", err)
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+	cursor, err := coll.Find(ctx, query, opts)
+	if err != nil {
+		r.log.Errorf("failed to list applications: %v", err)
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var dbApps []Application
+	if err := cursor.All(ctx, &dbApps); err != nil {
+		return nil, 0, err
+	}
+
+	apps := make([]*biz.Application, 0, len(dbApps))
+	for i := range dbApps {
+		apps = append(apps, r.toBiz(&dbApps[i]))
+	}
+	return apps, int32(total), nil
+}
+
+// WithdrawApplication marks a submitted application as withdrawn
+func (r *applicationRepo) WithdrawApplication(ctx context.Context, id string, at time.Time) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.data.db.Collection(CollectionApplication).UpdateOne(ctx,
+		bson.M{"_id": objID, "status": string(biz.ApplicationStatusSubmitted)},
+		bson.M{"$set": bson.M{
+			"status":       string(biz.ApplicationStatusWithdrawn),
+			"withdrawn_at": at,
+			"updated_at":   at,
+		}},
+	)
+	if err != nil {
+		r.log.Errorf("failed to withdraw application: %v", err)
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// MoveApplicationStage moves a submitted application from stage from, pushing change to its history
+func (r *applicationRepo) MoveApplicationStage(ctx context.Context, id, from string, change *biz.StageChange) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, err
+	}
+
+	filter := bson.M{"_id": objID, "status": string(biz.ApplicationStatusSubmitted), "stage": from}
+	if from == "" {
+		// Đơn cũ chưa có stage
+		filter["stage"] = bson.M{"$in": bson.A{nil, ""}}
+	}
+	result, err := r.data.db.Collection(CollectionApplication).UpdateOne(ctx, filter, bson.M{
+		"$set":  bson.M{"stage": change.To, "updated_at": change.ChangedAt},
+		"$push": bson.M{"stage_history": toStageChangeDocs([]*biz.StageChange{change})[0]},
+	})
+	if err != nil {
+		r.log.Errorf("failed to move application stage: %v", err)
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// CountApplicationsByStage counts matching applications grouped by stage
+func (r *applicationRepo) CountApplicationsByStage(ctx context.Context, filter *biz.ApplicationFilter) (map[string]int32, error) {
+	counts := map[string]int32{}
+	query, ok := r.filterQuery(filter)
+	if !ok {
+		return counts, nil
+	}
+
+	cursor, err := r.data.db.Collection(CollectionApplication).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: query}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"$ifNull": bson.A{"$stage", ""}},
+			"count": bson.M{"$sum": 1},
+		}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to count applications by stage: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Stage string `bson:"_id"`
+		Count int32  `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	for _, res := range results {
+		counts[res.Stage] += res.Count
+	}
+	return counts, nil
+}
+
+// filterQuery builds the query of an application filter, ok = false nếu ID trong filter không hợp lệ
+func (r *applicationRepo) filterQuery(filter *biz.ApplicationFilter) (bson.M, bool) {
+	query := bson.M{}
+	for field, id := range map[string]string{
+		"job_id":     filter.JobID,
+		"company_id": filter.CompanyID,
+		"user_id":    filter.UserID,
+	} {
+		if id == "" {
+			continue
+		}
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, false
+		}
+		query[field] = objID
+	}
+	if filter.Status != "" {
+		query["status"] = string(filter.Status)
+	}
+	if filter.Stage != "" {
+		query["stage"] = filter.Stage
+	}
+	return query, true
+}
+
+func toStageChangeDocs(changes []*biz.StageChange) []StageChange {
+	docs := make([]StageChange, 0, len(changes))
+	for _, c := range changes {
+		docs = append(docs, StageChange{
+			From:      c.From,
+			To:        c.To,
+			Reason:    c.Reason,
+			ActorID:   c.ActorID,
+			ChangedAt: c.ChangedAt,
+		})
+	}
+	return docs
+}
+
+// toBiz converts data layer Application to biz layer Application
+func (r *applicationRepo) toBiz(a *Application) *biz.Application {
+	resumes := &resumeRepo{data: r.data, log: r.log}
+	history := make([]*biz.StageChange, 0, len(a.StageHistory))
+	for _, c := range a.StageHistory {
+		history = append(history, &biz.StageChange{
+			From:      c.From,
+			To:        c.To,
+			Reason:    c.Reason,
+			ActorID:   c.ActorID,
+			ChangedAt: c.ChangedAt,
+		})
+	}
+	return &biz.Application{
+		ID:            a.ID.Hex(),
+		JobID:         a.JobID.Hex(),
+		CompanyID:     a.CompanyID.Hex(),
+		UserID:        a.UserID.Hex(),
+		JobTitle:      a.JobTitle,
+		CompanyName:   a.CompanyName,
+		ResumeID:      a.ResumeID.Hex(),
+		ResumeVersion: a.ResumeVersion,
+		Resume: &biz.ResumeDetail{
+			Name:           a.Resume.Name,
+			Email:          a.Resume.Email,
+			Phone:          a.Resume.Phone,
+			Summary:        a.Resume.Summary,
+			Skills:         a.Resume.Skills,
+			Education:      resumes.toEducationBiz(&a.Resume.Education),
+			Experience:     resumes.toExperienceBiz(&a.Resume.Experience),
+			Certifications: a.Resume.Certifications,
+			Languages:      a.Resume.Languages,
+		},
+		CoverLetter:  a.CoverLetter,
+		Status:       biz.ApplicationStatus(a.Status),
+		Stage:        a.Stage,
+		StageHistory: history,
+		WithdrawnAt:  a.WithdrawnAt,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+}
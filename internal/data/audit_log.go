@@ -0,0 +1,177 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuditLog struct for MongoDB, documents are only ever inserted
+type AuditLog struct {
+	ID             primitive.ObjectID     `bson:"_id,omitempty"`
+	ActorID        string                 `bson:"actor_id"`
+	ImpersonatorID string                 `bson:"impersonator_id,omitempty"`
+	Action         string                 `bson:"action"`
+	TargetType     string                 `bson:"target_type"`
+	TargetID       string                 `bson:"target_id"`
+	Before         map[string]interface{} `bson:"before,omitempty"`
+	After          map[string]interface{} `bson:"after,omitempty"`
+	Reason         string                 `bson:"reason,omitempty"`
+	IPAddress      string                 `bson:"ip_address,omitempty"`
+	RequestID      string                 `bson:"request_id,omitempty"`
+	CreatedAt      time.Time              `bson:"created_at"`
+}
+
+type auditRecorder struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAuditRecorder creates a new audit log recorder
+func NewAuditRecorder(data *Data, logger log.Logger) biz.AuditRecorder {
+	r := &auditRecorder{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionAuditLog).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "actor_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "target_type", Value: 1}, {Key: "target_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "action", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "created_at", Value: -1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create audit log indexes: %v", err)
+	}
+
+	return r
+}
+
+// Record appends an entry to the audit log
+func (r *auditRecorder) Record(ctx context.Context, entry *biz.AuditEntry) error {
+	createdAt := entry.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	dbEntry := &AuditLog{
+		ActorID:        entry.ActorID,
+		ImpersonatorID: entry.ImpersonatorID,
+		Action:         entry.Action,
+		TargetType:     entry.TargetType,
+		TargetID:       entry.TargetID,
+		Before:         entry.Before,
+		After:          entry.After,
+		Reason:         entry.Reason,
+		IPAddress:      entry.IPAddress,
+		RequestID:      entry.RequestID,
+		CreatedAt:      createdAt,
+	}
+
+	if _, err := r.data.db.Collection(CollectionAuditLog).InsertOne(ctx, dbEntry); err != nil {
+		r.log.Errorf("failed to write audit log: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+type auditLogRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAuditLogRepo creates a read only audit log repository (indexes are created by NewAuditRecorder)
+func NewAuditLogRepo(data *Data, logger log.Logger) biz.AuditLogRepo {
+	return &auditLogRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListAuditEntries lists audit entries matching filter, newest first
+func (r *auditLogRepo) ListAuditEntries(ctx context.Context, filter *biz.AuditFilter, page, pageSize int32) ([]*biz.AuditEntry, int32, error) {
+	query := bson.M{}
+	if filter != nil {
+		if filter.ActorID != "" {
+			query["actor_id"] = filter.ActorID
+		}
+		if filter.Action != "" {
+			query["action"] = filter.Action
+		}
+		if filter.TargetType != "" {
+			query["target_type"] = filter.TargetType
+		}
+		if filter.TargetID != "" {
+			query["target_id"] = filter.TargetID
+		}
+		createdAt := bson.M{}
+		if filter.From != nil {
+			createdAt["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			createdAt["$lt"] = *filter.To
+		}
+		if len(createdAt) > 0 {
+			query["created_at"] = createdAt
+		}
+	}
+
+	// Count total
+	total, err := r.data.db.Collection(CollectionAuditLog).CountDocuments(ctx, query)
+	if err != nil {
+		r.log.Errorf("failed to count audit log: %v", err)
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	// Decode before/after lồng nhau thành map thay vì bson.D
+	coll := r.data.db.Collection(CollectionAuditLog, options.Collection().SetBSONOptions(&options.BSONOptions{DefaultDocumentM: true}))
+	cursor, err := coll.Find(ctx, query, opts)
+	if err != nil {
+		r.log.Errorf("failed to list audit log: %v", err)
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []*biz.AuditEntry
+	for cursor.Next(ctx) {
+		var entry AuditLog
+		if err := cursor.Decode(&entry); err != nil {
+			continue
+		}
+		entries = append(entries, r.toBiz(&entry))
+	}
+
+	return entries, int32(total), nil
+}
+
+// toBiz converts data layer AuditLog to biz layer AuditEntry
+func (r *auditLogRepo) toBiz(entry *AuditLog) *biz.AuditEntry {
+	return &biz.AuditEntry{
+		ID:             entry.ID.Hex(),
+		ActorID:        entry.ActorID,
+		ImpersonatorID: entry.ImpersonatorID,
+		Action:         entry.Action,
+		TargetType:     entry.TargetType,
+		TargetID:       entry.TargetID,
+		Before:         entry.Before,
+		After:          entry.After,
+		Reason:         entry.Reason,
+		IPAddress:      entry.IPAddress,
+		RequestID:      entry.RequestID,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
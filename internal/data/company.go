@@ -2,7 +2,9 @@ package data
 
 import (
 	"JobblyBE/internal/biz"
+	"JobblyBE/pkg/textnorm"
 	"context"
+	"regexp"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -25,6 +27,9 @@ type Company struct {
 	FoundedYear string             `bson:"founded_year"`
 	CreatedAt   time.Time          `bson:"created_at"`
 	UpdatedAt   time.Time          `bson:"updated_at"`
+	// Bản chuẩn hóa (textnorm) để tìm không dấu, ghi cùng name/location
+	NameNorm     string `bson:"name_norm"`
+	LocationNorm string `bson:"location_norm"`
 }
 
 type companyRepo struct {
@@ -44,16 +49,18 @@ func NewCompanyRepo(data *Data, logger log.Logger) biz.CompanyRepo {
 func (r *companyRepo) CreateCompany(ctx context.Context, company *biz.Company) (*biz.Company, error) {
 	now := time.Now()
 	dbCompany := &Company{
-		Name:        company.Name,
-		Description: company.Description,
-		Website:     company.Website,
-		LogoURL:     company.LogoURL,
-		Industry:    company.Industry,
-		CompanySize: company.CompanySize,
-		Location:    company.Location,
-		FoundedYear: company.FoundedYear,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		Name:         company.Name,
+		Description:  company.Description,
+		Website:      company.Website,
+		LogoURL:      company.LogoURL,
+		Industry:     company.Industry,
+		CompanySize:  company.CompanySize,
+		Location:     company.Location,
+		FoundedYear:  company.FoundedYear,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		NameNorm:     textnorm.Normalize(company.Name),
+		LocationNorm: textnorm.Normalize(company.Location),
 	}
 
 	result, err := r.data.db.Collection(CollectionCompany).InsertOne(ctx, dbCompany)
@@ -75,15 +82,17 @@ func (r *companyRepo) UpdateCompany(ctx context.Context, company *biz.Company) e
 
 	update := bson.M{
 		"$set": bson.M{
-			"name":         company.Name,
-			"description":  company.Description,
-			"website":      company.Website,
-			"logo_url":     company.LogoURL,
-			"industry":     company.Industry,
-			"company_size": company.CompanySize,
-			"location":     company.Location,
-			"founded_year": company.FoundedYear,
-			"updated_at":   time.Now(),
+			"name":          company.Name,
+			"description":   company.Description,
+			"website":       company.Website,
+			"logo_url":      company.LogoURL,
+			"industry":      company.Industry,
+			"company_size":  company.CompanySize,
+			"location":      company.Location,
+			"founded_year":  company.FoundedYear,
+			"updated_at":    time.Now(),
+			"name_norm":     textnorm.Normalize(company.Name),
+			"location_norm": textnorm.Normalize(company.Location),
 		},
 	}
 
@@ -159,15 +168,16 @@ func (r *companyRepo) ListCompanies(ctx context.Context, filter *biz.CompanyFilt
 	if filter != nil {
 		if filter.Industry != "" {
 			// Case-insensitive match for industry
-			query["industry"] = bson.M{"$regex": filter.Industry, "$options": "i"}
+			query["industry"] = bson.M{"$regex": regexp.QuoteMeta(filter.Industry), "$options": "i"}
 		}
 		if filter.Location != "" {
-			query["location"] = bson.M{"$regex": filter.Location, "$options": "i"}
+			query["location_norm"] = bson.M{"$regex": regexp.QuoteMeta(textnorm.Normalize(filter.Location))}
 		}
 		if filter.Keyword != "" {
+			keyword := regexp.QuoteMeta(filter.Keyword)
 			query["$or"] = []bson.M{
-				{"name": bson.M{"$regex": filter.Keyword, "$options": "i"}},
-				{"description": bson.M{"$regex": filter.Keyword, "$options": "i"}},
+				{"name_norm": bson.M{"$regex": regexp.QuoteMeta(textnorm.Normalize(filter.Keyword))}},
+				{"description": bson.M{"$regex": keyword, "$options": "i"}},
 			}
 		}
 	}
@@ -0,0 +1,229 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CompanyMember struct for MongoDB
+type CompanyMember struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	CompanyID  primitive.ObjectID `bson:"company_id"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	Email      string             `bson:"email"`
+	Role       string             `bson:"role"`
+	Status     string             `bson:"status"`
+	InvitedBy  string             `bson:"invited_by"`
+	AcceptedAt *time.Time         `bson:"accepted_at,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at"`
+}
+
+type companyMemberRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCompanyMemberRepo creates a new company member repository
+func NewCompanyMemberRepo(data *Data, logger log.Logger) biz.CompanyMemberRepo {
+	r := &companyMemberRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionCompanyMember).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// Một user chỉ có một membership trong mỗi công ty
+		{Keys: bson.D{{Key: "company_id", Value: 1}, {Key: "user_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create company member indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateMember creates a new company member
+func (r *companyMemberRepo) CreateMember(ctx context.Context, member *biz.CompanyMember) (*biz.CompanyMember, error) {
+	companyObjID, err := primitive.ObjectIDFromHex(member.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	userObjID, err := primitive.ObjectIDFromHex(member.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dbMember := &CompanyMember{
+		CompanyID: companyObjID,
+		UserID:    userObjID,
+		Email:     member.Email,
+		Role:      string(member.Role),
+		Status:    string(member.Status),
+		InvitedBy: member.InvitedBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if member.Status == biz.MemberStatusActive {
+		dbMember.AcceptedAt = &now
+	}
+
+	result, err := r.data.db.Collection(CollectionCompanyMember).InsertOne(ctx, dbMember)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, biz.ErrCompanyMemberAlreadyExists
+		}
+		r.log.Errorf("failed to create company member: %v", err)
+		return nil, err
+	}
+
+	dbMember.ID = result.InsertedID.(primitive.ObjectID)
+	return r.toBiz(dbMember), nil
+}
+
+// GetMember retrieves the membership of a user in a company
+func (r *companyMemberRepo) GetMember(ctx context.Context, companyID, userID string) (*biz.CompanyMember, error) {
+	companyObjID, err := primitive.ObjectIDFromHex(companyID)
+	if err != nil {
+		return nil, nil // Invalid ID, no such member
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, nil // Invalid ID, no such member
+	}
+
+	var member CompanyMember
+	err = r.data.db.Collection(CollectionCompanyMember).FindOne(ctx, bson.M{
+		"company_id": companyObjID,
+		"user_id":    userObjID,
+	}).Decode(&member)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Member not found
+		}
+		r.log.Errorf("failed to get company member: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&member), nil
+}
+
+// ListMembers lists all members and pending invitations of a company
+func (r *companyMemberRepo) ListMembers(ctx context.Context, companyID string) ([]*biz.CompanyMember, error) {
+	companyObjID, err := primitive.ObjectIDFromHex(companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.data.db.Collection(CollectionCompanyMember).Find(ctx, bson.M{"company_id": companyObjID}, opts)
+	if err != nil {
+		r.log.Errorf("failed to list company members: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var members []*biz.CompanyMember
+	for cursor.Next(ctx) {
+		var member CompanyMember
+		if err := cursor.Decode(&member); err != nil {
+			continue
+		}
+		members = append(members, r.toBiz(&member))
+	}
+
+	return members, nil
+}
+
+// ActivateMember marks a pending member as active
+func (r *companyMemberRepo) ActivateMember(ctx context.Context, id string) (*biz.CompanyMember, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var member CompanyMember
+	err = r.data.db.Collection(CollectionCompanyMember).FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": objID, "status": string(biz.MemberStatusPending)},
+		bson.M{
+			"$set": bson.M{
+				"status":      string(biz.MemberStatusActive),
+				"accepted_at": now,
+				"updated_at":  now,
+			},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&member)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // No pending invitation
+		}
+		r.log.Errorf("failed to activate company member: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&member), nil
+}
+
+// DeleteMember deletes a company member
+func (r *companyMemberRepo) DeleteMember(ctx context.Context, id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.data.db.Collection(CollectionCompanyMember).DeleteOne(ctx, bson.M{"_id": objID}); err != nil {
+		r.log.Errorf("failed to delete company member: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// CountActiveOwners counts the active owners of a company
+func (r *companyMemberRepo) CountActiveOwners(ctx context.Context, companyID string) (int64, error) {
+	companyObjID, err := primitive.ObjectIDFromHex(companyID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.data.db.Collection(CollectionCompanyMember).CountDocuments(ctx, bson.M{
+		"company_id": companyObjID,
+		"role":       string(biz.MemberRoleOwner),
+		"status":     string(biz.MemberStatusActive),
+	})
+	if err != nil {
+		r.log.Errorf("failed to count company owners: %v", err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// toBiz converts data layer CompanyMember to biz layer CompanyMember
+func (r *companyMemberRepo) toBiz(m *CompanyMember) *biz.CompanyMember {
+	return &biz.CompanyMember{
+		ID:         m.ID.Hex(),
+		CompanyID:  m.CompanyID.Hex(),
+		UserID:     m.UserID.Hex(),
+		Email:      m.Email,
+		Role:       biz.MemberRole(m.Role),
+		Status:     biz.MemberStatus(m.Status),
+		InvitedBy:  m.InvitedBy,
+		AcceptedAt: m.AcceptedAt,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
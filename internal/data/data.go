@@ -20,6 +20,28 @@ var ProviderSet = wire.NewSet(
 	NewCompanyRepo,
 	NewUserTrackingRepo,
 	NewResumeRepo,
+	NewTokenRevocationRepo,
+	NewSessionRepo,
+	NewSecurityEventRepo,
+	NewMailer,
+	NewPasswordResetRepo,
+	NewTOTPRepo,
+	NewCompanyMemberRepo,
+	NewTokenKeySet,
+	NewLoginAttemptRepo,
+	NewOAuthStateRepo,
+	NewUserIdentityRepo,
+	NewAPIKeyRepo,
+	NewAuditRecorder,
+	NewAuditLogRepo,
+	NewPersonalDataStores,
+	NewSchedulerLeaseStore,
+	NewApplicationRepo,
+	NewPipelineRepo,
+	NewSavedJobRepo,
+	NewSavedSearchRepo,
+	NewAlertMatchRepo,
+	NewNotificationRepo,
 )
 
 // Data .
@@ -29,10 +51,29 @@ type Data struct {
 }
 
 const (
-	CollectionUser         = "user"
-	CollectionCompany      = "company"
-	CollectionJobPosting   = "job_posting"
-	CollectionUserTracking = "user_tracking"
+	CollectionUser               = "user"
+	CollectionCompany            = "company"
+	CollectionJobPosting         = "job_posting"
+	CollectionUserTracking       = "user_tracking"
+	CollectionRevokedToken       = "revoked_token"
+	CollectionSession            = "session"
+	CollectionSecurityEvent      = "security_event"
+	CollectionPasswordResetToken = "password_reset_token"
+	CollectionUserTOTP           = "user_totp"
+	CollectionCompanyMember      = "company_member"
+	CollectionLoginAttempt       = "login_attempt"
+	CollectionOAuthState         = "oauth_state"
+	CollectionUserIdentity       = "user_identity"
+	CollectionAPIKey             = "api_key"
+	CollectionAuditLog           = "audit_log"
+	CollectionSchedulerLease     = "scheduler_lease"
+	CollectionApplication        = "application"
+	CollectionHiringPipeline     = "hiring_pipeline"
+	CollectionSavedJob           = "saved_job"
+	CollectionSavedSearch        = "saved_search"
+	CollectionJobAlertMatch      = "job_alert_match"
+	CollectionJobAlertState      = "job_alert_state"
+	CollectionNotification       = "notification"
 )
 
 // NewData .
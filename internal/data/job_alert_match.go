@@ -0,0 +1,270 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// alertWatermarkID là _id của document lưu watermark trong job_alert_state
+const alertWatermarkID = "matcher"
+
+// JobAlertMatch struct for MongoDB
+type JobAlertMatch struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	SearchID   primitive.ObjectID `bson:"search_id"`
+	UserID     primitive.ObjectID `bson:"user_id"`
+	JobID      primitive.ObjectID `bson:"job_id"`
+	NotifiedAt *time.Time         `bson:"notified_at"`
+	CreatedAt  time.Time          `bson:"created_at"`
+}
+
+type alertMatchRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAlertMatchRepo creates a new job alert match repository
+func NewAlertMatchRepo(data *Data, logger log.Logger) biz.AlertMatchRepo {
+	r := &alertMatchRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionJobAlertMatch).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// Mỗi job chỉ khớp một lần với mỗi saved search
+		{Keys: bson.D{{Key: "search_id", Value: 1}, {Key: "job_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "notified_at", Value: 1}, {Key: "search_id", Value: 1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create job alert match indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateAlertMatches inserts the matches that do not exist yet
+func (r *alertMatchRepo) CreateAlertMatches(ctx context.Context, matches []*biz.AlertMatch) (int, error) {
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(matches))
+	for _, m := range matches {
+		searchObjID, err := primitive.ObjectIDFromHex(m.SearchID)
+		if err != nil {
+			return 0, err
+		}
+		userObjID, err := primitive.ObjectIDFromHex(m.UserID)
+		if err != nil {
+			return 0, err
+		}
+		jobObjID, err := primitive.ObjectIDFromHex(m.JobID)
+		if err != nil {
+			return 0, err
+		}
+
+		// Upsert với $setOnInsert để match đã có (kể cả đã gửi) không bị tạo lại
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"search_id": searchObjID, "job_id": jobObjID}).
+			SetUpdate(bson.M{"$setOnInsert": bson.M{
+				"user_id":     userObjID,
+				"notified_at": nil,
+				"created_at":  now,
+			}}).
+			SetUpsert(true))
+	}
+	if len(models) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.data.db.Collection(CollectionJobAlertMatch).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		r.log.Errorf("failed to create job alert matches: %v", err)
+		return 0, err
+	}
+	if result == nil {
+		return 0, nil
+	}
+	return int(result.UpsertedCount), nil
+}
+
+// PendingAlertSearchIDs returns the saved searches with frequency having undelivered matches.
+// Saved search đã bị xóa (lookup rỗng) cũng được trả về để dọn match.
+func (r *alertMatchRepo) PendingAlertSearchIDs(ctx context.Context, frequency biz.AlertFrequency) ([]string, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"notified_at": nil}}},
+		{{Key: "$group", Value: bson.M{"_id": "$search_id"}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         CollectionSavedSearch,
+			"localField":   "_id",
+			"foreignField": "_id",
+			"as":           "search",
+		}}},
+		{{Key: "$match", Value: bson.M{"$or": bson.A{
+			bson.M{"search": bson.M{"$size": 0}},
+			bson.M{"search.frequency": string(frequency)},
+		}}}},
+		{{Key: "$project", Value: bson.M{"_id": 1}}},
+	}
+
+	cursor, err := r.data.db.Collection(CollectionJobAlertMatch).Aggregate(ctx, pipeline)
+	if err != nil {
+		r.log.Errorf("failed to list pending job alerts: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(results))
+	for _, res := range results {
+		ids = append(ids, res.ID.Hex())
+	}
+	return ids, nil
+}
+
+// ListPendingAlertMatches lists the undelivered matches of a saved search, oldest first
+func (r *alertMatchRepo) ListPendingAlertMatches(ctx context.Context, searchID string) ([]*biz.AlertMatch, error) {
+	searchObjID, err := primitive.ObjectIDFromHex(searchID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.data.db.Collection(CollectionJobAlertMatch).Find(ctx,
+		bson.M{"search_id": searchObjID, "notified_at": nil}, opts)
+	if err != nil {
+		r.log.Errorf("failed to list job alert matches: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var dbMatches []JobAlertMatch
+	if err := cursor.All(ctx, &dbMatches); err != nil {
+		return nil, err
+	}
+
+	matches := make([]*biz.AlertMatch, 0, len(dbMatches))
+	for i := range dbMatches {
+		matches = append(matches, r.toBiz(&dbMatches[i]))
+	}
+	return matches, nil
+}
+
+// MarkAlertMatchesNotified marks matches as delivered
+func (r *alertMatchRepo) MarkAlertMatchesNotified(ctx context.Context, ids []string, at time.Time) error {
+	objIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return err
+		}
+		objIDs = append(objIDs, objID)
+	}
+
+	_, err := r.data.db.Collection(CollectionJobAlertMatch).UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": objIDs}},
+		bson.M{"$set": bson.M{"notified_at": at}},
+	)
+	if err != nil {
+		r.log.Errorf("failed to mark job alert matches notified: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeletePendingAlertMatches deletes the undelivered matches of a saved search
+func (r *alertMatchRepo) DeletePendingAlertMatches(ctx context.Context, searchID string) error {
+	return r.deleteMatches(ctx, searchID, true)
+}
+
+// DeleteAlertMatches deletes every match of a saved search
+func (r *alertMatchRepo) DeleteAlertMatches(ctx context.Context, searchID string) error {
+	return r.deleteMatches(ctx, searchID, false)
+}
+
+func (r *alertMatchRepo) deleteMatches(ctx context.Context, searchID string, pendingOnly bool) error {
+	searchObjID, err := primitive.ObjectIDFromHex(searchID)
+	if err != nil {
+		return err
+	}
+
+	query := bson.M{"search_id": searchObjID}
+	if pendingOnly {
+		query["notified_at"] = nil
+	}
+	if _, err := r.data.db.Collection(CollectionJobAlertMatch).DeleteMany(ctx, query); err != nil {
+		r.log.Errorf("failed to delete job alert matches: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetAlertWatermark reads the (posted_at, job) position the matcher continues from
+func (r *alertMatchRepo) GetAlertWatermark(ctx context.Context) (*biz.AlertWatermark, error) {
+	var state struct {
+		Watermark time.Time           `bson:"watermark"`
+		JobID     *primitive.ObjectID `bson:"job_id"`
+	}
+	err := r.data.db.Collection(CollectionJobAlertState).FindOne(ctx, bson.M{"_id": alertWatermarkID}).Decode(&state)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Matcher chưa chạy lần nào
+		}
+		r.log.Errorf("failed to get job alert watermark: %v", err)
+		return nil, err
+	}
+
+	watermark := &biz.AlertWatermark{PostedAt: state.Watermark}
+	if state.JobID != nil {
+		watermark.JobID = state.JobID.Hex()
+	}
+	return watermark, nil
+}
+
+// SetAlertWatermark stores the (posted_at, job) position the matcher continues from
+func (r *alertMatchRepo) SetAlertWatermark(ctx context.Context, w biz.AlertWatermark) error {
+	var jobID *primitive.ObjectID
+	if w.JobID != "" {
+		objID, err := primitive.ObjectIDFromHex(w.JobID)
+		if err != nil {
+			return err
+		}
+		jobID = &objID
+	}
+
+	_, err := r.data.db.Collection(CollectionJobAlertState).UpdateOne(ctx,
+		bson.M{"_id": alertWatermarkID},
+		bson.M{"$set": bson.M{"watermark": w.PostedAt, "job_id": jobID}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		r.log.Errorf("failed to set job alert watermark: %v", err)
+		return err
+	}
+	return nil
+}
+
+// toBiz converts data layer JobAlertMatch to biz layer AlertMatch
+func (r *alertMatchRepo) toBiz(m *JobAlertMatch) *biz.AlertMatch {
+	return &biz.AlertMatch{
+		ID:         m.ID.Hex(),
+		SearchID:   m.SearchID.Hex(),
+		UserID:     m.UserID.Hex(),
+		JobID:      m.JobID.Hex(),
+		NotifiedAt: m.NotifiedAt,
+		CreatedAt:  m.CreatedAt,
+	}
+}
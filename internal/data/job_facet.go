@@ -0,0 +1,202 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// salaryBucketFilterKey là key của filter salary_bucket trong jobFilterQuery
+const salaryBucketFilterKey = "$expr"
+
+// facetCount is a group of a facet
+type facetCount struct {
+	ID    interface{} `bson:"_id"`
+	Label string      `bson:"label"`
+	Count int32       `bson:"count"`
+}
+
+// JobFacets counts the jobs matching filter by facet with a single $facet aggregation
+func (r *jobPostingRepo) JobFacets(ctx context.Context, filter *biz.JobFilter) (*biz.JobFacets, error) {
+	now := time.Now()
+	query := jobFilterQuery(filter, now)
+
+	// $text chỉ dùng được trong $match đầu tiên, các filter khác áp dụng trong từng facet
+	match := bson.M{}
+	if text, ok := query["$text"]; ok {
+		match["$text"] = text
+		delete(query, "$text")
+	}
+
+	// without returns query without the filter of a facet
+	without := func(key string) bson.M {
+		q := bson.M{}
+		for k, v := range query {
+			if k != key {
+				q[k] = v
+			}
+		}
+		return q
+	}
+
+	// Facet company bỏ filter company_id nên chỉ đếm job công khai,
+	// thành viên công ty không thấy số job chưa publish của công ty khác
+	companyQuery := without("company_id")
+	companyQuery["$and"] = []bson.M{jobStatusQuery(biz.JobStatusPublished, now)}
+
+	byCount := bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$facet", Value: bson.M{
+			"level":    countByPipeline(without("level"), bson.M{"$toUpper": "$level"}, byCount),
+			"job_type": countByPipeline(without("job_type"), bson.M{"$toUpper": "$job_type"}, byCount),
+			"location": mongo.Pipeline{
+				{{Key: "$match", Value: without("location_norm")}},
+				// Gộp theo bản chuẩn hóa, hiển thị một cách viết gốc
+				{{Key: "$group", Value: bson.M{"_id": "$location_norm", "label": bson.M{"$first": "$location"}, "count": bson.M{"$sum": 1}}}},
+				{{Key: "$sort", Value: byCount}},
+				{{Key: "$limit", Value: biz.JobFacetLimit}},
+			},
+			"job_tech": mongo.Pipeline{
+				{{Key: "$match", Value: without("job_tech")}},
+				{{Key: "$unwind", Value: "$job_tech"}},
+				// Mỗi job chỉ đếm một lần cho mỗi tech
+				{{Key: "$group", Value: bson.M{
+					"_id":   bson.M{"job": "$_id", "tech": bson.M{"$toLower": "$job_tech"}},
+					"label": bson.M{"$first": "$job_tech"},
+				}}},
+				{{Key: "$group", Value: bson.M{"_id": "$_id.tech", "label": bson.M{"$first": "$label"}, "count": bson.M{"$sum": 1}}}},
+				{{Key: "$sort", Value: byCount}},
+				{{Key: "$limit", Value: biz.JobFacetLimit}},
+			},
+			"company": mongo.Pipeline{
+				{{Key: "$match", Value: companyQuery}},
+				{{Key: "$group", Value: bson.M{"_id": "$company_id", "count": bson.M{"$sum": 1}}}},
+				{{Key: "$sort", Value: byCount}},
+				{{Key: "$limit", Value: biz.JobFacetLimit}},
+				{{Key: "$lookup", Value: bson.M{
+					"from":         CollectionCompany,
+					"localField":   "_id",
+					"foreignField": "_id",
+					"as":           "company",
+				}}},
+				{{Key: "$addFields", Value: bson.M{"label": bson.M{"$arrayElemAt": bson.A{"$company.name", 0}}}}},
+			},
+			"salary_bucket": countByPipeline(without(salaryBucketFilterKey), salaryBucketExpr(), byCount),
+		}}},
+	}
+
+	cursor, err := r.data.db.Collection(CollectionJobPosting).Aggregate(ctx, pipeline)
+	if err != nil {
+		r.log.Errorf("failed to count job facets: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result struct {
+		Level        []facetCount `bson:"level"`
+		JobType      []facetCount `bson:"job_type"`
+		Location     []facetCount `bson:"location"`
+		JobTech      []facetCount `bson:"job_tech"`
+		Company      []facetCount `bson:"company"`
+		SalaryBucket []facetCount `bson:"salary_bucket"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+	}
+
+	return &biz.JobFacets{
+		Level:        toFacetCounts(result.Level, false),
+		JobType:      toFacetCounts(result.JobType, false),
+		Location:     toFacetCounts(result.Location, true),
+		JobTech:      toFacetCounts(result.JobTech, true),
+		Company:      toFacetCounts(result.Company, false),
+		SalaryBucket: toSalaryFacetCounts(result.SalaryBucket),
+	}, nil
+}
+
+// countByPipeline counts the jobs matching query by key
+func countByPipeline(query bson.M, key interface{}, sort bson.D) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$match", Value: query}},
+		{{Key: "$group", Value: bson.M{"_id": key, "count": bson.M{"$sum": 1}}}},
+		{{Key: "$sort", Value: sort}},
+	}
+}
+
+// salaryBucketExpr returns the key of the salary bucket of a job (biz.SalaryBuckets)
+func salaryBucketExpr() bson.M {
+	amount := bson.M{"$cond": bson.A{bson.M{"$gt": bson.A{"$salary_max", 0}}, "$salary_max", "$salary_min"}}
+	branches := bson.A{
+		bson.M{"case": bson.M{"$not": bson.A{bson.M{"$gt": bson.A{amount, 0}}}}, "then": biz.SalaryBucketNegotiable},
+	}
+	for _, b := range biz.SalaryBuckets {
+		conditions := bson.A{
+			bson.M{"$eq": bson.A{bson.M{"$toUpper": "$salary_currency"}, b.Currency}},
+			bson.M{"$gte": bson.A{amount, b.Min}},
+		}
+		if b.Max > 0 {
+			conditions = append(conditions, bson.M{"$lt": bson.A{amount, b.Max}})
+		}
+		branches = append(branches, bson.M{"case": bson.M{"$and": conditions}, "then": b.Key})
+	}
+	return bson.M{"$switch": bson.M{"branches": branches, "default": biz.SalaryBucketOther}}
+}
+
+// toFacetCounts converts facet groups, bỏ nhóm không có giá trị.
+// labelAsValue: filter theo cách viết gốc (location, job_tech) thay vì _id
+func toFacetCounts(groups []facetCount, labelAsValue bool) []biz.FacetCount {
+	counts := make([]biz.FacetCount, 0, len(groups))
+	for _, g := range groups {
+		var value string
+		switch id := g.ID.(type) {
+		case string:
+			value = id
+		case primitive.ObjectID:
+			value = id.Hex()
+		}
+		if value == "" {
+			continue
+		}
+
+		label := g.Label
+		if label == "" {
+			label = value
+		}
+		if labelAsValue {
+			value = label
+		}
+		counts = append(counts, biz.FacetCount{Value: value, Label: label, Count: g.Count})
+	}
+	return counts
+}
+
+// toSalaryFacetCounts orders the salary buckets as biz.SalaryBuckets, NEGOTIABLE và OTHER ở cuối
+func toSalaryFacetCounts(groups []facetCount) []biz.FacetCount {
+	byKey := make(map[string]int32, len(groups))
+	for _, g := range groups {
+		if key, ok := g.ID.(string); ok {
+			byKey[key] = g.Count
+		}
+	}
+
+	var counts []biz.FacetCount
+	for _, b := range biz.SalaryBuckets {
+		if n := byKey[b.Key]; n > 0 {
+			counts = append(counts, biz.FacetCount{Value: b.Key, Label: b.Label(), Count: n})
+		}
+	}
+	if n := byKey[biz.SalaryBucketNegotiable]; n > 0 {
+		counts = append(counts, biz.FacetCount{Value: biz.SalaryBucketNegotiable, Label: "Negotiable", Count: n})
+	}
+	if n := byKey[biz.SalaryBucketOther]; n > 0 {
+		counts = append(counts, biz.FacetCount{Value: biz.SalaryBucketOther, Label: "Other", Count: n})
+	}
+	return counts
+}
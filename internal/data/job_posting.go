@@ -2,13 +2,17 @@ package data
 
 import (
 	"JobblyBE/internal/biz"
+	"JobblyBE/pkg/textnorm"
 	"context"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // JobPosting struct for MongoDB
@@ -29,7 +33,12 @@ type JobPosting struct {
 	Requirements          string             `bson:"requirements"`
 	Benefits              string             `bson:"benefits"`
 	JobTech               []string           `bson:"job_tech"`
+	Status                string             `bson:"status,omitempty"` // rỗng = job tạo trước khi có status (PUBLISHED)
+	ExpiresAt             *time.Time         `bson:"expires_at,omitempty"`
 	CreatedAt             time.Time          `bson:"created_at"`
+	// Bản chuẩn hóa (textnorm) để tìm không dấu, ghi cùng title/location
+	TitleNorm    string `bson:"title_norm"`
+	LocationNorm string `bson:"location_norm"`
 }
 
 type jobPostingRepo struct {
@@ -39,10 +48,55 @@ type jobPostingRepo struct {
 
 // NewJobPostingRepo creates a new job posting repository
 func NewJobPostingRepo(data *Data, logger log.Logger) biz.JobPostingRepo {
-	return &jobPostingRepo{
+	r := &jobPostingRepo{
 		data: data,
 		log:  log.NewHelper(logger),
 	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionJobPosting).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "company_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetSparse(true)},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "posted_at", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create job posting indexes: %v", err)
+	}
+	// Tạo riêng để index cũ (trên title) không chặn các index khác
+	if _, err := r.data.db.Collection(CollectionJobPosting).Indexes().CreateOne(ctx, jobPostingTextIndex()); err != nil {
+		r.log.Errorf("failed to create job posting text index, run cmd/backfill-normalized to migrate it: %v", err)
+	}
+
+	return r
+}
+
+// jobPostingTextIndexName is the name of the text index of job postings
+const jobPostingTextIndexName = "job_posting_text"
+
+// jobPostingTextIndex is the text index used by the keyword search.
+// Trọng số title > job_tech > requirements > description.
+// Tiếng Việt không có stemming nên dùng language "none" (giữ mọi từ, không bỏ stop word).
+// Title dùng bản chuẩn hóa để "lap trinh vien" khớp "Lập trình viên" (text index không bỏ được đ)
+func jobPostingTextIndex() mongo.IndexModel {
+	return mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "title_norm", Value: "text"},
+			{Key: "job_tech", Value: "text"},
+			{Key: "requirements", Value: "text"},
+			{Key: "description", Value: "text"},
+		},
+		Options: options.Index().
+			SetName(jobPostingTextIndexName).
+			SetDefaultLanguage("none").
+			SetWeights(bson.D{
+				{Key: "title_norm", Value: 10},
+				{Key: "job_tech", Value: 5},
+				{Key: "requirements", Value: 2},
+				{Key: "description", Value: 1},
+			}),
+	}
 }
 
 // CreateJobPosting creates a new job posting
@@ -71,7 +125,11 @@ func (r *jobPostingRepo) CreateJobPosting(ctx context.Context, job *biz.JobPosti
 		Requirements:          job.Requirements,
 		Benefits:              job.Benefits,
 		JobTech:               job.JobTech,
+		Status:                string(job.Status),
+		ExpiresAt:             job.ExpiresAt,
 		CreatedAt:             now,
+		TitleNorm:             textnorm.Normalize(job.Title),
+		LocationNorm:          textnorm.Normalize(job.Location),
 	}
 
 	result, err := r.data.db.Collection(CollectionJobPosting).InsertOne(ctx, dbJob)
@@ -107,6 +165,9 @@ func (r *jobPostingRepo) UpdateJobPosting(ctx context.Context, job *biz.JobPosti
 			"requirements":           job.Requirements,
 			"benefits":               job.Benefits,
 			"job_tech":               job.JobTech,
+			"expires_at":             job.ExpiresAt,
+			"title_norm":             textnorm.Normalize(job.Title),
+			"location_norm":          textnorm.Normalize(job.Location),
 		},
 	}
 
@@ -150,7 +211,7 @@ func (r *jobPostingRepo) GetJobPosting(ctx context.Context, id string) (*biz.Job
 	pipeline := mongo.Pipeline{
 		{{Key: "$match", Value: bson.M{"_id": objID}}},
 		{{Key: "$lookup", Value: bson.M{
-			"from":         "companies",
+			"from":         CollectionCompany,
 			"localField":   "company_id",
 			"foreignField": "_id",
 			"as":           "company",
@@ -192,41 +253,11 @@ func (r *jobPostingRepo) GetJobPosting(ctx context.Context, id string) (*biz.Job
 
 // ListJobPostings lists job postings with filters and pagination
 func (r *jobPostingRepo) ListJobPostings(ctx context.Context, filter *biz.JobFilter, page, pageSize int32) ([]*biz.JobPosting, int32, error) {
-	// Build filter query
-	query := bson.M{}
+	query := jobFilterQuery(filter, time.Now())
 
-	if filter != nil {
-		if filter.CompanyID != "" {
-			companyObjID, err := primitive.ObjectIDFromHex(filter.CompanyID)
-			if err == nil {
-				query["company_id"] = companyObjID
-			}
-		}
-		if filter.Location != "" {
-			query["location"] = bson.M{"$regex": filter.Location, "$options": "i"}
-		}
-		if filter.JobType != "" {
-			// Case-insensitive match for job_type
-			query["job_type"] = bson.M{"$regex": "^" + string(filter.JobType) + "$", "$options": "i"}
-		}
-		if filter.Level != "" {
-			// Case-insensitive match for level
-			query["level"] = bson.M{"$regex": "^" + string(filter.Level) + "$", "$options": "i"}
-		}
-		if filter.Keyword != "" {
-			query["$or"] = []bson.M{
-				{"title": bson.M{"$regex": filter.Keyword, "$options": "i"}},
-				{"description": bson.M{"$regex": filter.Keyword, "$options": "i"}},
-			}
-		}
-		if len(filter.JobTech) > 0 {
-			// Case-insensitive match for job_tech array
-			techRegexes := make([]bson.M, len(filter.JobTech))
-			for i, tech := range filter.JobTech {
-				techRegexes[i] = bson.M{"$regex": "^" + tech + "$", "$options": "i"}
-			}
-			query["job_tech"] = bson.M{"$in": techRegexes}
-		}
+	sort := bson.D{{Key: "created_at", Value: -1}}
+	if _, ok := query["$text"]; ok && filter.Sort == biz.JobSortRelevance {
+		sort = bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "created_at", Value: -1}}
 	}
 
 	// Count total
@@ -242,11 +273,11 @@ func (r *jobPostingRepo) ListJobPostings(ctx context.Context, filter *biz.JobFil
 	// Use aggregation to join with company
 	pipeline := mongo.Pipeline{
 		{{Key: "$match", Value: query}},
-		{{Key: "$sort", Value: bson.M{"created_at": -1}}},
+		{{Key: "$sort", Value: sort}},
 		{{Key: "$skip", Value: skip}},
 		{{Key: "$limit", Value: pageSize}},
 		{{Key: "$lookup", Value: bson.M{
-			"from":         "companies",
+			"from":         CollectionCompany,
 			"localField":   "company_id",
 			"foreignField": "_id",
 			"as":           "company",
@@ -287,8 +318,180 @@ func (r *jobPostingRepo) ListJobPostings(ctx context.Context, filter *biz.JobFil
 	return jobs, int32(total), nil
 }
 
+// jobFilterQuery builds the query of a job listing filter
+func jobFilterQuery(filter *biz.JobFilter, now time.Time) bson.M {
+	// Build filter query
+	query := bson.M{}
+
+	if filter != nil {
+		if filter.CompanyID != "" {
+			companyObjID, err := primitive.ObjectIDFromHex(filter.CompanyID)
+			if err == nil {
+				query["company_id"] = companyObjID
+			}
+		}
+		if filter.Location != "" {
+			query["location_norm"] = bson.M{"$regex": regexp.QuoteMeta(textnorm.Normalize(filter.Location))}
+		}
+		if filter.JobType != "" {
+			// Case-insensitive match for job_type
+			query["job_type"] = bson.M{"$regex": "^" + regexp.QuoteMeta(string(filter.JobType)) + "$", "$options": "i"}
+		}
+		if filter.Level != "" {
+			// Case-insensitive match for level
+			query["level"] = bson.M{"$regex": "^" + regexp.QuoteMeta(string(filter.Level)) + "$", "$options": "i"}
+		}
+		if terms := biz.SearchTerms(filter.Keyword); len(terms) > 0 {
+			// Khớp job chứa một term bất kỳ
+			query["$text"] = bson.M{"$search": strings.Join(terms, " ")}
+		}
+		if filter.SalaryBucket != "" {
+			// Cùng biểu thức với facet salary_bucket
+			query[salaryBucketFilterKey] = bson.M{"$eq": bson.A{salaryBucketExpr(), filter.SalaryBucket}}
+		}
+		if len(filter.JobTech) > 0 {
+			// Case-insensitive match for job_tech array
+			techRegexes := make([]primitive.Regex, len(filter.JobTech))
+			for i, tech := range filter.JobTech {
+				techRegexes[i] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(tech) + "$", Options: "i"}
+			}
+			query["job_tech"] = bson.M{"$in": techRegexes}
+		}
+	}
+
+	var conditions []bson.M
+	if filter != nil {
+		if filter.PublicOnly {
+			conditions = append(conditions, jobStatusQuery(biz.JobStatusPublished, now))
+		}
+		if filter.Status != "" {
+			conditions = append(conditions, jobStatusQuery(filter.Status, now))
+		}
+	}
+	if len(conditions) > 0 {
+		// $and để không đụng $or của status EXPIRED
+		query["$and"] = conditions
+	}
+
+	return query
+}
+
+// UpdateJobStatus sets the status of a job if it is still from
+func (r *jobPostingRepo) UpdateJobStatus(ctx context.Context, id string, from, to biz.JobStatus, postedAt *time.Time) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, err
+	}
+
+	set := bson.M{"status": string(to)}
+	if postedAt != nil {
+		set["posted_at"] = postedAt
+	}
+
+	result, err := r.data.db.Collection(CollectionJobPosting).UpdateOne(ctx,
+		bson.M{"_id": objID, "status": storedJobStatus(from)},
+		bson.M{"$set": set},
+	)
+	if err != nil {
+		r.log.Errorf("failed to update job status: %v", err)
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// ListExpiredJobPostings returns published or paused jobs whose expires_at is not after now
+func (r *jobPostingRepo) ListExpiredJobPostings(ctx context.Context, now time.Time, limit int) ([]*biz.JobPosting, error) {
+	return r.findJobPostings(ctx, bson.M{
+		"status":     bson.M{"$in": bson.A{string(biz.JobStatusPublished), string(biz.JobStatusPaused), nil}},
+		"expires_at": bson.M{"$lte": now},
+	}, "expires_at", limit)
+}
+
+// ListScheduledJobPostings returns unexpired drafts whose posted_at is not after now
+func (r *jobPostingRepo) ListScheduledJobPostings(ctx context.Context, now time.Time, limit int) ([]*biz.JobPosting, error) {
+	return r.findJobPostings(ctx, bson.M{
+		"status":     string(biz.JobStatusDraft),
+		"posted_at":  bson.M{"$lte": now},
+		"expires_at": bson.M{"$not": bson.M{"$lte": now}},
+	}, "posted_at", limit)
+}
+
+// ListPublishedJobPostingsSince returns published, unexpired jobs with posted_at up to until
+// and (posted_at, _id) after (since, afterID)
+func (r *jobPostingRepo) ListPublishedJobPostingsSince(ctx context.Context, since time.Time, afterID string, until time.Time, limit int) ([]*biz.JobPosting, error) {
+	query := jobStatusQuery(biz.JobStatusPublished, until)
+	query["posted_at"] = bson.M{"$gte": since, "$lte": until}
+	if afterID != "" {
+		afterObjID, err := primitive.ObjectIDFromHex(afterID)
+		if err != nil {
+			return nil, err
+		}
+		query["$or"] = bson.A{
+			bson.M{"posted_at": bson.M{"$gt": since}},
+			bson.M{"posted_at": since, "_id": bson.M{"$gt": afterObjID}},
+		}
+	}
+	return r.findJobPostings(ctx, query, "posted_at", limit)
+}
+
+// findJobPostings returns at most limit jobs matching query sorted by (field, _id), without company info
+func (r *jobPostingRepo) findJobPostings(ctx context.Context, query bson.M, sortField string, limit int) ([]*biz.JobPosting, error) {
+	opts := options.Find().SetSort(bson.D{{Key: sortField, Value: 1}, {Key: "_id", Value: 1}}).SetLimit(int64(limit))
+	cursor, err := r.data.db.Collection(CollectionJobPosting).Find(ctx, query, opts)
+	if err != nil {
+		r.log.Errorf("failed to find job postings: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var dbJobs []JobPosting
+	if err := cursor.All(ctx, &dbJobs); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*biz.JobPosting, 0, len(dbJobs))
+	for i := range dbJobs {
+		jobs = append(jobs, r.toBiz(&dbJobs[i]))
+	}
+	return jobs, nil
+}
+
+// storedJobStatus matches the stored status field of jobs in status s
+// (job cũ không có field status là PUBLISHED)
+func storedJobStatus(s biz.JobStatus) interface{} {
+	if s == biz.JobStatusPublished {
+		return bson.M{"$in": bson.A{string(s), nil}}
+	}
+	return string(s)
+}
+
+// jobStatusQuery matches the jobs whose current status at now is s.
+// Job PUBLISHED/PAUSED đã qua expires_at được tính là EXPIRED.
+func jobStatusQuery(s biz.JobStatus, now time.Time) bson.M {
+	expirable := bson.A{string(biz.JobStatusPublished), string(biz.JobStatusPaused), nil}
+	switch s {
+	case biz.JobStatusPublished, biz.JobStatusPaused:
+		return bson.M{
+			"status": storedJobStatus(s),
+			// $not $lte khớp cả job không có expires_at
+			"expires_at": bson.M{"$not": bson.M{"$lte": now}},
+		}
+	case biz.JobStatusExpired:
+		return bson.M{"$or": bson.A{
+			bson.M{"status": string(biz.JobStatusExpired)},
+			bson.M{"status": bson.M{"$in": expirable}, "expires_at": bson.M{"$lte": now}},
+		}}
+	}
+	return bson.M{"status": string(s)}
+}
+
 // toBiz converts data layer JobPosting to biz layer JobPosting
 func (r *jobPostingRepo) toBiz(j *JobPosting) *biz.JobPosting {
+	status := j.Status
+	if status == "" {
+		status = string(biz.JobStatusPublished)
+	}
 	return &biz.JobPosting{
 		ID:                    j.ID.Hex(),
 		CompanyID:             j.CompanyID.Hex(),
@@ -306,6 +509,8 @@ func (r *jobPostingRepo) toBiz(j *JobPosting) *biz.JobPosting {
 		Requirements:          j.Requirements,
 		Benefits:              j.Benefits,
 		JobTech:               j.JobTech,
+		Status:                biz.JobStatus(status),
+		ExpiresAt:             j.ExpiresAt,
 		CreatedAt:             j.CreatedAt,
 	}
 }
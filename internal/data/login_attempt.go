@@ -0,0 +1,142 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// LoginAttempt struct for MongoDB (one document per account or client IP)
+type LoginAttempt struct {
+	Key         string      `bson:"_id"`
+	Failures    []time.Time `bson:"failures"`
+	Lockouts    int         `bson:"lockouts"`
+	LockedUntil *time.Time  `bson:"locked_until,omitempty"`
+	ExpiresAt   time.Time   `bson:"expires_at"`
+}
+
+type loginAttemptRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLoginAttemptRepo creates a MongoDB backed login attempt repository
+func NewLoginAttemptRepo(data *Data, logger log.Logger) biz.LoginAttemptRepo {
+	r := &loginAttemptRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	// TTL index: state is forgotten after a quiet period, lockout backoff resets
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionLoginAttempt).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		r.log.Errorf("failed to create login attempt ttl index: %v", err)
+	}
+
+	return r
+}
+
+// GetLoginAttempt retrieves the state of a key
+func (r *loginAttemptRepo) GetLoginAttempt(ctx context.Context, key string) (*biz.LoginAttempt, error) {
+	var attempt LoginAttempt
+	err := r.data.db.Collection(CollectionLoginAttempt).FindOne(ctx, bson.M{"_id": key}).Decode(&attempt)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // No failures recorded
+		}
+		r.log.Errorf("failed to get login attempt: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&attempt), nil
+}
+
+// AddLoginFailure appends a failure and drops the ones before windowStart in one atomic update
+func (r *loginAttemptRepo) AddLoginFailure(ctx context.Context, key string, at, windowStart, expiresAt time.Time) (*biz.LoginAttempt, error) {
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"failures": bson.M{"$filter": bson.M{
+				"input": bson.M{"$concatArrays": bson.A{bson.M{"$ifNull": bson.A{"$failures", bson.A{}}}, bson.A{at}}},
+				"cond":  bson.M{"$gte": bson.A{"$$this", windowStart}},
+			}},
+			"lockouts":   bson.M{"$ifNull": bson.A{"$lockouts", 0}},
+			"expires_at": bson.M{"$max": bson.A{bson.M{"$ifNull": bson.A{"$expires_at", expiresAt}}, expiresAt}},
+		}}},
+	}
+
+	var attempt LoginAttempt
+	err := r.data.db.Collection(CollectionLoginAttempt).FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": key},
+		update,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&attempt)
+	if err != nil {
+		r.log.Errorf("failed to add login failure: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&attempt), nil
+}
+
+// LockLogin locks a key that is not locked at `at`
+func (r *loginAttemptRepo) LockLogin(ctx context.Context, key string, at, until, expiresAt time.Time) (*biz.LoginAttempt, error) {
+	var attempt LoginAttempt
+	err := r.data.db.Collection(CollectionLoginAttempt).FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"_id": key,
+			"$or": bson.A{
+				bson.M{"locked_until": bson.M{"$exists": false}},
+				bson.M{"locked_until": bson.M{"$lte": at}},
+			},
+		},
+		bson.M{
+			"$set": bson.M{
+				"locked_until": until,
+				"failures":     bson.A{},
+				"expires_at":   expiresAt,
+			},
+			"$inc": bson.M{"lockouts": 1},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&attempt)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Already locked
+		}
+		r.log.Errorf("failed to lock login: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&attempt), nil
+}
+
+// DeleteLoginAttempt clears the state of a key
+func (r *loginAttemptRepo) DeleteLoginAttempt(ctx context.Context, key string) error {
+	if _, err := r.data.db.Collection(CollectionLoginAttempt).DeleteOne(ctx, bson.M{"_id": key}); err != nil {
+		r.log.Errorf("failed to delete login attempt: %v", err)
+		return err
+	}
+	return nil
+}
+
+// toBiz converts data layer LoginAttempt to biz layer LoginAttempt
+func (r *loginAttemptRepo) toBiz(a *LoginAttempt) *biz.LoginAttempt {
+	return &biz.LoginAttempt{
+		Key:         a.Key,
+		Failures:    len(a.Failures),
+		Lockouts:    a.Lockouts,
+		LockedUntil: a.LockedUntil,
+	}
+}
@@ -0,0 +1,29 @@
+package data
+
+import (
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/configx"
+	"JobblyBE/pkg/mailer"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewMailer creates the mailer configured in conf.Server (log driver by default)
+func NewMailer(c *conf.Server, logger log.Logger) mailer.Mailer {
+	mc := c.GetMail()
+	cfg := mailer.Config{
+		Driver:   configx.GetEnvOrString("MAIL_DRIVER", mc.GetDriver()),
+		Host:     configx.GetEnvOrString("SMTP_HOST", mc.GetHost()),
+		Port:     configx.GetEnvOrInt("SMTP_PORT", int(mc.GetPort())),
+		Username: configx.GetEnvOrString("SMTP_USERNAME", mc.GetUsername()),
+		Password: configx.GetEnvOrString("SMTP_PASSWORD", mc.GetPassword()),
+		From:     configx.GetEnvOrString("MAIL_FROM", mc.GetFrom()),
+		Dir:      configx.GetEnvOrString("MAIL_DIR", mc.GetDir()),
+	}
+	if cfg.From == "" {
+		cfg.From = "no-reply@jobbly.vn"
+	}
+
+	log.NewHelper(logger).Infof("mailer driver: %s", cfg.Driver)
+	return mailer.New(cfg, logger)
+}
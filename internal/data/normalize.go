@@ -0,0 +1,139 @@
+package data
+
+import (
+	"JobblyBE/pkg/textnorm"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// backfillBatchSize là số update gửi trong một BulkWrite
+const backfillBatchSize = 500
+
+// normalizedFields maps every collection with shadow fields to its source field -> normalized field
+var normalizedFields = map[string]map[string]string{
+	CollectionJobPosting: {"title": "title_norm", "location": "location_norm"},
+	CollectionCompany:    {"name": "name_norm", "location": "location_norm"},
+}
+
+// MigrateJobPostingTextIndex drops the text index of job postings if it was created on title
+// (trước khi có title_norm) và tạo lại trên title_norm. MongoDB không cho tạo index trùng tên
+// với key khác nên NewJobPostingRepo không tự sửa được. Trả về true nếu index cũ đã bị xóa.
+func (d *Data) MigrateJobPostingTextIndex(ctx context.Context) (bool, error) {
+	indexes := d.db.Collection(CollectionJobPosting).Indexes()
+
+	cursor, err := indexes.List(ctx)
+	if err != nil {
+		d.log.Errorf("failed to list job posting indexes: %v", err)
+		return false, err
+	}
+	var specs []struct {
+		Name    string `bson:"name"`
+		Weights bson.M `bson:"weights"`
+	}
+	if err := cursor.All(ctx, &specs); err != nil {
+		return false, err
+	}
+
+	dropped := false
+	for _, spec := range specs {
+		if spec.Name != jobPostingTextIndexName {
+			continue
+		}
+		if _, ok := spec.Weights["title_norm"]; ok {
+			break // đã là index mới
+		}
+		if _, err := indexes.DropOne(ctx, jobPostingTextIndexName); err != nil {
+			d.log.Errorf("failed to drop job posting text index: %v", err)
+			return false, err
+		}
+		dropped = true
+	}
+
+	if _, err := indexes.CreateOne(ctx, jobPostingTextIndex()); err != nil {
+		d.log.Errorf("failed to create job posting text index: %v", err)
+		return dropped, err
+	}
+	return dropped, nil
+}
+
+// BackfillNormalizedFields recomputes the normalized shadow fields of every job posting and company.
+// Chỉ ghi document có giá trị thay đổi nên có thể chạy lại nhiều lần.
+// Trả về số document được cập nhật theo collection.
+func (d *Data) BackfillNormalizedFields(ctx context.Context) (map[string]int64, error) {
+	updated := make(map[string]int64, len(normalizedFields))
+	for collection, fields := range normalizedFields {
+		n, err := d.backfillCollection(ctx, collection, fields)
+		if err != nil {
+			return updated, err
+		}
+		updated[collection] = n
+	}
+	return updated, nil
+}
+
+func (d *Data) backfillCollection(ctx context.Context, collection string, fields map[string]string) (int64, error) {
+	coll := d.db.Collection(collection)
+
+	projection := bson.M{}
+	for source, target := range fields {
+		projection[source] = 1
+		projection[target] = 1
+	}
+	cursor, err := coll.Find(ctx, bson.M{}, options.Find().SetProjection(projection))
+	if err != nil {
+		d.log.Errorf("failed to read %s: %v", collection, err)
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var updated int64
+	models := make([]mongo.WriteModel, 0, backfillBatchSize)
+	flush := func() error {
+		if len(models) == 0 {
+			return nil
+		}
+		result, err := coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			d.log.Errorf("failed to backfill %s: %v", collection, err)
+			return err
+		}
+		updated += result.ModifiedCount
+		models = models[:0]
+		return nil
+	}
+
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return updated, err
+		}
+
+		set := bson.M{}
+		for source, target := range fields {
+			value, _ := doc[source].(string)
+			normalized := textnorm.Normalize(value)
+			if current, ok := doc[target].(string); !ok || current != normalized {
+				set[target] = normalized
+			}
+		}
+		if len(set) == 0 {
+			continue
+		}
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": doc["_id"]}).
+			SetUpdate(bson.M{"$set": set}))
+		if len(models) == backfillBatchSize {
+			if err := flush(); err != nil {
+				return updated, err
+			}
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return updated, err
+	}
+	return updated, flush()
+}
@@ -0,0 +1,177 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Notification struct for MongoDB
+type Notification struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Type      string             `bson:"type"`
+	Title     string             `bson:"title"`
+	Body      string             `bson:"body"`
+	Data      map[string]string  `bson:"data,omitempty"`
+	ReadAt    *time.Time         `bson:"read_at"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type notificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewNotificationRepo creates a new notification repository
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	r := &notificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionNotification).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "read_at", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create notification indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateNotification stores a new notification
+func (r *notificationRepo) CreateNotification(ctx context.Context, n *biz.Notification) (*biz.Notification, error) {
+	userObjID, err := primitive.ObjectIDFromHex(n.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbNotification := &Notification{
+		UserID:    userObjID,
+		Type:      string(n.Type),
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		CreatedAt: time.Now(),
+	}
+
+	result, err := r.data.db.Collection(CollectionNotification).InsertOne(ctx, dbNotification)
+	if err != nil {
+		r.log.Errorf("failed to create notification: %v", err)
+		return nil, err
+	}
+
+	dbNotification.ID = result.InsertedID.(primitive.ObjectID)
+	return r.toBiz(dbNotification), nil
+}
+
+// ListNotifications lists the notifications of a user, newest first, with the unread count
+func (r *notificationRepo) ListNotifications(ctx context.Context, userID string, unreadOnly bool, page, pageSize int32) ([]*biz.Notification, int32, int32, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	coll := r.data.db.Collection(CollectionNotification)
+	unread, err := coll.CountDocuments(ctx, bson.M{"user_id": userObjID, "read_at": nil})
+	if err != nil {
+		r.log.Errorf("failed to count notifications: %v", err)
+		return nil, 0, 0, err
+	}
+
+	query := bson.M{"user_id": userObjID}
+	total := unread
+	if unreadOnly {
+		query["read_at"] = nil
+	} else if total, err = coll.CountDocuments(ctx, query); err != nil {
+		r.log.Errorf("failed to count notifications: %v", err)
+		return nil, 0, 0, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+	cursor, err := coll.Find(ctx, query, opts)
+	if err != nil {
+		r.log.Errorf("failed to list notifications: %v", err)
+		return nil, 0, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var dbNotifications []Notification
+	if err := cursor.All(ctx, &dbNotifications); err != nil {
+		return nil, 0, 0, err
+	}
+
+	notifications := make([]*biz.Notification, 0, len(dbNotifications))
+	for i := range dbNotifications {
+		notifications = append(notifications, r.toBiz(&dbNotifications[i]))
+	}
+	return notifications, int32(total), int32(unread), nil
+}
+
+// MarkNotificationRead marks a notification of a user as read
+func (r *notificationRepo) MarkNotificationRead(ctx context.Context, userID, id string, at time.Time) (bool, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return false, err
+	}
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, nil // Invalid ID, no such notification
+	}
+
+	result, err := r.data.db.Collection(CollectionNotification).UpdateOne(ctx,
+		bson.M{"_id": objID, "user_id": userObjID},
+		// Giữ thời điểm đọc lần đầu
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{"read_at": bson.M{"$ifNull": bson.A{"$read_at", at}}}}}},
+	)
+	if err != nil {
+		r.log.Errorf("failed to mark notification read: %v", err)
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// MarkAllNotificationsRead marks every unread notification of a user as read
+func (r *notificationRepo) MarkAllNotificationsRead(ctx context.Context, userID string, at time.Time) error {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.db.Collection(CollectionNotification).UpdateMany(ctx,
+		bson.M{"user_id": userObjID, "read_at": nil},
+		bson.M{"$set": bson.M{"read_at": at}},
+	)
+	if err != nil {
+		r.log.Errorf("failed to mark notifications read: %v", err)
+		return err
+	}
+	return nil
+}
+
+// toBiz converts data layer Notification to biz layer Notification
+func (r *notificationRepo) toBiz(n *Notification) *biz.Notification {
+	return &biz.Notification{
+		ID:        n.ID.Hex(),
+		UserID:    n.UserID.Hex(),
+		Type:      biz.NotificationType(n.Type),
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      n.Data,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
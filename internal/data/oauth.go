@@ -0,0 +1,185 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// OAuthState struct for MongoDB
+type OAuthState struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	StateHash    string             `bson:"state_hash"`
+	Provider     string             `bson:"provider"`
+	CodeVerifier string             `bson:"code_verifier"`
+	Nonce        string             `bson:"nonce"`
+	ExpiresAt    time.Time          `bson:"expires_at"`
+	CreatedAt    time.Time          `bson:"created_at"`
+}
+
+// UserIdentity struct for MongoDB
+type UserIdentity struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	Provider  string             `bson:"provider"`
+	Subject   string             `bson:"subject"`
+	Email     string             `bson:"email"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type oauthStateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOAuthStateRepo creates a new oauth state repository
+func NewOAuthStateRepo(data *Data, logger log.Logger) biz.OAuthStateRepo {
+	r := &oauthStateRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionOAuthState).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "state_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		// Expired states are removed by MongoDB
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create oauth state indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateOAuthState stores a pending social login
+func (r *oauthStateRepo) CreateOAuthState(ctx context.Context, state *biz.OAuthState) error {
+	dbState := &OAuthState{
+		StateHash:    state.StateHash,
+		Provider:     state.Provider,
+		CodeVerifier: state.CodeVerifier,
+		Nonce:        state.Nonce,
+		ExpiresAt:    state.ExpiresAt,
+		CreatedAt:    state.CreatedAt,
+	}
+
+	if _, err := r.data.db.Collection(CollectionOAuthState).InsertOne(ctx, dbState); err != nil {
+		r.log.Errorf("failed to create oauth state: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ConsumeOAuthState atomically deletes an unexpired state and returns it
+func (r *oauthStateRepo) ConsumeOAuthState(ctx context.Context, stateHash string) (*biz.OAuthState, error) {
+	var state OAuthState
+	err := r.data.db.Collection(CollectionOAuthState).FindOneAndDelete(ctx, bson.M{
+		"state_hash": stateHash,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}).Decode(&state)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // No valid state
+		}
+		r.log.Errorf("failed to consume oauth state: %v", err)
+		return nil, err
+	}
+
+	return &biz.OAuthState{
+		StateHash:    state.StateHash,
+		Provider:     state.Provider,
+		CodeVerifier: state.CodeVerifier,
+		Nonce:        state.Nonce,
+		ExpiresAt:    state.ExpiresAt,
+		CreatedAt:    state.CreatedAt,
+	}, nil
+}
+
+type userIdentityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUserIdentityRepo creates a new linked identity repository
+func NewUserIdentityRepo(data *Data, logger log.Logger) biz.UserIdentityRepo {
+	r := &userIdentityRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionUserIdentity).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "provider", Value: 1}, {Key: "subject", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create user identity indexes: %v", err)
+	}
+
+	return r
+}
+
+// GetIdentity retrieves the identity linked to a provider subject
+func (r *userIdentityRepo) GetIdentity(ctx context.Context, provider, subject string) (*biz.UserIdentity, error) {
+	var identity UserIdentity
+	err := r.data.db.Collection(CollectionUserIdentity).FindOne(ctx, bson.M{
+		"provider": provider,
+		"subject":  subject,
+	}).Decode(&identity)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // identity not linked
+		}
+		r.log.Errorf("failed to get user identity: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&identity), nil
+}
+
+// CreateIdentity links a provider subject to a user
+func (r *userIdentityRepo) CreateIdentity(ctx context.Context, identity *biz.UserIdentity) error {
+	userObjID, err := primitive.ObjectIDFromHex(identity.UserID)
+	if err != nil {
+		return err
+	}
+
+	dbIdentity := &UserIdentity{
+		UserID:    userObjID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     identity.Email,
+		CreatedAt: time.Now(),
+	}
+
+	if _, err := r.data.db.Collection(CollectionUserIdentity).InsertOne(ctx, dbIdentity); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return biz.ErrIdentityAlreadyLinked
+		}
+		r.log.Errorf("failed to create user identity: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// toBiz converts data layer UserIdentity to biz layer UserIdentity
+func (r *userIdentityRepo) toBiz(i *UserIdentity) *biz.UserIdentity {
+	return &biz.UserIdentity{
+		ID:        i.ID.Hex(),
+		UserID:    i.UserID.Hex(),
+		Provider:  i.Provider,
+		Subject:   i.Subject,
+		Email:     i.Email,
+		CreatedAt: i.CreatedAt,
+	}
+}
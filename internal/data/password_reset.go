@@ -0,0 +1,145 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PasswordResetToken struct for MongoDB
+type PasswordResetToken struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	TokenHash string             `bson:"token_hash"`
+	ExpiresAt time.Time          `bson:"expires_at"`
+	UsedAt    *time.Time         `bson:"used_at,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type passwordResetRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPasswordResetRepo creates a new password reset token repository
+func NewPasswordResetRepo(data *Data, logger log.Logger) biz.PasswordResetRepo {
+	r := &passwordResetRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionPasswordResetToken).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "token_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+		// Expired tokens are removed by MongoDB
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create password reset token indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateResetToken stores a new password reset token
+func (r *passwordResetRepo) CreateResetToken(ctx context.Context, token *biz.PasswordResetToken) error {
+	userObjID, err := primitive.ObjectIDFromHex(token.UserID)
+	if err != nil {
+		return err
+	}
+
+	dbToken := &PasswordResetToken{
+		UserID:    userObjID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+
+	if _, err := r.data.db.Collection(CollectionPasswordResetToken).InsertOne(ctx, dbToken); err != nil {
+		r.log.Errorf("failed to create password reset token: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ConsumeResetToken atomically marks an unused, unexpired token as used and returns it
+func (r *passwordResetRepo) ConsumeResetToken(ctx context.Context, tokenHash string) (*biz.PasswordResetToken, error) {
+	now := time.Now()
+	var token PasswordResetToken
+	err := r.data.db.Collection(CollectionPasswordResetToken).FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"token_hash": tokenHash,
+			"used_at":    bson.M{"$exists": false},
+			"expires_at": bson.M{"$gt": now},
+		},
+		bson.M{"$set": bson.M{"used_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&token)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // No valid token
+		}
+		r.log.Errorf("failed to consume password reset token: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&token), nil
+}
+
+// DeleteUserResetTokens deletes all password reset tokens of a user
+func (r *passwordResetRepo) DeleteUserResetTokens(ctx context.Context, userID string) error {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.data.db.Collection(CollectionPasswordResetToken).DeleteMany(ctx, bson.M{"user_id": userObjID}); err != nil {
+		r.log.Errorf("failed to delete password reset tokens: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// HasRecentResetToken reports whether the user has an unused, unexpired token created after since
+func (r *passwordResetRepo) HasRecentResetToken(ctx context.Context, userID string, since time.Time) (bool, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return false, err
+	}
+
+	count, err := r.data.db.Collection(CollectionPasswordResetToken).CountDocuments(ctx, bson.M{
+		"user_id":    userObjID,
+		"used_at":    bson.M{"$exists": false},
+		"expires_at": bson.M{"$gt": time.Now()},
+		"created_at": bson.M{"$gte": since},
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		r.log.Errorf("failed to check recent password reset tokens: %v", err)
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// toBiz converts data layer PasswordResetToken to biz layer PasswordResetToken
+func (r *passwordResetRepo) toBiz(t *PasswordResetToken) *biz.PasswordResetToken {
+	return &biz.PasswordResetToken{
+		ID:        t.ID.Hex(),
+		UserID:    t.UserID.Hex(),
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
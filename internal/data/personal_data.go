@@ -0,0 +1,129 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// personalDataCollection describes where a collection stores the owner of its documents
+type personalDataCollection struct {
+	Name      string
+	UserField string   // field chứa ObjectID của user
+	Secret    []string // field không bao giờ export (hash, secret)
+}
+
+// personalDataCollections lists every collection holding personal data, in purge order.
+// Collection mới có dữ liệu của user phải được thêm vào đây.
+// Không có ở đây: revoked_token, login_attempt, oauth_state (TTL, tự hết hạn)
+// và audit_log (append-only, giữ lại làm bằng chứng; không chứa giá trị field cá nhân, xem biz.AuditRedacted).
+var personalDataCollections = []personalDataCollection{
+	{Name: CollectionUserTracking, UserField: "user_id"},
+	{Name: CollectionSession, UserField: "user_id"},
+	{Name: CollectionSecurityEvent, UserField: "user_id"},
+	{Name: CollectionPasswordResetToken, UserField: "user_id", Secret: []string{"token_hash"}},
+	{Name: CollectionUserTOTP, UserField: "_id", Secret: []string{"secret", "recovery_code_hashes"}},
+	{Name: CollectionCompanyMember, UserField: "user_id"},
+	{Name: CollectionUserIdentity, UserField: "user_id"},
+	{Name: CollectionAPIKey, UserField: "user_id", Secret: []string{"key_hash"}},
+	{Name: CollectionApplication, UserField: "user_id"},
+	{Name: CollectionSavedJob, UserField: "user_id"},
+	{Name: CollectionSavedSearch, UserField: "user_id", Secret: []string{"unsubscribe_token"}},
+	{Name: CollectionJobAlertMatch, UserField: "user_id"},
+	{Name: CollectionNotification, UserField: "user_id"},
+	// Profile và các resume (embedded), luôn purge cuối cùng
+	{Name: CollectionUser, UserField: "_id", Secret: []string{"password", "email_verification_token_id"}},
+}
+
+type personalDataStore struct {
+	data       *Data
+	collection personalDataCollection
+	log        *log.Helper
+}
+
+// NewPersonalDataStores creates the personal data stores of every collection holding user data
+func NewPersonalDataStores(data *Data, logger log.Logger) biz.PersonalDataStores {
+	stores := make(biz.PersonalDataStores, 0, len(personalDataCollections))
+	for _, c := range personalDataCollections {
+		stores = append(stores, &personalDataStore{
+			data:       data,
+			collection: c,
+			log:        log.NewHelper(logger),
+		})
+	}
+	return stores
+}
+
+func (s *personalDataStore) Name() string {
+	return s.collection.Name
+}
+
+// filter matches the documents of userID. Never matches the zero ObjectID,
+// dữ liệu ẩn danh (user_id rỗng) không thuộc về user nào nên không bị export hay xóa.
+func (s *personalDataStore) filter(userID string) (bson.M, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, err
+	}
+	if objID.IsZero() {
+		return nil, biz.ErrUserNotFound
+	}
+	return bson.M{s.collection.UserField: objID}, nil
+}
+
+// ExportUserData returns the documents of a user without their secret fields
+func (s *personalDataStore) ExportUserData(ctx context.Context, userID string) ([]map[string]interface{}, error) {
+	filter, err := s.filter(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find()
+	if len(s.collection.Secret) > 0 {
+		projection := bson.M{}
+		for _, field := range s.collection.Secret {
+			projection[field] = 0
+		}
+		opts.SetProjection(projection)
+	}
+
+	// Decode document lồng nhau thành map để encode JSON dễ đọc
+	coll := s.data.db.Collection(s.collection.Name, options.Collection().SetBSONOptions(&options.BSONOptions{DefaultDocumentM: true}))
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		s.log.Errorf("failed to export %s: %v", s.collection.Name, err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []bson.M
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, 0, len(docs))
+	for _, doc := range docs {
+		result = append(result, doc)
+	}
+	return result, nil
+}
+
+// PurgeUserData deletes the documents of a user
+func (s *personalDataStore) PurgeUserData(ctx context.Context, userID string) (int64, error) {
+	filter, err := s.filter(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := s.data.db.Collection(s.collection.Name).DeleteMany(ctx, filter)
+	if err != nil {
+		s.log.Errorf("failed to purge %s: %v", s.collection.Name, err)
+		return 0, err
+	}
+
+	return result.DeletedCount, nil
+}
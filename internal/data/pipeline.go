@@ -0,0 +1,115 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// HiringPipeline struct for MongoDB, mỗi công ty một document với _id = company ID
+type HiringPipeline struct {
+	CompanyID primitive.ObjectID `bson:"_id"`
+	Stages    []PipelineStage    `bson:"stages"`
+	UpdatedBy string             `bson:"updated_by"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+// PipelineStage struct for MongoDB
+type PipelineStage struct {
+	Key         string   `bson:"key"`
+	Name        string   `bson:"name"`
+	Transitions []string `bson:"transitions"`
+}
+
+type pipelineRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPipelineRepo creates a new hiring pipeline repository
+func NewPipelineRepo(data *Data, logger log.Logger) biz.PipelineRepo {
+	return &pipelineRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetPipeline retrieves the pipeline configured by a company
+func (r *pipelineRepo) GetPipeline(ctx context.Context, companyID string) (*biz.HiringPipeline, error) {
+	objID, err := primitive.ObjectIDFromHex(companyID)
+	if err != nil {
+		return nil, nil // Invalid ID, dùng pipeline mặc định
+	}
+
+	var p HiringPipeline
+	err = r.data.db.Collection(CollectionHiringPipeline).FindOne(ctx, bson.M{"_id": objID}).Decode(&p)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Company chưa cấu hình pipeline
+		}
+		r.log.Errorf("failed to get hiring pipeline: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&p), nil
+}
+
+// SavePipeline creates or replaces the pipeline of a company
+func (r *pipelineRepo) SavePipeline(ctx context.Context, pipeline *biz.HiringPipeline) error {
+	objID, err := primitive.ObjectIDFromHex(pipeline.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	p := &HiringPipeline{
+		CompanyID: objID,
+		Stages:    make([]PipelineStage, 0, len(pipeline.Stages)),
+		UpdatedBy: pipeline.UpdatedBy,
+		UpdatedAt: time.Now(),
+	}
+	if pipeline.UpdatedAt != nil {
+		p.UpdatedAt = *pipeline.UpdatedAt
+	}
+	for _, stage := range pipeline.Stages {
+		p.Stages = append(p.Stages, PipelineStage{
+			Key:         stage.Key,
+			Name:        stage.Name,
+			Transitions: stage.Transitions,
+		})
+	}
+
+	_, err = r.data.db.Collection(CollectionHiringPipeline).ReplaceOne(ctx,
+		bson.M{"_id": objID}, p, options.Replace().SetUpsert(true))
+	if err != nil {
+		r.log.Errorf("failed to save hiring pipeline: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// toBiz converts data layer HiringPipeline to biz layer HiringPipeline
+func (r *pipelineRepo) toBiz(p *HiringPipeline) *biz.HiringPipeline {
+	stages := make([]*biz.PipelineStage, 0, len(p.Stages))
+	for _, stage := range p.Stages {
+		stages = append(stages, &biz.PipelineStage{
+			Key:         stage.Key,
+			Name:        stage.Name,
+			Transitions: stage.Transitions,
+		})
+	}
+
+	updatedAt := p.UpdatedAt
+	return &biz.HiringPipeline{
+		CompanyID: p.CompanyID.Hex(),
+		Stages:    stages,
+		UpdatedBy: p.UpdatedBy,
+		UpdatedAt: &updatedAt,
+	}
+}
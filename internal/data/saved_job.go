@@ -0,0 +1,214 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SavedJob struct for MongoDB
+type SavedJob struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	JobID     primitive.ObjectID `bson:"job_id"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type savedJobRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSavedJobRepo creates a new saved job repository
+func NewSavedJobRepo(data *Data, logger log.Logger) biz.SavedJobRepo {
+	r := &savedJobRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionSavedJob).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "job_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create saved job indexes: %v", err)
+	}
+
+	return r
+}
+
+// SaveJob upserts a saved job of a user
+func (r *savedJobRepo) SaveJob(ctx context.Context, userID, jobID string, at time.Time) error {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+	jobObjID, err := primitive.ObjectIDFromHex(jobID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.db.Collection(CollectionSavedJob).UpdateOne(ctx,
+		bson.M{"user_id": userObjID, "job_id": jobObjID},
+		bson.M{"$setOnInsert": bson.M{"created_at": at}},
+		options.Update().SetUpsert(true),
+	)
+	// Hai request lưu cùng lúc: request thua gặp duplicate key, job vẫn đã được lưu
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		r.log.Errorf("failed to save job: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSavedJob removes a saved job of a user
+func (r *savedJobRepo) DeleteSavedJob(ctx context.Context, userID, jobID string) error {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+	jobObjID, err := primitive.ObjectIDFromHex(jobID)
+	if err != nil {
+		return nil // Invalid ID, job chưa được lưu
+	}
+
+	_, err = r.data.db.Collection(CollectionSavedJob).DeleteOne(ctx, bson.M{"user_id": userObjID, "job_id": jobObjID})
+	if err != nil {
+		r.log.Errorf("failed to delete saved job: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListSavedJobs lists the saved jobs of a user joined with job and company info
+func (r *savedJobRepo) ListSavedJobs(ctx context.Context, userID string, page, pageSize int32) ([]*biz.SavedJob, int32, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	skip := (page - 1) * pageSize
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userObjID}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         CollectionJobPosting,
+			"localField":   "job_id",
+			"foreignField": "_id",
+			"as":           "job",
+		}}},
+		// Bỏ job đã bị xóa
+		{{Key: "$unwind", Value: "$job"}},
+		{{Key: "$facet", Value: bson.M{
+			"items": bson.A{
+				bson.M{"$sort": bson.M{"created_at": -1}},
+				bson.M{"$skip": skip},
+				bson.M{"$limit": pageSize},
+				bson.M{"$lookup": bson.M{
+					"from":         CollectionCompany,
+					"localField":   "job.company_id",
+					"foreignField": "_id",
+					"as":           "company",
+				}},
+				bson.M{"$unwind": bson.M{
+					"path":                       "$company",
+					"preserveNullAndEmptyArrays": true,
+				}},
+			},
+			"total": bson.A{bson.M{"$count": "count"}},
+		}}},
+	}
+
+	cursor, err := r.data.db.Collection(CollectionSavedJob).Aggregate(ctx, pipeline)
+	if err != nil {
+		r.log.Errorf("failed to list saved jobs: %v", err)
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	type SavedJobWithJob struct {
+		SavedJob `bson:",inline"`
+		Job      JobPosting `bson:"job"`
+		Company  *Company   `bson:"company"`
+	}
+
+	var results []struct {
+		Items []SavedJobWithJob `bson:"items"`
+		Total []struct {
+			Count int32 `bson:"count"`
+		} `bson:"total"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, 0, err
+	}
+	if len(results) == 0 {
+		return []*biz.SavedJob{}, 0, nil
+	}
+
+	jobs := &jobPostingRepo{data: r.data, log: r.log}
+	companies := &companyRepo{data: r.data, log: r.log}
+	saved := make([]*biz.SavedJob, 0, len(results[0].Items))
+	for i := range results[0].Items {
+		item := &results[0].Items[i]
+		bizJob := jobs.toBiz(&item.Job)
+		if item.Company != nil {
+			bizJob.Company = companies.toBiz(item.Company)
+		}
+		saved = append(saved, &biz.SavedJob{
+			UserID:  item.UserID.Hex(),
+			JobID:   item.JobID.Hex(),
+			Job:     bizJob,
+			SavedAt: item.CreatedAt,
+		})
+	}
+
+	var total int32
+	if len(results[0].Total) > 0 {
+		total = results[0].Total[0].Count
+	}
+	return saved, total, nil
+}
+
+// SavedJobIDs returns the IDs among jobIDs saved by a user
+func (r *savedJobRepo) SavedJobIDs(ctx context.Context, userID string, jobIDs []string) (map[string]bool, error) {
+	saved := map[string]bool{}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return saved, nil
+	}
+
+	jobObjIDs := make([]primitive.ObjectID, 0, len(jobIDs))
+	for _, id := range jobIDs {
+		if objID, err := primitive.ObjectIDFromHex(id); err == nil {
+			jobObjIDs = append(jobObjIDs, objID)
+		}
+	}
+
+	opts := options.Find().SetProjection(bson.M{"job_id": 1})
+	cursor, err := r.data.db.Collection(CollectionSavedJob).Find(ctx,
+		bson.M{"user_id": userObjID, "job_id": bson.M{"$in": jobObjIDs}}, opts)
+	if err != nil {
+		r.log.Errorf("failed to find saved jobs: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []SavedJob
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	for _, doc := range docs {
+		saved[doc.JobID.Hex()] = true
+	}
+	return saved, nil
+}
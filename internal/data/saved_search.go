@@ -0,0 +1,282 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SavedSearch struct for MongoDB
+type SavedSearch struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	UserID           primitive.ObjectID `bson:"user_id"`
+	Name             string             `bson:"name"`
+	Filter           SavedSearchFilter  `bson:"filter"`
+	Frequency        string             `bson:"frequency"`
+	Channels         []string           `bson:"channels"`
+	Muted            bool               `bson:"muted"`
+	UnsubscribeToken string             `bson:"unsubscribe_token"`
+	LastNotifiedAt   *time.Time         `bson:"last_notified_at,omitempty"`
+	CreatedAt        time.Time          `bson:"created_at"`
+	UpdatedAt        time.Time          `bson:"updated_at"`
+}
+
+// SavedSearchFilter struct for MongoDB
+type SavedSearchFilter struct {
+	CompanyID string   `bson:"company_id,omitempty"`
+	Location  string   `bson:"location,omitempty"`
+	JobType   string   `bson:"job_type,omitempty"`
+	Level     string   `bson:"level,omitempty"`
+	Keyword   string   `bson:"keyword,omitempty"`
+	JobTech   []string `bson:"job_tech,omitempty"`
+}
+
+type savedSearchRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSavedSearchRepo creates a new saved search repository
+func NewSavedSearchRepo(data *Data, logger log.Logger) biz.SavedSearchRepo {
+	r := &savedSearchRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionSavedSearch).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}}},
+		{Keys: bson.D{{Key: "unsubscribe_token", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "muted", Value: 1}, {Key: "_id", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create saved search indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateSavedSearch creates a new saved search
+func (r *savedSearchRepo) CreateSavedSearch(ctx context.Context, search *biz.SavedSearch) (*biz.SavedSearch, error) {
+	userObjID, err := primitive.ObjectIDFromHex(search.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dbSearch := &SavedSearch{
+		UserID:           userObjID,
+		Name:             search.Name,
+		Filter:           toSavedSearchFilterDoc(search.Filter),
+		Frequency:        string(search.Frequency),
+		Channels:         toAlertChannelDocs(search.Channels),
+		Muted:            search.Muted,
+		UnsubscribeToken: search.UnsubscribeToken,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	result, err := r.data.db.Collection(CollectionSavedSearch).InsertOne(ctx, dbSearch)
+	if err != nil {
+		r.log.Errorf("failed to create saved search: %v", err)
+		return nil, err
+	}
+
+	dbSearch.ID = result.InsertedID.(primitive.ObjectID)
+	return r.toBiz(dbSearch), nil
+}
+
+// GetSavedSearch retrieves a saved search by ID
+func (r *savedSearchRepo) GetSavedSearch(ctx context.Context, id string) (*biz.SavedSearch, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil // Invalid ID, no such saved search
+	}
+	return r.findOne(ctx, bson.M{"_id": objID})
+}
+
+// GetSavedSearchByUnsubscribeToken retrieves the saved search of an unsubscribe link
+func (r *savedSearchRepo) GetSavedSearchByUnsubscribeToken(ctx context.Context, token string) (*biz.SavedSearch, error) {
+	return r.findOne(ctx, bson.M{"unsubscribe_token": token})
+}
+
+func (r *savedSearchRepo) findOne(ctx context.Context, query bson.M) (*biz.SavedSearch, error) {
+	var search SavedSearch
+	err := r.data.db.Collection(CollectionSavedSearch).FindOne(ctx, query).Decode(&search)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Saved search not found
+		}
+		r.log.Errorf("failed to get saved search: %v", err)
+		return nil, err
+	}
+	return r.toBiz(&search), nil
+}
+
+// ListSavedSearches lists the saved searches of a user, oldest first
+func (r *savedSearchRepo) ListSavedSearches(ctx context.Context, userID string) ([]*biz.SavedSearch, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	return r.find(ctx, bson.M{"user_id": userObjID}, opts)
+}
+
+// CountSavedSearches counts the saved searches of a user
+func (r *savedSearchRepo) CountSavedSearches(ctx context.Context, userID string) (int64, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.data.db.Collection(CollectionSavedSearch).CountDocuments(ctx, bson.M{"user_id": userObjID})
+	if err != nil {
+		r.log.Errorf("failed to count saved searches: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// UpdateSavedSearch updates the name, filter, frequency and channels of a saved search
+func (r *savedSearchRepo) UpdateSavedSearch(ctx context.Context, search *biz.SavedSearch) error {
+	return r.update(ctx, search.ID, bson.M{
+		"name":       search.Name,
+		"filter":     toSavedSearchFilterDoc(search.Filter),
+		"frequency":  string(search.Frequency),
+		"channels":   toAlertChannelDocs(search.Channels),
+		"updated_at": time.Now(),
+	})
+}
+
+// SetSavedSearchMuted mutes or unmutes a saved search
+func (r *savedSearchRepo) SetSavedSearchMuted(ctx context.Context, id string, muted bool) error {
+	return r.update(ctx, id, bson.M{"muted": muted, "updated_at": time.Now()})
+}
+
+// SetSavedSearchNotified records the time the last alert of a saved search was sent
+func (r *savedSearchRepo) SetSavedSearchNotified(ctx context.Context, id string, at time.Time) error {
+	return r.update(ctx, id, bson.M{"last_notified_at": at})
+}
+
+func (r *savedSearchRepo) update(ctx context.Context, id string, set bson.M) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.db.Collection(CollectionSavedSearch).UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": set})
+	if err != nil {
+		r.log.Errorf("failed to update saved search: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteSavedSearch deletes a saved search
+func (r *savedSearchRepo) DeleteSavedSearch(ctx context.Context, id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.db.Collection(CollectionSavedSearch).DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
+		r.log.Errorf("failed to delete saved search: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListActiveSavedSearches lists unmuted saved searches after afterID, ordered by ID
+func (r *savedSearchRepo) ListActiveSavedSearches(ctx context.Context, afterID string, limit int) ([]*biz.SavedSearch, error) {
+	query := bson.M{"muted": false}
+	if afterID != "" {
+		objID, err := primitive.ObjectIDFromHex(afterID)
+		if err != nil {
+			return nil, err
+		}
+		query["_id"] = bson.M{"$gt": objID}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(limit))
+	return r.find(ctx, query, opts)
+}
+
+func (r *savedSearchRepo) find(ctx context.Context, query bson.M, opts *options.FindOptions) ([]*biz.SavedSearch, error) {
+	cursor, err := r.data.db.Collection(CollectionSavedSearch).Find(ctx, query, opts)
+	if err != nil {
+		r.log.Errorf("failed to list saved searches: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var dbSearches []SavedSearch
+	if err := cursor.All(ctx, &dbSearches); err != nil {
+		return nil, err
+	}
+
+	searches := make([]*biz.SavedSearch, 0, len(dbSearches))
+	for i := range dbSearches {
+		searches = append(searches, r.toBiz(&dbSearches[i]))
+	}
+	return searches, nil
+}
+
+func toSavedSearchFilterDoc(f *biz.JobFilter) SavedSearchFilter {
+	if f == nil {
+		return SavedSearchFilter{}
+	}
+	return SavedSearchFilter{
+		CompanyID: f.CompanyID,
+		Location:  f.Location,
+		JobType:   string(f.JobType),
+		Level:     string(f.Level),
+		Keyword:   f.Keyword,
+		JobTech:   f.JobTech,
+	}
+}
+
+func toAlertChannelDocs(channels []biz.AlertChannel) []string {
+	docs := make([]string, 0, len(channels))
+	for _, c := range channels {
+		docs = append(docs, string(c))
+	}
+	return docs
+}
+
+// toBiz converts data layer SavedSearch to biz layer SavedSearch
+func (r *savedSearchRepo) toBiz(s *SavedSearch) *biz.SavedSearch {
+	channels := make([]biz.AlertChannel, 0, len(s.Channels))
+	for _, c := range s.Channels {
+		channels = append(channels, biz.AlertChannel(c))
+	}
+	return &biz.SavedSearch{
+		ID:     s.ID.Hex(),
+		UserID: s.UserID.Hex(),
+		Name:   s.Name,
+		Filter: &biz.JobFilter{
+			CompanyID: s.Filter.CompanyID,
+			Location:  s.Filter.Location,
+			JobType:   biz.JobType(s.Filter.JobType),
+			Level:     biz.Level(s.Filter.Level),
+			Keyword:   s.Filter.Keyword,
+			JobTech:   s.Filter.JobTech,
+		},
+		Frequency:        biz.AlertFrequency(s.Frequency),
+		Channels:         channels,
+		Muted:            s.Muted,
+		UnsubscribeToken: s.UnsubscribeToken,
+		LastNotifiedAt:   s.LastNotifiedAt,
+		CreatedAt:        s.CreatedAt,
+		UpdatedAt:        s.UpdatedAt,
+	}
+}
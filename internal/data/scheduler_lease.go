@@ -0,0 +1,132 @@
+package data
+
+import (
+	"JobblyBE/pkg/scheduler"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SchedulerLease struct for MongoDB, một document cho mỗi task
+type SchedulerLease struct {
+	Task        string        `bson:"_id"`
+	Owner       string        `bson:"owner"`
+	Slot        time.Time     `bson:"slot"` // slot đã được nhận gần nhất
+	LockedUntil time.Time     `bson:"locked_until"`
+	LastRun     *SchedulerRun `bson:"last_run,omitempty"`
+}
+
+// SchedulerRun is the outcome of the last run of a task
+type SchedulerRun struct {
+	Owner      string    `bson:"owner"`
+	Slot       time.Time `bson:"slot"`
+	StartedAt  time.Time `bson:"started_at"`
+	FinishedAt time.Time `bson:"finished_at"`
+	Processed  int       `bson:"processed"`
+	Error      string    `bson:"error,omitempty"`
+}
+
+type schedulerLeaseStore struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSchedulerLeaseStore creates the Mongo lease store shared by the scheduler of every replica
+func NewSchedulerLeaseStore(data *Data, logger log.Logger) scheduler.LeaseStore {
+	return &schedulerLeaseStore{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Acquire takes the lease if it is free and slot has not been run yet.
+// Document chưa tồn tại được tạo bằng upsert; khi replica khác đang giữ lease
+// filter không khớp, upsert bị trùng _id và trả về false.
+func (s *schedulerLeaseStore) Acquire(ctx context.Context, task, owner string, slot time.Time, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id":          task,
+		"slot":         bson.M{"$not": bson.M{"$gte": slot}},
+		"locked_until": bson.M{"$not": bson.M{"$gt": now}},
+	}
+	update := bson.M{"$set": bson.M{
+		"owner":        owner,
+		"slot":         slot,
+		"locked_until": now.Add(ttl),
+	}}
+
+	_, err := s.data.db.Collection(CollectionSchedulerLease).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		s.log.Errorf("failed to acquire scheduler lease: %v", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Release frees the lease held by owner and stores run
+func (s *schedulerLeaseStore) Release(ctx context.Context, task, owner string, run *scheduler.Run) error {
+	_, err := s.data.db.Collection(CollectionSchedulerLease).UpdateOne(ctx,
+		bson.M{"_id": task, "owner": owner},
+		bson.M{"$set": bson.M{
+			"locked_until": run.FinishedAt,
+			"last_run": &SchedulerRun{
+				Owner:      run.Owner,
+				Slot:       run.Slot,
+				StartedAt:  run.StartedAt,
+				FinishedAt: run.FinishedAt,
+				Processed:  run.Processed,
+				Error:      run.Error,
+			},
+		}},
+	)
+	if err != nil {
+		s.log.Errorf("failed to release scheduler lease: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Leases returns the lease of every task
+func (s *schedulerLeaseStore) Leases(ctx context.Context) (map[string]*scheduler.Lease, error) {
+	cursor, err := s.data.db.Collection(CollectionSchedulerLease).Find(ctx, bson.M{})
+	if err != nil {
+		s.log.Errorf("failed to list scheduler leases: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []SchedulerLease
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	leases := make(map[string]*scheduler.Lease, len(docs))
+	for _, doc := range docs {
+		lease := &scheduler.Lease{
+			Owner:       doc.Owner,
+			LockedUntil: doc.LockedUntil,
+		}
+		if doc.LastRun != nil {
+			lease.LastRun = &scheduler.Run{
+				Owner:      doc.LastRun.Owner,
+				Slot:       doc.LastRun.Slot,
+				StartedAt:  doc.LastRun.StartedAt,
+				FinishedAt: doc.LastRun.FinishedAt,
+				Processed:  doc.LastRun.Processed,
+				Error:      doc.LastRun.Error,
+			}
+		}
+		leases[doc.Task] = lease
+	}
+
+	return leases, nil
+}
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SecurityEvent struct for MongoDB
+type SecurityEvent struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"user_id"`
+	SessionID string             `bson:"session_id,omitempty"`
+	Type      string             `bson:"type"`
+	Detail    string             `bson:"detail"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type securityEventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSecurityEventRepo creates a new security event repository
+func NewSecurityEventRepo(data *Data, logger log.Logger) biz.SecurityEventRepo {
+	return &securityEventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateSecurityEvent stores a security event
+func (r *securityEventRepo) CreateSecurityEvent(ctx context.Context, event *biz.SecurityEvent) error {
+	userObjID, err := primitive.ObjectIDFromHex(event.UserID)
+	if err != nil {
+		return err
+	}
+
+	dbEvent := &SecurityEvent{
+		UserID:    userObjID,
+		SessionID: event.SessionID,
+		Type:      event.Type,
+		Detail:    event.Detail,
+		CreatedAt: time.Now(),
+	}
+
+	if _, err := r.data.db.Collection(CollectionSecurityEvent).InsertOne(ctx, dbEvent); err != nil {
+		r.log.Errorf("failed to create security event: %v", err)
+		return err
+	}
+
+	return nil
+}
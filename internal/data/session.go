@@ -0,0 +1,255 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Session struct for MongoDB (one refresh-token family)
+type Session struct {
+	ID                    primitive.ObjectID `bson:"_id,omitempty"`
+	UserID                primitive.ObjectID `bson:"user_id"`
+	UserAgent             string             `bson:"user_agent"`
+	IPAddress             string             `bson:"ip_address"`
+	CurrentRefreshTokenID string             `bson:"current_refresh_token_id"`
+	AccessTokenID         string             `bson:"access_token_id"`
+	AccessExpiresAt       time.Time          `bson:"access_expires_at"`
+	Revoked               bool               `bson:"revoked"`
+	RevokedAt             *time.Time         `bson:"revoked_at,omitempty"`
+	RevokeReason          string             `bson:"revoke_reason,omitempty"`
+	ExpiresAt             time.Time          `bson:"expires_at"`
+	LastSeenAt            time.Time          `bson:"last_seen_at"`
+	CreatedAt             time.Time          `bson:"created_at"`
+	UpdatedAt             time.Time          `bson:"updated_at"`
+}
+
+type sessionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSessionRepo creates a new session repository
+func NewSessionRepo(data *Data, logger log.Logger) biz.SessionRepo {
+	r := &sessionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionSession).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+		// Sessions disappear once their last refresh token has expired
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create session indexes: %v", err)
+	}
+
+	return r
+}
+
+// CreateSession creates a new session
+func (r *sessionRepo) CreateSession(ctx context.Context, session *biz.Session) (*biz.Session, error) {
+	userObjID, err := primitive.ObjectIDFromHex(session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dbSession := &Session{
+		UserID:     userObjID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		ExpiresAt:  session.ExpiresAt,
+		LastSeenAt: now,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	result, err := r.data.db.Collection(CollectionSession).InsertOne(ctx, dbSession)
+	if err != nil {
+		r.log.Errorf("failed to create session: %v", err)
+		return nil, err
+	}
+
+	dbSession.ID = result.InsertedID.(primitive.ObjectID)
+	return r.toBiz(dbSession), nil
+}
+
+// GetSession retrieves a session by ID
+func (r *sessionRepo) GetSession(ctx context.Context, id string) (*biz.Session, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil // Invalid ID, no such session
+	}
+
+	var session Session
+	err = r.data.db.Collection(CollectionSession).FindOne(ctx, bson.M{"_id": objID}).Decode(&session)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Session not found
+		}
+		r.log.Errorf("failed to get session: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&session), nil
+}
+
+// ListActiveSessions lists the sessions of a user that are not revoked and not expired
+func (r *sessionRepo) ListActiveSessions(ctx context.Context, userID string) ([]*biz.Session, error) {
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"user_id":    userObjID,
+		"revoked":    false,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "last_seen_at", Value: -1}})
+
+	cursor, err := r.data.db.Collection(CollectionSession).Find(ctx, filter, opts)
+	if err != nil {
+		r.log.Errorf("failed to list sessions: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var sessions []*biz.Session
+	for cursor.Next(ctx) {
+		var session Session
+		if err := cursor.Decode(&session); err != nil {
+			r.log.Errorf("failed to decode session: %v", err)
+			continue
+		}
+		sessions = append(sessions, r.toBiz(&session))
+	}
+
+	return sessions, nil
+}
+
+// SetTokens records the token pair issued when the session is created
+func (r *sessionRepo) SetTokens(ctx context.Context, id string, tokens *biz.IssuedTokens) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.db.Collection(CollectionSession).UpdateOne(
+		ctx,
+		bson.M{"_id": objID},
+		bson.M{"$set": r.tokenFields(tokens)},
+	)
+	if err != nil {
+		r.log.Errorf("failed to set session tokens: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// RotateTokens replaces the current refresh token only if it still matches
+// and returns the session as it was before the rotation
+func (r *sessionRepo) RotateTokens(ctx context.Context, id, currentRefreshTokenID string, tokens *biz.IssuedTokens) (*biz.Session, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous Session
+	err = r.data.db.Collection(CollectionSession).FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"_id":                      objID,
+			"current_refresh_token_id": currentRefreshTokenID,
+			"revoked":                  false,
+		},
+		bson.M{"$set": r.tokenFields(tokens)},
+		options.FindOneAndUpdate().SetReturnDocument(options.Before),
+	).Decode(&previous)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Token đã được rotate hoặc session đã bị thu hồi
+		}
+		r.log.Errorf("failed to rotate session tokens: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&previous), nil
+}
+
+// RevokeSession marks a session as revoked and returns it
+func (r *sessionRepo) RevokeSession(ctx context.Context, id, reason string) (*biz.Session, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var session Session
+	err = r.data.db.Collection(CollectionSession).FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": objID},
+		bson.M{
+			"$set": bson.M{
+				"revoked":       true,
+				"revoked_at":    now,
+				"revoke_reason": reason,
+				"updated_at":    now,
+			},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&session)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // Session not found
+		}
+		r.log.Errorf("failed to revoke session: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&session), nil
+}
+
+// tokenFields builds the $set document for a newly issued token pair
+func (r *sessionRepo) tokenFields(tokens *biz.IssuedTokens) bson.M {
+	now := time.Now()
+	return bson.M{
+		"current_refresh_token_id": tokens.RefreshTokenID,
+		"access_token_id":          tokens.AccessTokenID,
+		"access_expires_at":        tokens.AccessExpiresAt,
+		"expires_at":               tokens.RefreshExpiresAt,
+		"last_seen_at":             now,
+		"updated_at":               now,
+	}
+}
+
+// toBiz converts data layer Session to biz layer Session
+func (r *sessionRepo) toBiz(s *Session) *biz.Session {
+	return &biz.Session{
+		ID:                    s.ID.Hex(),
+		UserID:                s.UserID.Hex(),
+		UserAgent:             s.UserAgent,
+		IPAddress:             s.IPAddress,
+		CurrentRefreshTokenID: s.CurrentRefreshTokenID,
+		AccessTokenID:         s.AccessTokenID,
+		AccessExpiresAt:       s.AccessExpiresAt,
+		Revoked:               s.Revoked,
+		RevokedAt:             s.RevokedAt,
+		RevokeReason:          s.RevokeReason,
+		ExpiresAt:             s.ExpiresAt,
+		LastSeenAt:            s.LastSeenAt,
+		CreatedAt:             s.CreatedAt,
+		UpdatedAt:             s.UpdatedAt,
+	}
+}
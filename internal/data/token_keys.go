@@ -0,0 +1,59 @@
+package data
+
+import (
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/configx"
+	"JobblyBE/pkg/middleware/auth"
+	"errors"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewTokenKeySet loads the JWT signing and verification keys configured in conf.Server.
+// Without a signing key, tokens are signed with the legacy HS256 jwt_secret.
+func NewTokenKeySet(c *conf.Server, logger log.Logger) (*auth.KeySet, error) {
+	helper := log.NewHelper(logger)
+	secret := configx.GetEnvOrString("JWT_SECRET", c.JwtSecret)
+	signingKey := c.GetJwt().GetSigningKey()
+	signingFile := configx.GetEnvOrString("JWT_SIGNING_KEY_FILE", signingKey.GetFile())
+
+	if signingFile == "" {
+		if secret == "" {
+			return nil, errors.New("neither a JWT signing key nor a JWT secret is configured")
+		}
+		helper.Warn("no JWT signing key configured, signing tokens with HS256 jwt_secret")
+		return auth.NewHMACKeySet(secret), nil
+	}
+
+	keys := auth.NewKeySet()
+	signer, err := auth.LoadPrivateKeyFile(signingFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWT signing key: %w", err)
+	}
+	if err := keys.SetSigningKey(configx.GetEnvOrString("JWT_SIGNING_KEY_ID", signingKey.GetId()), signer); err != nil {
+		return nil, fmt.Errorf("invalid JWT signing key: %w", err)
+	}
+
+	for _, k := range c.GetJwt().GetVerificationKeys() {
+		publicKey, err := auth.LoadPublicKeyFile(k.GetFile())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWT verification key: %w", err)
+		}
+		if err := keys.AddVerificationKey(k.GetId(), publicKey); err != nil {
+			return nil, fmt.Errorf("invalid JWT verification key %s: %w", k.GetFile(), err)
+		}
+	}
+
+	// Token HS256 phát hành trước khi chuyển sang signing key vẫn hợp lệ cho đến khi
+	// jwt_secret bị gỡ khỏi config
+	if secret != "" {
+		if err := keys.SetLegacySecret(secret); err != nil {
+			return nil, err
+		}
+		helper.Warn("jwt_secret is still configured, legacy HS256 tokens are accepted")
+	}
+
+	helper.Infof("JWT signing key id: %s (%d verification keys)", keys.SigningKeyID(), len(keys.JWKS().Keys))
+	return keys, nil
+}
@@ -0,0 +1,175 @@
+package data
+
+import (
+	"JobblyBE/pkg/middleware/auth"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RevokedToken struct for MongoDB
+type RevokedToken struct {
+	JTI       string    `bson:"_id"`
+	ExpiresAt time.Time `bson:"expires_at"`
+	RevokedAt time.Time `bson:"revoked_at"`
+}
+
+// sessionCacheTTL là thời gian nhớ một session chưa bị thu hồi.
+// Session đã thu hồi không thể mở lại nên được nhớ đến khi mọi token của nó đã hết hạn
+// (auth.RefreshTokenDuration), sau đó query lại nếu còn được hỏi.
+const sessionCacheTTL = 30 * time.Second
+
+// sessionCacheMaxSize là số session tối đa trong cache
+const sessionCacheMaxSize = 10000
+
+type sessionCacheEntry struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+type tokenRevocationRepo struct {
+	data *Data
+	log  *log.Helper
+
+	mu       sync.Mutex
+	sessions map[string]sessionCacheEntry
+}
+
+// NewTokenRevocationRepo creates a MongoDB backed token revocation store
+func NewTokenRevocationRepo(data *Data, logger log.Logger) auth.RevocationStore {
+	r := &tokenRevocationRepo{
+		data:     data,
+		log:      log.NewHelper(logger),
+		sessions: make(map[string]sessionCacheEntry),
+	}
+
+	// TTL index: MongoDB removes the entry once the token would have expired anyway
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionRevokedToken).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	if err != nil {
+		r.log.Errorf("failed to create revoked token ttl index: %v", err)
+	}
+
+	return r
+}
+
+// Revoke marks a token ID as revoked until expiresAt
+func (r *tokenRevocationRepo) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" {
+		return nil
+	}
+
+	_, err := r.data.db.Collection(CollectionRevokedToken).UpdateOne(
+		ctx,
+		bson.M{"_id": jti},
+		bson.M{
+			"$set": bson.M{
+				"expires_at": expiresAt,
+			},
+			"$setOnInsert": bson.M{
+				"revoked_at": time.Now(),
+			},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		r.log.Errorf("failed to revoke token: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// IsRevoked reports whether a token ID has been revoked
+func (r *tokenRevocationRepo) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	if jti == "" {
+		return false, nil
+	}
+
+	// The TTL monitor runs periodically, so also filter on expires_at
+	count, err := r.data.db.Collection(CollectionRevokedToken).CountDocuments(
+		ctx,
+		bson.M{
+			"_id":        jti,
+			"expires_at": bson.M{"$gt": time.Now()},
+		},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		r.log.Errorf("failed to check revoked token: %v", err)
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// IsSessionRevoked reports whether the session of an access token is revoked or no longer exists.
+// Kết quả được cache sessionCacheTTL để không query mỗi request.
+func (r *tokenRevocationRepo) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	now := time.Now()
+	r.mu.Lock()
+	entry, ok := r.sessions[sessionID]
+	r.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.revoked, nil
+	}
+
+	objID, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return true, nil // sid không hợp lệ
+	}
+
+	var session struct {
+		Revoked bool `bson:"revoked"`
+	}
+	err = r.data.db.Collection(CollectionSession).FindOne(ctx, bson.M{"_id": objID},
+		options.FindOne().SetProjection(bson.M{"revoked": 1})).Decode(&session)
+	revoked := session.Revoked
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			r.log.Errorf("failed to check session revocation: %v", err)
+			return false, err
+		}
+		revoked = true // Session đã bị xóa (hết hạn hoặc user bị xóa)
+	}
+
+	ttl := sessionCacheTTL
+	if revoked {
+		ttl = auth.RefreshTokenDuration
+	}
+	r.cacheSession(sessionID, sessionCacheEntry{revoked: revoked, expiresAt: now.Add(ttl)}, now)
+
+	return revoked, nil
+}
+
+// cacheSession stores entry, giữ cache không vượt quá sessionCacheMaxSize. Khi đầy thì bỏ
+// các entry đã hết hạn, nếu vẫn còn trên 90% thì bỏ entry bất kỳ (lần sau sẽ query lại)
+func (r *tokenRevocationRepo) cacheSession(sessionID string, entry sessionCacheEntry, now time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[sessionID]; !ok && len(r.sessions) >= sessionCacheMaxSize {
+		for id, e := range r.sessions {
+			if !now.Before(e.expiresAt) {
+				delete(r.sessions, id)
+			}
+		}
+		for id := range r.sessions {
+			if len(r.sessions) < sessionCacheMaxSize-sessionCacheMaxSize/10 {
+				break
+			}
+			delete(r.sessions, id)
+		}
+	}
+	r.sessions[sessionID] = entry
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCacheSessionBounded(t *testing.T) {
+	r := &tokenRevocationRepo{sessions: make(map[string]sessionCacheEntry)}
+	now := time.Now()
+
+	// Session đã thu hồi chiếm đầy cache
+	for i := 0; i < 3*sessionCacheMaxSize; i++ {
+		r.cacheSession(fmt.Sprintf("revoked-%d", i), sessionCacheEntry{revoked: true, expiresAt: now.Add(time.Hour)}, now)
+		if len(r.sessions) > sessionCacheMaxSize {
+			t.Fatalf("cache size = %d after %d inserts, want <= %d", len(r.sessions), i+1, sessionCacheMaxSize)
+		}
+	}
+	if _, ok := r.sessions[fmt.Sprintf("revoked-%d", 3*sessionCacheMaxSize-1)]; !ok {
+		t.Error("latest entry was evicted")
+	}
+}
+
+func TestCacheSessionEvictsExpired(t *testing.T) {
+	r := &tokenRevocationRepo{sessions: make(map[string]sessionCacheEntry)}
+	now := time.Now()
+
+	for i := 0; i < sessionCacheMaxSize; i++ {
+		r.cacheSession(fmt.Sprintf("expired-%d", i), sessionCacheEntry{revoked: true, expiresAt: now.Add(time.Minute)}, now)
+	}
+	later := now.Add(2 * time.Minute)
+	r.cacheSession("fresh", sessionCacheEntry{expiresAt: later.Add(sessionCacheTTL)}, later)
+
+	if len(r.sessions) != 1 {
+		t.Errorf("cache size = %d, want only the fresh entry", len(r.sessions))
+	}
+}
@@ -0,0 +1,182 @@
+package data
+
+import (
+	"JobblyBE/internal/biz"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UserTOTP struct for MongoDB, one document per user
+type UserTOTP struct {
+	UserID             primitive.ObjectID `bson:"_id"`
+	Secret             string             `bson:"secret"`
+	Enabled            bool               `bson:"enabled"`
+	RecoveryCodeHashes []string           `bson:"recovery_code_hashes"`
+	LastUsedStep       int64              `bson:"last_used_step"`
+	ConfirmedAt        *time.Time         `bson:"confirmed_at,omitempty"`
+	CreatedAt          time.Time          `bson:"created_at"`
+	UpdatedAt          time.Time          `bson:"updated_at"`
+}
+
+type totpRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTOTPRepo creates a new TOTP repository
+func NewTOTPRepo(data *Data, logger log.Logger) biz.TOTPRepo {
+	return &totpRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetUserTOTP retrieves the TOTP settings of a user
+func (r *totpRepo) GetUserTOTP(ctx context.Context, userID string) (*biz.UserTOTP, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var userTOTP UserTOTP
+	err = r.data.db.Collection(CollectionUserTOTP).FindOne(ctx, bson.M{"_id": objID}).Decode(&userTOTP)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil // TOTP not enrolled
+		}
+		r.log.Errorf("failed to get user totp: %v", err)
+		return nil, err
+	}
+
+	return r.toBiz(&userTOTP), nil
+}
+
+// SavePendingSecret stores a new, not yet enabled secret. Enabled settings are never overwritten.
+func (r *totpRepo) SavePendingSecret(ctx context.Context, userID, secret string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = r.data.db.Collection(CollectionUserTOTP).UpdateOne(
+		ctx,
+		bson.M{"_id": objID, "enabled": bson.M{"$ne": true}},
+		bson.M{
+			"$set": bson.M{
+				"secret":               secret,
+				"enabled":              false,
+				"recovery_code_hashes": []string{},
+				"last_used_step":       0,
+				"updated_at":           now,
+			},
+			"$setOnInsert": bson.M{
+				"created_at": now,
+			},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		r.log.Errorf("failed to save totp secret: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Enable turns on TOTP with the given recovery code hashes
+func (r *totpRepo) Enable(ctx context.Context, userID string, recoveryCodeHashes []string, lastUsedStep int64) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = r.data.db.Collection(CollectionUserTOTP).UpdateOne(
+		ctx,
+		bson.M{"_id": objID},
+		bson.M{
+			"$set": bson.M{
+				"enabled":              true,
+				"recovery_code_hashes": recoveryCodeHashes,
+				"last_used_step":       lastUsedStep,
+				"confirmed_at":         now,
+				"updated_at":           now,
+			},
+		},
+	)
+	if err != nil {
+		r.log.Errorf("failed to enable totp: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// UseStep records step as used if it is newer than the last used one
+func (r *totpRepo) UseStep(ctx context.Context, userID string, step int64) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.data.db.Collection(CollectionUserTOTP).UpdateOne(
+		ctx,
+		bson.M{"_id": objID, "last_used_step": bson.M{"$lt": step}},
+		bson.M{
+			"$set": bson.M{
+				"last_used_step": step,
+				"updated_at":     time.Now(),
+			},
+		},
+	)
+	if err != nil {
+		r.log.Errorf("failed to record totp step: %v", err)
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+// UseRecoveryCode removes a recovery code hash, reporting whether it existed
+func (r *totpRepo) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.data.db.Collection(CollectionUserTOTP).UpdateOne(
+		ctx,
+		bson.M{"_id": objID, "recovery_code_hashes": codeHash},
+		bson.M{
+			"$pull": bson.M{"recovery_code_hashes": codeHash},
+			"$set":  bson.M{"updated_at": time.Now()},
+		},
+	)
+	if err != nil {
+		r.log.Errorf("failed to use recovery code: %v", err)
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// toBiz converts data layer UserTOTP to biz layer UserTOTP
+func (r *totpRepo) toBiz(t *UserTOTP) *biz.UserTOTP {
+	return &biz.UserTOTP{
+		UserID:             t.UserID.Hex(),
+		Secret:             t.Secret,
+		Enabled:            t.Enabled,
+		RecoveryCodeHashes: t.RecoveryCodeHashes,
+		LastUsedStep:       t.LastUsedStep,
+		ConfirmedAt:        t.ConfirmedAt,
+		CreatedAt:          t.CreatedAt,
+		UpdatedAt:          t.UpdatedAt,
+	}
+}
@@ -3,12 +3,14 @@ package data
 import (
 	"JobblyBE/internal/biz"
 	"context"
+	"regexp"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // User struct for MongoDB
@@ -20,10 +22,17 @@ type User struct {
 	PhoneNumber string             `bson:"phone_number"`
 	Role        string             `bson:"role"`
 	Active      bool               `bson:"active"`
-	Resume      []Resume           `bson:"resume"`
-	LastLogin   *time.Time         `bson:"last_login,omitempty"`
-	CreatedAt   time.Time          `bson:"created_at"`
-	UpdatedAt   time.Time          `bson:"updated_at"`
+	// nil với tài khoản tạo trước khi có xác thực email, coi như đã xác thực
+	EmailVerified            *bool      `bson:"email_verified,omitempty"`
+	EmailVerificationTokenID string     `bson:"email_verification_token_id,omitempty"`
+	PasswordResetAt          *time.Time `bson:"password_reset_at,omitempty"`
+	Resume                   []Resume   `bson:"resume"`
+	LastLogin                *time.Time `bson:"last_login,omitempty"`
+	// Xóa mềm: user bị deactivate và purge hẳn sau PurgeAt
+	DeletedAt *time.Time `bson:"deleted_at,omitempty"`
+	PurgeAt   *time.Time `bson:"purge_at,omitempty"`
+	CreatedAt time.Time  `bson:"created_at"`
+	UpdatedAt time.Time  `bson:"updated_at"`
 }
 
 type userRepo struct {
@@ -43,15 +52,16 @@ func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
 func (r *userRepo) CreateUser(ctx context.Context, user *biz.User) (*biz.User, error) {
 	now := time.Now()
 	dbUser := &User{
-		FullName:    user.FullName,
-		Email:       user.Email,
-		Password:    user.Password,
-		PhoneNumber: user.PhoneNumber,
-		Role:        string(user.Role),
-		Active:      user.Active,
-		Resume:      []Resume{},
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		FullName:      user.FullName,
+		Email:         user.Email,
+		Password:      user.Password,
+		PhoneNumber:   user.PhoneNumber,
+		Role:          string(user.Role),
+		Active:        user.Active,
+		EmailVerified: &user.EmailVerified,
+		Resume:        []Resume{},
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}
 
 	result, err := r.data.db.Collection(CollectionUser).InsertOne(ctx, dbUser)
@@ -155,18 +165,262 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User) error {
 	return nil
 }
 
+// SetEmailVerificationToken stores the ID of the latest email verification token
+func (r *userRepo) SetEmailVerificationToken(ctx context.Context, userID, tokenID string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.db.Collection(CollectionUser).UpdateOne(
+		ctx,
+		bson.M{"_id": objID},
+		bson.M{
+			"$set": bson.M{
+				"email_verification_token_id": tokenID,
+				"updated_at":                  time.Now(),
+			},
+		},
+	)
+	if err != nil {
+		r.log.Errorf("failed to set email verification token: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// MarkEmailVerified marks the email verified and consumes the token if tokenID is still the latest one
+func (r *userRepo) MarkEmailVerified(ctx context.Context, userID, tokenID string) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.data.db.Collection(CollectionUser).UpdateOne(
+		ctx,
+		bson.M{
+			"_id":                         objID,
+			"email_verification_token_id": tokenID,
+		},
+		bson.M{
+			"$set": bson.M{
+				"email_verified": true,
+				"updated_at":     time.Now(),
+			},
+			"$unset": bson.M{
+				"email_verification_token_id": "",
+			},
+		},
+	)
+	if err != nil {
+		r.log.Errorf("failed to mark email verified: %v", err)
+		return false, err
+	}
+
+	return result.MatchedCount == 1, nil
+}
+
+// ResetPassword sets a new hashed password and records when it was reset
+func (r *userRepo) ResetPassword(ctx context.Context, userID, hashedPassword string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = r.data.db.Collection(CollectionUser).UpdateOne(
+		ctx,
+		bson.M{"_id": objID},
+		bson.M{
+			"$set": bson.M{
+				"password":          hashedPassword,
+				"password_reset_at": now,
+				"updated_at":        now,
+			},
+		},
+	)
+	if err != nil {
+		r.log.Errorf("failed to reset password: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListUsers lists users matching filter, newest first
+func (r *userRepo) ListUsers(ctx context.Context, filter *biz.UserFilter, page, pageSize int32) ([]*biz.User, int32, error) {
+	// Build filter query
+	query := bson.M{}
+
+	if filter != nil {
+		if filter.Keyword != "" {
+			keyword := regexp.QuoteMeta(filter.Keyword)
+			query["$or"] = []bson.M{
+				{"full_name": bson.M{"$regex": keyword, "$options": "i"}},
+				{"email": bson.M{"$regex": keyword, "$options": "i"}},
+			}
+		}
+		if filter.Role != "" {
+			query["role"] = string(filter.Role)
+		}
+		if filter.Active != nil {
+			query["active"] = *filter.Active
+		}
+	}
+
+	// Count total
+	total, err := r.data.db.Collection(CollectionUser).CountDocuments(ctx, query)
+	if err != nil {
+		r.log.Errorf("failed to count users: %v", err)
+		return nil, 0, err
+	}
+
+	// Find with pagination, không lấy resume nhúng trong user
+	opts := options.Find().
+		SetSort(bson.M{"created_at": -1}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize)).
+		SetProjection(bson.M{"resume": 0})
+
+	cursor, err := r.data.db.Collection(CollectionUser).Find(ctx, query, opts)
+	if err != nil {
+		r.log.Errorf("failed to list users: %v", err)
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []*biz.User
+	for cursor.Next(ctx) {
+		var user User
+		if err := cursor.Decode(&user); err != nil {
+			continue
+		}
+		users = append(users, r.toBiz(&user))
+	}
+
+	return users, int32(total), nil
+}
+
+// SetUserActive activates or deactivates a user
+func (r *userRepo) SetUserActive(ctx context.Context, userID string, active bool) error {
+	if !active {
+		return r.setFields(ctx, userID, bson.M{"active": false})
+	}
+
+	// Kích hoạt lại cũng hủy yêu cầu xóa tài khoản đang chờ purge
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+	update := bson.M{
+		"$set":   bson.M{"active": true, "updated_at": time.Now()},
+		"$unset": bson.M{"deleted_at": "", "purge_at": ""},
+	}
+	if _, err := r.data.db.Collection(CollectionUser).UpdateOne(ctx, bson.M{"_id": objID}, update); err != nil {
+		r.log.Errorf("failed to update user: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetUserRole changes the role of a user
+func (r *userRepo) SetUserRole(ctx context.Context, userID string, role biz.Role) error {
+	return r.setFields(ctx, userID, bson.M{"role": string(role)})
+}
+
+// ChangeUserRole sets the role of a user whose current role is from, without touching other fields
+func (r *userRepo) ChangeUserRole(ctx context.Context, userID string, from, to biz.Role) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.data.db.Collection(CollectionUser).UpdateOne(ctx,
+		bson.M{"_id": objID, "role": string(from)},
+		bson.M{"$set": bson.M{"role": string(to), "updated_at": time.Now()}},
+	)
+	if err != nil {
+		r.log.Errorf("failed to change user role: %v", err)
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+// MarkUserDeleted deactivates a user and schedules its purge
+func (r *userRepo) MarkUserDeleted(ctx context.Context, userID string, deletedAt, purgeAt time.Time) error {
+	return r.setFields(ctx, userID, bson.M{
+		"active":     false,
+		"deleted_at": deletedAt,
+		"purge_at":   purgeAt,
+	})
+}
+
+// ListUsersToPurge returns the IDs of soft deleted users whose purge time is before now
+func (r *userRepo) ListUsersToPurge(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	opts := options.Find().
+		SetSort(bson.M{"purge_at": 1}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"_id": 1})
+
+	cursor, err := r.data.db.Collection(CollectionUser).Find(ctx, bson.M{"purge_at": bson.M{"$lte": now}}, opts)
+	if err != nil {
+		r.log.Errorf("failed to list users to purge: %v", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var userIDs []string
+	for cursor.Next(ctx) {
+		var user User
+		if err := cursor.Decode(&user); err != nil {
+			continue
+		}
+		userIDs = append(userIDs, user.ID.Hex())
+	}
+
+	return userIDs, nil
+}
+
+// setFields sets fields of a user and bumps updated_at
+func (r *userRepo) setFields(ctx context.Context, userID string, fields bson.M) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return err
+	}
+
+	fields["updated_at"] = time.Now()
+	if _, err := r.data.db.Collection(CollectionUser).UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": fields}); err != nil {
+		r.log.Errorf("failed to update user: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// IsEmailVerified reports whether the user's email is verified.
+// Accounts created before email verification existed have no field and count as verified.
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerified == nil || *u.EmailVerified
+}
+
 // toBiz converts data layer User to biz layer User
 func (r *userRepo) toBiz(u *User) *biz.User {
 	return &biz.User{
-		UserID:      u.ID.Hex(),
-		FullName:    u.FullName,
-		Email:       u.Email,
-		Password:    u.Password,
-		PhoneNumber: u.PhoneNumber,
-		Role:        biz.Role(u.Role),
-		Active:      u.Active,
-		LastLogin:   u.LastLogin,
-		CreatedAt:   u.CreatedAt,
-		UpdatedAt:   u.UpdatedAt,
+		UserID:          u.ID.Hex(),
+		FullName:        u.FullName,
+		Email:           u.Email,
+		Password:        u.Password,
+		PhoneNumber:     u.PhoneNumber,
+		Role:            biz.Role(u.Role),
+		Active:          u.Active,
+		EmailVerified:   u.IsEmailVerified(),
+		PasswordResetAt: u.PasswordResetAt,
+		LastLogin:       u.LastLogin,
+		DeletedAt:       u.DeletedAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
 	}
 }
@@ -4,7 +4,9 @@ import (
 	"JobblyBE/internal/biz"
 	"context"
 	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"time"
 )
 
@@ -32,16 +34,29 @@ type userTrackingRepo struct {
 }
 
 func NewUserTrackingRepo(data *Data, logger log.Logger) biz.UserTrackingRepo {
-	return &userTrackingRepo{
+	r := &userTrackingRepo{
 		data: data,
 		log:  log.NewHelper(logger),
 	}
+
+	// Index theo user để export / purge dữ liệu của một user, theo created_at để xóa dữ liệu cũ
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.data.db.Collection(CollectionUserTracking).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "created_at", Value: 1}}},
+	})
+	if err != nil {
+		r.log.Errorf("failed to create user tracking indexes: %v", err)
+	}
+
+	return r
 }
 
 func (r *userTrackingRepo) CreateUserTracking(ctx context.Context, userTracking *biz.UserTracking) (*biz.UserTracking, error) {
 	now := time.Now()
 	ut := &UserTracking{
-		UserID:       primitive.ObjectID{},
+		UserID:       userTracking.UserID,
 		TrackingType: userTracking.TrackingType,
 		Metadata:     userTracking.Metadata,
 		CreatedAt:    now,
@@ -54,3 +69,13 @@ func (r *userTrackingRepo) CreateUserTracking(ctx context.Context, userTracking
 	ut.ID = result.InsertedID.(primitive.ObjectID)
 	return r.toBiz(ut), nil
 }
+
+// DeleteUserTrackingBefore deletes the tracking created before t
+func (r *userTrackingRepo) DeleteUserTrackingBefore(ctx context.Context, t time.Time) (int64, error) {
+	result, err := r.data.db.Collection(CollectionUserTracking).DeleteMany(ctx, bson.M{"created_at": bson.M{"$lt": t}})
+	if err != nil {
+		r.log.Errorf("failed to delete user tracking: %v", err)
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
@@ -4,6 +4,7 @@ import (
 	authv1 "JobblyBE/api/auth/v1"
 	"JobblyBE/internal/conf"
 	"JobblyBE/internal/service"
+	"JobblyBE/pkg/middleware/auth"
 	"JobblyBE/pkg/middleware/logging"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -12,11 +13,18 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, authSvc *service.AuthService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, authSvc *service.AuthService, keys *auth.KeySet, revocationStore auth.RevocationStore, apiKeys auth.APIKeyValidator, policies auth.PolicyTable, logger log.Logger) (*grpc.Server, error) {
+	proxies, err := parseTrustedProxies(c.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			logging.Server(logger),
+			auth.LogImpersonation(keys, logger),
+			auth.Authorize(keys, revocationStore, apiKeys, policies),
+			requestInfo(proxies),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -30,5 +38,10 @@ func NewGRPCServer(c *conf.Server, authSvc *service.AuthService, logger log.Logg
 	}
 	srv := grpc.NewServer(opts...)
 	authv1.RegisterAuthServer(srv, authSvc)
-	return srv
+
+	// Fail fast nếu có operation được đăng ký mà chưa khai báo policy
+	if err := policies.Check(grpcOperations(srv)...); err != nil {
+		return nil, err
+	}
+	return srv, nil
 }
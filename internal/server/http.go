@@ -1,6 +1,7 @@
 package server
 
 import (
+	adminv1 "JobblyBE/api/admin/v1"
 	authv1 "JobblyBE/api/auth/v1"
 	jobv1 "JobblyBE/api/job/v1"
 	resumev1 "JobblyBE/api/resume/v1"
@@ -15,7 +16,6 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
-	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
@@ -27,25 +27,31 @@ func NewHTTPServer(
 	jobSvc *service.JobPostingService,
 	companySvc *service.CompanyService,
 	resumeSvc *service.ResumeService,
+	applicationSvc *service.ApplicationService,
+	pipelineSvc *service.HiringPipelineService,
+	jobAlertSvc *service.JobAlertService,
+	notificationSvc *service.NotificationService,
+	userAdminSvc *service.UserAdminService,
+	auditLogSvc *service.AuditLogService,
+	schedulerSvc *service.SchedulerService,
+	keys *auth.KeySet,
+	revocationStore auth.RevocationStore,
+	apiKeys auth.APIKeyValidator,
+	policies auth.PolicyTable,
 	logger log.Logger,
 ) *http.Server {
-	// JWT secret from config
-	jwtSecret := configx.GetEnvOrString("JWT_SECRET", c.JwtSecret)
-
-	if jwtSecret == "" {
-		log.Fatal("JWT secret is not configured")
+	proxies, err := parseTrustedProxies(c.TrustedProxies)
+	if err != nil {
+		panic(err)
 	}
-
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			logging.Server(logger),
-			// Layer 1: Always parse JWT if present (for all endpoints)
-			auth.OptionalJWTAuth(jwtSecret),
-			// Layer 2: Require valid JWT (only for protected endpoints)
-			selector.Server(
-				auth.JWTAuth(jwtSecret),
-			).Match(NewWhiteListMatcher()).Build(),
+			auth.LogImpersonation(keys, logger),
+			// Policy (public / authenticated / roles / API key scopes) khai báo trên từng RPC
+			auth.Authorize(keys, revocationStore, apiKeys, policies),
+			requestInfo(proxies),
 		),
 	}
 	if c.Http.Network != "" {
@@ -62,12 +68,19 @@ func NewHTTPServer(
 	jobv1.RegisterJobPostingHTTPServer(srv, jobSvc)
 	jobv1.RegisterCompanyHTTPServer(srv, companySvc)
 	resumev1.RegisterResumeHTTPServer(srv, resumeSvc)
+	jobv1.RegisterApplicationHTTPServer(srv, applicationSvc)
+	jobv1.RegisterHiringPipelineHTTPServer(srv, pipelineSvc)
+	jobv1.RegisterJobAlertHTTPServer(srv, jobAlertSvc)
+	jobv1.RegisterNotificationHTTPServer(srv, notificationSvc)
+	adminv1.RegisterUserAdminHTTPServer(srv, userAdminSvc)
+	adminv1.RegisterAuditLogHTTPServer(srv, auditLogSvc)
+	adminv1.RegisterSchedulerHTTPServer(srv, schedulerSvc)
 
 	// Get resume parser URL from config
 	resumeParserURL := c.ResumeParserUrl
 
 	// Create upload handler
-	uploadHandler, err := NewUploadHandler(configx.GetEnvOrString("RESUME_PARSER_URL", resumeParserURL), logger, configx.GetEnvOrString("DATABASE_SOURCE", cData.Database.Source), configx.GetEnvOrString("DATABASE_NAME", cData.Database.Name), jwtSecret)
+	uploadHandler, err := NewUploadHandler(configx.GetEnvOrString("RESUME_PARSER_URL", resumeParserURL), logger, configx.GetEnvOrString("DATABASE_SOURCE", cData.Database.Source), configx.GetEnvOrString("DATABASE_NAME", cData.Database.Name), keys, revocationStore)
 	if err != nil {
 		panic(err)
 	}
@@ -76,6 +89,9 @@ func NewHTTPServer(
 	// Use HandleFunc for raw HTTP handler
 	srv.HandleFunc("/api/v1/resumes/upload", uploadHandler.HandleUploadResume)
 
+	// Public keys để các service khác verify token của Jobbly
+	srv.HandleFunc("/.well-known/jwks.json", NewJWKSHandler(keys))
+
 	// Register swagger ui url: http://<hostname>/q/swagger-ui/
 	h := openapiv2.NewHandler()
 	srv.HandlePrefix("/q/", h)
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"encoding/json"
+	nethttp "net/http"
+
+	"JobblyBE/pkg/middleware/auth"
+)
+
+// NewJWKSHandler serves the public token verification keys as a JSON Web Key Set
+func NewJWKSHandler(keys *auth.KeySet) nethttp.HandlerFunc {
+	body, err := json.Marshal(keys.JWKS())
+	if err != nil {
+		panic(err)
+	}
+
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodGet && r.Method != nethttp.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			nethttp.Error(w, "method not allowed", nethttp.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		// Client cache key ngắn hạn; khi xoay key, key mới được publish trước khi dùng để ký
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(body)
+	}
+}
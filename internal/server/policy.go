@@ -0,0 +1,105 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	policyv1 "JobblyBE/api/policy/v1"
+	"JobblyBE/internal/biz"
+	"JobblyBE/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// infraPolicies covers the services kratos registers on every gRPC server
+var infraPolicies = auth.PolicyTable{
+	"/grpc.health.v1.Health/*":     {Access: auth.AccessPublic},
+	"/kratos.api.Metadata/*":       {Access: auth.AccessAuthenticated, Roles: []string{string(biz.RoleAdmin)}},
+	"/grpc.channelz.v1.Channelz/*": {Access: auth.AccessAuthenticated, Roles: []string{string(biz.RoleAdmin)}},
+}
+
+// NewPolicyTable builds the authorization policy table from the (api.policy.v1.policy)
+// option of every RPC compiled into the binary.
+// Fails if any RPC does not declare a policy, so a new RPC can not be exposed by accident.
+func NewPolicyTable() (auth.PolicyTable, error) {
+	table := auth.PolicyTable{}
+	for operation, policy := range infraPolicies {
+		table[operation] = policy
+	}
+
+	var operations, unknownScopes []string
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(string(fd.Package()), "api.") {
+			return true
+		}
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				operation := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), method.Name())
+				operations = append(operations, operation)
+				if policy, ok := methodPolicy(method); ok {
+					table[operation] = policy
+				}
+				for _, scope := range table[operation].Scopes {
+					if !biz.IsAPIKeyScope(scope) {
+						unknownScopes = append(unknownScopes, fmt.Sprintf("%s (%s)", scope, operation))
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	if err := table.Check(operations...); err != nil {
+		return nil, err
+	}
+	if len(unknownScopes) > 0 {
+		return nil, fmt.Errorf("unknown api key scopes in policies: %s", strings.Join(unknownScopes, ", "))
+	}
+
+	return table, nil
+}
+
+// methodPolicy reads the policy option of an RPC.
+// A policy with neither access nor roles counts as missing.
+func methodPolicy(method protoreflect.MethodDescriptor) (auth.Policy, bool) {
+	if !proto.HasExtension(method.Options(), policyv1.E_Policy) {
+		return auth.Policy{}, false
+	}
+	p := proto.GetExtension(method.Options(), policyv1.E_Policy).(*policyv1.Policy)
+
+	policy := auth.Policy{Roles: p.GetRoles(), Scopes: p.GetScopes(), DenyImpersonation: p.GetDenyImpersonation()}
+	switch p.GetAccess() {
+	case policyv1.Access_PUBLIC:
+		policy.Access = auth.AccessPublic
+	case policyv1.Access_AUTHENTICATED:
+		policy.Access = auth.AccessAuthenticated
+	default:
+		if len(policy.Roles) == 0 {
+			return auth.Policy{}, false
+		}
+		policy.Access = auth.AccessAuthenticated
+	}
+
+	return policy, true
+}
+
+// grpcOperations lists the unary operations registered on a gRPC server
+// (middleware only runs for unary calls)
+func grpcOperations(srv *grpc.Server) []string {
+	var operations []string
+	for service, info := range srv.GetServiceInfo() {
+		for _, method := range info.Methods {
+			if method.IsClientStream || method.IsServerStream {
+				continue
+			}
+			operations = append(operations, fmt.Sprintf("/%s/%s", service, method.Name))
+		}
+	}
+	return operations
+}
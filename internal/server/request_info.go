@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	"JobblyBE/internal/biz"
+	"JobblyBE/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// RequestIDHeader carries the ID of a request, echoed back in the reply
+const RequestIDHeader = "X-Request-ID"
+
+// requestInfo stores the client IP, user agent and request ID of every request in the context,
+// used by sessions, login throttling and the audit log.
+// Phải đặt sau Authorize để biết admin đang mạo danh (nếu có).
+func requestInfo(proxies trustedProxies) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			info := &biz.RequestInfo{
+				IPAddress: proxies.clientIP(ctx, tr),
+				UserAgent: tr.RequestHeader().Get("User-Agent"),
+				RequestID: tr.RequestHeader().Get(RequestIDHeader),
+			}
+			if info.RequestID == "" {
+				info.RequestID = newRequestID()
+			}
+			tr.ReplyHeader().Set(RequestIDHeader, info.RequestID)
+
+			if claims, err := auth.GetClaimsFromContext(ctx); err == nil && claims.IsImpersonated() {
+				info.ImpersonatorID = claims.Actor.UserID
+			}
+
+			return handler(biz.NewRequestInfoContext(ctx, info), req)
+		}
+	}
+}
+
+// trustedProxies are the reverse proxies allowed to set X-Forwarded-For / X-Real-IP
+type trustedProxies []*net.IPNet
+
+// parseTrustedProxies parses the trusted_proxies config (IPs or CIDRs)
+func parseTrustedProxies(entries []string) (trustedProxies, error) {
+	proxies := make(trustedProxies, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// contains reports whether ip is a trusted proxy
+func (p trustedProxies) contains(ip net.IP) bool {
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the IP of the client. Header X-Forwarded-For / X-Real-IP chỉ được dùng khi
+// request đến từ trusted proxy; với X-Forwarded-For lấy hop ngoài cùng bên phải không phải
+// trusted proxy, vì các entry bên trái do client tự gửi và có thể giả mạo.
+func (p trustedProxies) clientIP(ctx context.Context, tr transport.Transporter) string {
+	remote := remoteIP(ctx, tr)
+	if remote == nil || !p.contains(remote) {
+		if remote == nil {
+			return ""
+		}
+		return remote.String()
+	}
+
+	if forwarded := tr.RequestHeader().Get("X-Forwarded-For"); forwarded != "" {
+		client := remote
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				break // entry không hợp lệ, dùng hop trusted gần nhất
+			}
+			client = ip
+			if !p.contains(ip) {
+				break
+			}
+		}
+		return client.String()
+	}
+	if ip := net.ParseIP(strings.TrimSpace(tr.RequestHeader().Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+	return remote.String()
+}
+
+// remoteIP returns the address of the connection of the request
+func remoteIP(ctx context.Context, tr transport.Transporter) net.IP {
+	var addr string
+	if ht, ok := tr.(http.Transporter); ok {
+		addr = ht.Request().RemoteAddr
+	} else if pr, ok := peer.FromContext(ctx); ok && pr.Addr != nil {
+		addr = pr.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
+
+// newRequestID sinh request ID ngẫu nhiên khi client không gửi lên
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
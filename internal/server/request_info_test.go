@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"net"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/peer"
+)
+
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type fakeTransport struct {
+	header headerCarrier
+}
+
+func (t *fakeTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *fakeTransport) Endpoint() string                { return "" }
+func (t *fakeTransport) Operation() string               { return "" }
+func (t *fakeTransport) RequestHeader() transport.Header { return t.header }
+func (t *fakeTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+func TestClientIP(t *testing.T) {
+	proxies, err := parseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"no proxy", "203.0.113.5:4000", nil, "203.0.113.5"},
+		{"untrusted remote ignores headers", "203.0.113.5:4000", map[string]string{"X-Forwarded-For": "1.2.3.4", "X-Real-IP": "1.2.3.4"}, "203.0.113.5"},
+		{"trusted proxy single hop", "10.0.0.2:80", map[string]string{"X-Forwarded-For": "198.51.100.7"}, "198.51.100.7"},
+		{"spoofed left-most entry", "10.0.0.2:80", map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.7"}, "198.51.100.7"},
+		{"skips trusted hops", "10.0.0.2:80", map[string]string{"X-Forwarded-For": "198.51.100.7, 192.168.1.1, 10.1.2.3"}, "198.51.100.7"},
+		{"all hops trusted", "10.0.0.2:80", map[string]string{"X-Forwarded-For": "10.1.1.1, 10.2.2.2"}, "10.1.1.1"},
+		{"invalid hop stops", "10.0.0.2:80", map[string]string{"X-Forwarded-For": "198.51.100.7, garbage, 10.1.2.3"}, "10.1.2.3"},
+		{"x-real-ip", "192.168.1.1:80", map[string]string{"X-Real-IP": "198.51.100.9"}, "198.51.100.9"},
+		{"invalid x-real-ip", "192.168.1.1:80", map[string]string{"X-Real-IP": "nope"}, "192.168.1.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &fakeTransport{header: headerCarrier{}}
+			for k, v := range tt.headers {
+				tr.header.Set(k, v)
+			}
+			addr, err := net.ResolveTCPAddr("tcp", tt.remote)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+
+			if got := proxies.clientIP(ctx, tr); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	if _, err := parseTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("expected error for invalid CIDR")
+	}
+	if _, err := parseTrustedProxies([]string{"not-an-ip"}); err == nil {
+		t.Error("expected error for invalid IP")
+	}
+	proxies, err := parseTrustedProxies([]string{"::1", "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proxies.contains(net.ParseIP("::1")) || !proxies.contains(net.ParseIP("127.0.0.1")) || proxies.contains(net.ParseIP("127.0.0.2")) {
+		t.Errorf("unexpected trusted proxies %v", proxies)
+	}
+}
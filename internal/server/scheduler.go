@@ -0,0 +1,122 @@
+package server
+
+import (
+	"JobblyBE/internal/biz"
+	"JobblyBE/internal/conf"
+	"JobblyBE/pkg/configx"
+	"JobblyBE/pkg/scheduler"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// schedulerBatchSize giới hạn số item một lần chạy xử lý, phần còn lại để lần chạy sau
+const schedulerBatchSize = 500
+
+// NewScheduler creates the background task scheduler with the tasks of the app.
+// Lịch mặc định có thể được ghi đè theo tên task trong conf.Server.Scheduler.
+func NewScheduler(
+	c *conf.Server,
+	store scheduler.LeaseStore,
+	jobUC *biz.JobPostingUseCase,
+	trackingUC *biz.UserTrackingUseCase,
+	accountUC *biz.AccountDataUseCase,
+	alertUC *biz.JobAlertUseCase,
+	logger log.Logger,
+) (*scheduler.Scheduler, error) {
+	tasks := []scheduler.Task{
+		{
+			Name:     "expire_jobs",
+			Schedule: "*/5 * * * *",
+			Run: func(ctx context.Context, now time.Time) (int, error) {
+				return jobUC.ExpireJobPostings(ctx, now, schedulerBatchSize)
+			},
+		},
+		{
+			Name:     "publish_scheduled_jobs",
+			Schedule: "* * * * *",
+			Run: func(ctx context.Context, now time.Time) (int, error) {
+				return jobUC.PublishScheduledJobPostings(ctx, now, schedulerBatchSize)
+			},
+		},
+		{
+			Name:     "purge_stale_tracking",
+			Schedule: "0 3 * * *",
+			Run:      trackingUC.PurgeStaleTracking,
+		},
+		{
+			Name:     "purge_deleted_accounts",
+			Schedule: "30 3 * * *",
+			Run: func(ctx context.Context, now time.Time) (int, error) {
+				return accountUC.PurgeDeletedAccounts(ctx, now, schedulerBatchSize)
+			},
+		},
+		{
+			// Match job mới publish với saved search và gửi ngay alert INSTANT
+			Name:     "match_job_alerts",
+			Schedule: "* * * * *",
+			Run: func(ctx context.Context, now time.Time) (int, error) {
+				matched, err := alertUC.MatchNewJobs(ctx, now, schedulerBatchSize)
+				if err != nil {
+					return matched, err
+				}
+				sent, err := alertUC.SendJobAlerts(ctx, now, biz.AlertFrequencyInstant)
+				return matched + sent, err
+			},
+		},
+		{
+			Name:     "send_daily_job_alerts",
+			Schedule: "0 8 * * *",
+			Run: func(ctx context.Context, now time.Time) (int, error) {
+				return alertUC.SendJobAlerts(ctx, now, biz.AlertFrequencyDaily)
+			},
+		},
+		{
+			Name:     "send_weekly_job_alerts",
+			Schedule: "0 8 * * 1",
+			Run: func(ctx context.Context, now time.Time) (int, error) {
+				return alertUC.SendJobAlerts(ctx, now, biz.AlertFrequencyWeekly)
+			},
+		},
+	}
+
+	sc := c.GetScheduler()
+	disabled := configx.GetEnvOrBool("SCHEDULER_DISABLED", sc.GetDisabled())
+	overrides := sc.GetTasks()
+
+	s := scheduler.New(store, logger)
+	for _, task := range tasks {
+		if o, ok := overrides[task.Name]; ok {
+			if o.GetSchedule() != "" {
+				task.Schedule = o.GetSchedule()
+			}
+			if o.GetLeaseTtl() != nil {
+				task.LeaseTTL = o.GetLeaseTtl().AsDuration()
+			}
+			task.Disabled = o.GetDisabled()
+		}
+		task.Disabled = task.Disabled || disabled
+
+		if err := s.Register(task); err != nil {
+			return nil, fmt.Errorf("task %s: %w", task.Name, err)
+		}
+	}
+	for name := range overrides {
+		if !hasTask(tasks, name) {
+			return nil, fmt.Errorf("scheduler: unknown task %s in config", name)
+		}
+	}
+
+	return s, nil
+}
+
+func hasTask(tasks []scheduler.Task, name string) bool {
+	for _, task := range tasks {
+		if task.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -66,14 +66,15 @@ type Experience struct {
 }
 
 type UploadHandler struct {
-	parserURL string
-	jwtSecret string
-	log       *log.Helper
-	cli       *req.Client
-	db        *mongo.Database
+	parserURL       string
+	keys            *auth.KeySet
+	revocationStore auth.RevocationStore
+	log             *log.Helper
+	cli             *req.Client
+	db              *mongo.Database
 }
 
-func NewUploadHandler(parserURL string, logger log.Logger, databaseSource string, databaseName string, jwtSecret string) (*UploadHandler, error) {
+func NewUploadHandler(parserURL string, logger log.Logger, databaseSource string, databaseName string, keys *auth.KeySet, revocationStore auth.RevocationStore) (*UploadHandler, error) {
 
 	log := log.NewHelper(logger)
 	// Create MongoDB client
@@ -99,10 +100,11 @@ func NewUploadHandler(parserURL string, logger log.Logger, databaseSource string
 	db := client.Database(dbName)
 
 	return &UploadHandler{
-		parserURL: parserURL,
-		jwtSecret: jwtSecret,
-		log:       log,
-		db:        db,
+		parserURL:       parserURL,
+		keys:            keys,
+		revocationStore: revocationStore,
+		log:             log,
+		db:              db,
 		cli: req.C().
 			SetBaseURL(parserURL).
 			SetTimeout(5 * time.Minute), // 5 minutes timeout for parsing
@@ -211,12 +213,20 @@ func (h *UploadHandler) HandleUploadResume(w http.ResponseWriter, r *http.Reques
 	var userID string
 
 	if token != "" {
-		claims, err := auth.ValidateAccessToken(token, h.jwtSecret)
+		claims, err := auth.ValidateAccessToken(token, h.keys)
+		if err == nil && claims != nil {
+			err = auth.CheckRevoked(r.Context(), h.revocationStore, claims)
+		}
 		if err == nil && claims != nil {
 			userID = claims.UserID
 			h.log.Infof("User authenticated: %s", userID)
+			if claims.IsImpersonated() {
+				h.log.Infow("msg", "impersonated request", "operation", r.URL.Path,
+					"actor_id", claims.Actor.UserID, "actor_email", claims.Actor.Email,
+					"subject_id", claims.UserID, "token_id", claims.ID)
+			}
 		} else {
-			h.log.Warnf("Invalid or expired token: %v", err)
+			h.log.Warnf("Invalid, expired or revoked token: %v", err)
 		}
 	} else {
 		h.log.Info("No token provided, processing as anonymous")
@@ -285,6 +295,13 @@ func (h *UploadHandler) HandleUploadResume(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// Chưa xác thực email thì chưa được upload resume
+	if !user.IsEmailVerified() {
+		h.log.Warnf("user %s has not verified email", userID)
+		http.Error(w, "Please verify your email before uploading a resume", http.StatusForbidden)
+		return
+	}
+
 	if len(user.Resume) > 0 {
 		h.log.Warnf("user already has a resume")
 		http.Error(w, "You already have a resume. Please update it instead of creating a new one", http.StatusBadRequest)
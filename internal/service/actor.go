@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "JobblyBE/api/job/v1"
+	"JobblyBE/internal/biz"
+	"JobblyBE/pkg/middleware/auth"
+)
+
+// actorFromContext builds the biz.Actor of the authenticated request
+func actorFromContext(ctx context.Context) (*biz.Actor, error) {
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, pb.ErrorUnauthorized("failed to get user claims: %v", err)
+	}
+
+	return &biz.Actor{
+		UserID: claims.UserID,
+		Role:   biz.Role(claims.Role),
+	}, nil
+}
+
+// optionalActorFromContext returns the actor of a public request, nil for anonymous requests
+func optionalActorFromContext(ctx context.Context) *biz.Actor {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+	return actor
+}
+
+// parseJobTime parses an optional time field (posted_at, expires_at) of a job request
+func parseJobTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, pb.ErrorInvalidJobData("%s must be an RFC 3339 time", name)
+	}
+	return &t, nil
+}
+
+// jobError maps job/company biz errors to API errors
+func jobError(err error) error {
+	switch {
+	case errors.Is(err, biz.ErrUnauthorizedJobAction):
+		return pb.ErrorUnauthorizedJobAction("you are not allowed to manage this company")
+	case errors.Is(err, biz.ErrJobNotFound):
+		return pb.ErrorJobNotFound("job not found")
+	case errors.Is(err, biz.ErrInvalidJobData):
+		return pb.ErrorInvalidJobData("invalid job data")
+	case errors.Is(err, biz.ErrJobExpired):
+		return pb.ErrorJobExpired("job expired")
+	case errors.Is(err, biz.ErrInvalidJobStatus):
+		return pb.ErrorInvalidStatus("job status does not allow this action")
+	case errors.Is(err, biz.ErrInvalidJobSort):
+		return pb.ErrorDataRequestInvalid("sort must be newest or relevance")
+	case errors.Is(err, biz.ErrInvalidSalaryBucket):
+		return pb.ErrorDataRequestInvalid("unknown salary_bucket")
+	case errors.Is(err, biz.ErrCompanyNotFound):
+		return pb.ErrorCompanyNotFound("company not found")
+	case errors.Is(err, biz.ErrCompanyAlreadyExists):
+		return pb.ErrorCompanyAlreadyExists("company already exists")
+	case errors.Is(err, biz.ErrInvalidCompanyData):
+		return pb.ErrorInvalidCompanyData("invalid company data")
+	case errors.Is(err, biz.ErrCompanyMemberNotFound):
+		return pb.ErrorCompanyMemberNotFound("company member not found")
+	case errors.Is(err, biz.ErrCompanyMemberAlreadyExists):
+		return pb.ErrorCompanyMemberAlreadyExists("user is already a member of this company")
+	case errors.Is(err, biz.ErrLastCompanyOwner):
+		return pb.ErrorLastCompanyOwner("company must keep at least one owner")
+	case errors.Is(err, biz.ErrUserNotFound):
+		return pb.ErrorUserNotFound("user not found")
+	}
+	return err
+}
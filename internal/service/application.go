@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "JobblyBE/api/job/v1"
+	"JobblyBE/internal/biz"
+)
+
+type ApplicationService struct {
+	pb.UnimplementedApplicationServer
+	uc *biz.ApplicationUseCase
+}
+
+func NewApplicationService(uc *biz.ApplicationUseCase) *ApplicationService {
+	return &ApplicationService{uc: uc}
+}
+
+func (s *ApplicationService) ApplyJob(ctx context.Context, req *pb.ApplyJobRequest) (*pb.ApplicationReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.uc.Apply(ctx, actor, req.JobId, req.ResumeId, req.CoverLetter)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	return s.applicationToPb(app), nil
+}
+
+func (s *ApplicationService) ListMyApplications(ctx context.Context, req *pb.ListMyApplicationsRequest) (*pb.ListApplicationsReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, total, err := s.uc.ListMyApplications(ctx, actor, biz.ApplicationStatus(req.Status), req.Page, req.PageSize)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	return s.listToPb(apps, total, req.Page, req.PageSize), nil
+}
+
+func (s *ApplicationService) GetApplication(ctx context.Context, req *pb.GetApplicationRequest) (*pb.ApplicationReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.uc.GetApplication(ctx, actor, req.Id)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	return s.applicationToPb(app), nil
+}
+
+func (s *ApplicationService) WithdrawApplication(ctx context.Context, req *pb.WithdrawApplicationRequest) (*pb.ApplicationReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.uc.WithdrawApplication(ctx, actor, req.Id)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	return s.applicationToPb(app), nil
+}
+
+func (s *ApplicationService) ListJobApplications(ctx context.Context, req *pb.ListJobApplicationsRequest) (*pb.ListApplicationsReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, total, err := s.uc.ListJobApplications(ctx, actor, req.JobId, biz.ApplicationStatus(req.Status), req.Stage, req.Page, req.PageSize)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	return s.listToPb(apps, total, req.Page, req.PageSize), nil
+}
+
+func (s *ApplicationService) MoveApplication(ctx context.Context, req *pb.MoveApplicationRequest) (*pb.ApplicationReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.uc.MoveApplication(ctx, actor, req.Id, req.Stage, req.Reason)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	return s.applicationToPb(app), nil
+}
+
+// applicationError maps application biz errors to API errors
+func applicationError(err error) error {
+	switch {
+	case errors.Is(err, biz.ErrApplicationNotFound):
+		return pb.ErrorApplicationNotFound("application not found")
+	case errors.Is(err, biz.ErrApplicationAlreadyExists):
+		return pb.ErrorApplicationAlreadyExists("you have already applied to this job")
+	case errors.Is(err, biz.ErrInvalidApplication):
+		return pb.ErrorInvalidApplicationData("resume_id is required and the cover letter must be at most %d characters", biz.MaxCoverLetterLength)
+	case errors.Is(err, biz.ErrInvalidApplicationStatus):
+		return pb.ErrorInvalidStatus("application status does not allow this action")
+	case errors.Is(err, biz.ErrJobNotAcceptingApplications):
+		return pb.ErrorJobNotAcceptingApplications("job is not accepting applications")
+	case errors.Is(err, biz.ErrInvalidPipeline):
+		return pb.ErrorInvalidPipeline("pipeline must have 2 to %d stages with unique keys and transitions to existing stages", biz.MaxPipelineStages)
+	case errors.Is(err, biz.ErrPipelineStageInUse):
+		return pb.ErrorPipelineStageInUse("a removed stage still has applications")
+	case errors.Is(err, biz.ErrInvalidStageTransition):
+		return pb.ErrorInvalidStageTransition("application cannot move to this stage")
+	}
+	return jobError(err)
+}
+
+func (s *ApplicationService) listToPb(apps []*biz.Application, total, page, pageSize int32) *pb.ListApplicationsReply {
+	results := make([]*pb.ApplicationReply, 0, len(apps))
+	for _, app := range apps {
+		results = append(results, s.applicationToPb(app))
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return &pb.ListApplicationsReply{
+		Applications: results,
+		Total:        total,
+		Page:         page,
+		PageSize:     pageSize,
+	}
+}
+
+// Helper function to convert biz.Application to pb.ApplicationReply
+func (s *ApplicationService) applicationToPb(app *biz.Application) *pb.ApplicationReply {
+	reply := &pb.ApplicationReply{
+		Id:            app.ID,
+		JobId:         app.JobID,
+		CompanyId:     app.CompanyID,
+		UserId:        app.UserID,
+		JobTitle:      app.JobTitle,
+		CompanyName:   app.CompanyName,
+		ResumeId:      app.ResumeID,
+		ResumeVersion: app.ResumeVersion,
+		Resume:        (&ResumeService{}).resumeDetailToPb(app.Resume),
+		CoverLetter:   app.CoverLetter,
+		Status:        string(app.Status),
+		Stage:         app.Stage,
+		CreatedAt:     app.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     app.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if app.WithdrawnAt != nil {
+		reply.WithdrawnAt = app.WithdrawnAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	for _, c := range app.StageHistory {
+		reply.StageHistory = append(reply.StageHistory, &pb.StageChange{
+			From:      c.From,
+			To:        c.To,
+			Reason:    c.Reason,
+			ActorId:   c.ActorID,
+			ChangedAt: c.ChangedAt.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	return reply
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	pb "JobblyBE/api/admin/v1"
+	authv1 "JobblyBE/api/auth/v1"
+	"JobblyBE/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type AuditLogService struct {
+	pb.UnimplementedAuditLogServer
+	auditLogUC *biz.AuditLogUseCase
+	log        *log.Helper
+}
+
+func NewAuditLogService(auditLogUC *biz.AuditLogUseCase, logger log.Logger) *AuditLogService {
+	return &AuditLogService{
+		auditLogUC: auditLogUC,
+		log:        log.NewHelper(logger),
+	}
+}
+
+func (s *AuditLogService) ListAuditLogs(ctx context.Context, req *pb.ListAuditLogsRequest) (*pb.ListAuditLogsReply, error) {
+	s.log.WithContext(ctx).Infof("ListAuditLogs request: page=%d, page_size=%d", req.Page, req.PageSize)
+
+	filter := &biz.AuditFilter{
+		ActorID:    req.ActorId,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetId,
+	}
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, authv1.ErrorDataRequestInvalid("from must be an RFC 3339 time")
+		}
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, authv1.ErrorDataRequestInvalid("to must be an RFC 3339 time")
+		}
+		filter.To = &to
+	}
+
+	entries, total, err := s.auditLogUC.ListAuditEntries(ctx, filter, req.Page, req.PageSize)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to list audit log: %v", err)
+		return nil, authv1.ErrorSystemError("failed to list audit log")
+	}
+
+	pbEntries := make([]*pb.AuditLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, toAuditLogEntry(entry))
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return &pb.ListAuditLogsReply{
+		Entries:  pbEntries,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// toAuditLogEntry converts biz.AuditEntry to pb.AuditLogEntry
+func toAuditLogEntry(entry *biz.AuditEntry) *pb.AuditLogEntry {
+	return &pb.AuditLogEntry{
+		Id:             entry.ID,
+		ActorId:        entry.ActorID,
+		ImpersonatorId: entry.ImpersonatorID,
+		Action:         entry.Action,
+		TargetType:     entry.TargetType,
+		TargetId:       entry.TargetID,
+		Before:         toStruct(entry.Before),
+		After:          toStruct(entry.After),
+		Reason:         entry.Reason,
+		IpAddress:      entry.IPAddress,
+		RequestId:      entry.RequestID,
+		CreatedAt:      entry.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// toStruct converts a field map to a protobuf Struct (qua JSON để giữ được time, ObjectID...)
+func toStruct(fields map[string]interface{}) *structpb.Struct {
+	if fields == nil {
+		return nil
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil
+	}
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, s); err != nil {
+		return nil
+	}
+	return s
+}
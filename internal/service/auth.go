@@ -2,36 +2,51 @@ package service
 
 import (
 	"JobblyBE/internal/biz"
-	"JobblyBE/pkg/configx"
 	"context"
 	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	pb "JobblyBE/api/auth/v1"
 	"JobblyBE/pkg/middleware/auth"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
 type AuthService struct {
 	pb.UnimplementedAuthServer
-	authUC    *biz.AuthUseCase
-	jwtSecret string
-	log       *log.Helper
+	authUC          *biz.AuthUseCase
+	sessionUC       *biz.SessionUseCase
+	verificationUC  *biz.EmailVerificationUseCase
+	resetUC         *biz.PasswordResetUseCase
+	totpUC          *biz.TOTPUseCase
+	oauthUC         *biz.OAuthUseCase
+	apiKeyUC        *biz.APIKeyUseCase
+	accountUC       *biz.AccountDataUseCase
+	revocationStore auth.RevocationStore
+	keys            *auth.KeySet
+	log             *log.Helper
 }
 
-func NewAuthService(authUC *biz.AuthUseCase, jwtSecret string, logger log.Logger) *AuthService {
-	jwtSecret = configx.GetEnvOrString("JWT_SECRET", jwtSecret)
-	if jwtSecret == "" {
-		panic("JWT secret cannot be empty")
-	}
-
+func NewAuthService(authUC *biz.AuthUseCase, sessionUC *biz.SessionUseCase, verificationUC *biz.EmailVerificationUseCase, resetUC *biz.PasswordResetUseCase, totpUC *biz.TOTPUseCase, oauthUC *biz.OAuthUseCase, apiKeyUC *biz.APIKeyUseCase, accountUC *biz.AccountDataUseCase, revocationStore auth.RevocationStore, keys *auth.KeySet, logger log.Logger) *AuthService {
 	logHelper := log.NewHelper(logger)
-	logHelper.Infof("AuthService initialized with JWT secret length: %d", len(jwtSecret))
+	logHelper.Infof("AuthService initialized with JWT signing key: %q", keys.SigningKeyID())
 
 	return &AuthService{
-		authUC:    authUC,
-		jwtSecret: jwtSecret,
-		log:       logHelper,
+		authUC:          authUC,
+		sessionUC:       sessionUC,
+		verificationUC:  verificationUC,
+		resetUC:         resetUC,
+		totpUC:          totpUC,
+		oauthUC:         oauthUC,
+		apiKeyUC:        apiKeyUC,
+		accountUC:       accountUC,
+		revocationStore: revocationStore,
+		keys:            keys,
+		log:             logHelper,
 	}
 }
 
@@ -66,15 +81,13 @@ func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		return nil, pb.ErrorSystemError("failed to register user")
 	}
 
-	// Generate token pair
-	tokens, err := auth.NewTokenPair(
-		user.UserID,
-		user.Email,
-		user.FullName,
-		user.PhoneNumber,
-		string(user.Role),
-		s.jwtSecret,
-	)
+	// Gửi email xác thực, lỗi gửi mail không chặn đăng ký (user có thể yêu cầu gửi lại)
+	if err := s.verificationUC.SendVerification(ctx, user); err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to send verification email: %v", err)
+	}
+
+	// Start a new session and generate its token pair
+	tokens, err := s.issueTokens(ctx, user)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("Failed to generate tokens: %v", err)
 		return nil, pb.ErrorSystemError("failed to generate authentication tokens")
@@ -84,10 +97,11 @@ func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*p
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		User: &pb.AuthReply_User{
-			FullName:    user.FullName,
-			Email:       user.Email,
-			PhoneNumber: user.PhoneNumber,
-			Role:        string(user.Role),
+			FullName:      user.FullName,
+			Email:         user.Email,
+			PhoneNumber:   user.PhoneNumber,
+			Role:          string(user.Role),
+			EmailVerified: user.EmailVerified,
 		},
 	}, nil
 }
@@ -101,8 +115,11 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Auth
 	}
 
 	// Login through use case
-	user, err := s.authUC.Login(ctx, req.Email, req.Password)
+	user, err := s.authUC.Login(ctx, req.Email, req.Password, clientFromContext(ctx).IPAddress)
 	if err != nil {
+		if errors.Is(err, biz.ErrTooManyLoginAttempts) {
+			return nil, tooManyLoginAttempts(ctx, err)
+		}
 		if errors.Is(err, biz.ErrInvalidCredentials) {
 			return nil, pb.ErrorInvalidCredentials("invalid email or password")
 		}
@@ -113,30 +130,7 @@ func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Auth
 		return nil, pb.ErrorSystemError("login failed")
 	}
 
-	// Generate token pair
-	tokens, err := auth.NewTokenPair(
-		user.UserID,
-		user.Email,
-		user.FullName,
-		user.PhoneNumber,
-		string(user.Role),
-		s.jwtSecret,
-	)
-	if err != nil {
-		s.log.WithContext(ctx).Errorf("Failed to generate tokens: %v", err)
-		return nil, pb.ErrorSystemError("failed to generate authentication tokens")
-	}
-
-	return &pb.AuthReply{
-		AccessToken:  tokens.AccessToken,
-		RefreshToken: tokens.RefreshToken,
-		User: &pb.AuthReply_User{
-			FullName:    user.FullName,
-			Email:       user.Email,
-			PhoneNumber: user.PhoneNumber,
-			Role:        string(user.Role),
-		},
-	}, nil
+	return s.completeLogin(ctx, user)
 }
 
 func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenReply, error) {
@@ -147,37 +141,83 @@ func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequ
 		return nil, pb.ErrorDataRequestInvalid("refresh_token is required")
 	}
 
-	// Validate refresh token và lấy user ID
-	userID, err := auth.ValidateRefreshToken(req.RefreshToken, s.jwtSecret)
+	// Validate refresh token và lấy claims
+	refreshClaims, err := auth.ValidateToken(req.RefreshToken, s.keys, auth.RefreshToken)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("Invalid refresh token: %v", err)
 		return nil, pb.ErrorJwtTokenInvalid("invalid refresh token: %v", err)
 	}
 
+	// Refresh token đã bị thu hồi (logout) thì không được dùng nữa
+	if err := auth.CheckRevoked(ctx, s.revocationStore, refreshClaims); err != nil {
+		s.log.WithContext(ctx).Warnf("Rejected refresh token: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("invalid refresh token: %v", err)
+	}
+
 	// Get user from database through use case
-	user, err := s.authUC.GetUserByID(ctx, userID)
+	user, err := s.authUC.GetUserByID(ctx, refreshClaims.UserID)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("User not found: %v", err)
 		return nil, pb.ErrorUserNotFound("user not found")
 	}
+	if !user.Active {
+		return nil, pb.ErrorUnauthorized("user account is inactive")
+	}
 
-	// Generate new access token
-	accessToken, err := auth.GenerateAccessToken(
+	// Refresh token phát hành trước lần reset mật khẩu gần nhất không còn hiệu lực
+	if user.PasswordResetAt != nil && refreshClaims.IssuedAt != nil &&
+		refreshClaims.IssuedAt.Time.Before(user.PasswordResetAt.Truncate(time.Second)) {
+		return nil, pb.ErrorSessionRevoked("session is no longer valid, please login again")
+	}
+
+	// Token phát hành trước khi có session: retire nó và mở session mới
+	if refreshClaims.SessionID == "" {
+		if err := auth.RevokeClaims(ctx, s.revocationStore, refreshClaims); err != nil {
+			s.log.WithContext(ctx).Errorf("Failed to retire legacy refresh token: %v", err)
+			return nil, pb.ErrorSystemError("failed to refresh token")
+		}
+		tokens, err := s.issueTokens(ctx, user)
+		if err != nil {
+			s.log.WithContext(ctx).Errorf("Failed to generate tokens: %v", err)
+			return nil, pb.ErrorSystemError("failed to generate authentication tokens")
+		}
+		return &pb.RefreshTokenReply{
+			AccessToken:  tokens.AccessToken,
+			RefreshToken: tokens.RefreshToken,
+		}, nil
+	}
+
+	// Generate new token pair in the same session (token family)
+	tokens, err := auth.NewSessionTokenPair(
+		refreshClaims.SessionID,
 		user.UserID,
 		user.Email,
 		user.FullName,
 		user.PhoneNumber,
 		string(user.Role),
-		s.jwtSecret,
-		auth.AccessTokenDuration,
+		s.keys,
 	)
 	if err != nil {
-		s.log.WithContext(ctx).Errorf("Failed to generate access token: %v", err)
-		return nil, pb.ErrorSystemError("failed to generate access token")
+		s.log.WithContext(ctx).Errorf("Failed to generate tokens: %v", err)
+		return nil, pb.ErrorSystemError("failed to generate authentication tokens")
+	}
+
+	// Retire the presented refresh token; a replayed one revokes the family
+	err = s.sessionUC.RotateRefreshToken(ctx, refreshClaims.SessionID, refreshClaims.ID, issuedTokens(tokens))
+	if err != nil {
+		if errors.Is(err, biz.ErrRefreshTokenReused) {
+			return nil, pb.ErrorRefreshTokenReused("refresh token has already been used, please login again")
+		}
+		if errors.Is(err, biz.ErrSessionRevoked) || errors.Is(err, biz.ErrSessionNotFound) {
+			return nil, pb.ErrorSessionRevoked("session is no longer valid, please login again")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to rotate refresh token: %v", err)
+		return nil, pb.ErrorSystemError("failed to refresh token")
 	}
 
 	return &pb.RefreshTokenReply{
-		AccessToken: accessToken,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
 
@@ -200,10 +240,11 @@ func (s *AuthService) GetProfile(ctx context.Context, req *pb.GetProfileRequest)
 
 	// Return user profile
 	return &pb.GetProfileReply{
-		FullName:    user.FullName,
-		Email:       user.Email,
-		PhoneNumber: user.PhoneNumber,
-		Role:        string(user.Role),
+		FullName:      user.FullName,
+		Email:         user.Email,
+		PhoneNumber:   user.PhoneNumber,
+		Role:          string(user.Role),
+		EmailVerified: user.EmailVerified,
 		// Password should NEVER be returned in response
 	}, nil
 }
@@ -273,6 +314,9 @@ func (s *AuthService) ChangePassword(ctx context.Context, req *pb.ChangePassword
 	// Change password through use case
 	err = s.authUC.ChangePassword(ctx, claims.UserID, req.OldPassword, req.NewPassword)
 	if err != nil {
+		if errors.Is(err, biz.ErrTooManyLoginAttempts) {
+			return nil, tooManyLoginAttempts(ctx, err)
+		}
 		if errors.Is(err, biz.ErrInvalidCredentials) {
 			return nil, pb.ErrorInvalidCredentials("old password is incorrect")
 		}
@@ -286,6 +330,14 @@ func (s *AuthService) ChangePassword(ctx context.Context, req *pb.ChangePassword
 		return nil, pb.ErrorSystemError("failed to change password")
 	}
 
+	// Đăng xuất khỏi tất cả thiết bị khác nếu user yêu cầu
+	if req.RevokeOtherSessions {
+		if _, err := s.sessionUC.RevokeOtherSessions(ctx, claims.UserID, claims.SessionID, biz.SessionRevokePasswordChange); err != nil {
+			s.log.WithContext(ctx).Errorf("Failed to revoke other sessions: %v", err)
+			return nil, pb.ErrorSystemError("password changed but failed to sign out other sessions")
+		}
+	}
+
 	return &pb.ChangePasswordReply{
 		Message: "Password changed successfully",
 	}, nil
@@ -301,23 +353,691 @@ func (s *AuthService) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.Lo
 		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
 	}
 
-	// Log the logout action
-	s.log.WithContext(ctx).Infof("User %s logged out", claims.UserID)
+	// Thu hồi access token hiện tại
+	if err := auth.RevokeClaims(ctx, s.revocationStore, claims); err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to revoke access token: %v", err)
+		return nil, pb.ErrorSystemError("failed to logout")
+	}
 
-	// TODO: Implement token blacklist with Redis
-	// For now, we just return success
-	// In production, you should:
-	// 1. Add the access token to a blacklist in Redis with TTL = token expiration time
-	// 2. Add the refresh token to the blacklist if provided
-	// 3. Check blacklist in JWT middleware before validating token
+	// Kết thúc session (token family) của access token hiện tại
+	if claims.SessionID != "" {
+		if err := s.sessionUC.RevokeSession(ctx, claims.SessionID, biz.SessionRevokeLogout); err != nil && !errors.Is(err, biz.ErrSessionNotFound) {
+			s.log.WithContext(ctx).Errorf("Failed to revoke session: %v", err)
+			return nil, pb.ErrorSystemError("failed to logout")
+		}
+	}
+
+	// Thu hồi refresh token nếu client gửi lên
+	if req.RefreshToken != "" {
+		refreshClaims, err := auth.ValidateToken(req.RefreshToken, s.keys, auth.RefreshToken)
+		if err != nil {
+			return nil, pb.ErrorJwtTokenInvalid("invalid refresh token: %v", err)
+		}
+		if refreshClaims.UserID != claims.UserID {
+			return nil, pb.ErrorForbidden("refresh token does not belong to current user")
+		}
+		if err := auth.RevokeClaims(ctx, s.revocationStore, refreshClaims); err != nil {
+			s.log.WithContext(ctx).Errorf("Failed to revoke refresh token: %v", err)
+			return nil, pb.ErrorSystemError("failed to logout")
+		}
+		if refreshClaims.SessionID != "" && refreshClaims.SessionID != claims.SessionID {
+			if err := s.sessionUC.RevokeSession(ctx, refreshClaims.SessionID, biz.SessionRevokeLogout); err != nil && !errors.Is(err, biz.ErrSessionNotFound) {
+				s.log.WithContext(ctx).Errorf("Failed to revoke session: %v", err)
+				return nil, pb.ErrorSystemError("failed to logout")
+			}
+		}
+	}
 
-	// Example implementation:
-	// if req.RefreshToken != "" {
-	//     s.authUC.BlacklistToken(ctx, req.RefreshToken)
-	// }
-	// s.authUC.BlacklistToken(ctx, currentAccessToken)
+	// Log the logout action
+	s.log.WithContext(ctx).Infof("User %s logged out", claims.UserID)
 
 	return &pb.LogoutReply{
 		Message: "Logout successful",
 	}, nil
 }
+
+func (s *AuthService) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailReply, error) {
+	s.log.WithContext(ctx).Info("VerifyEmail request")
+
+	if req.Token == "" {
+		return nil, pb.ErrorDataRequestInvalid("token is required")
+	}
+
+	_, err := s.verificationUC.VerifyEmail(ctx, req.Token)
+	if err != nil {
+		if errors.Is(err, biz.ErrInvalidVerificationToken) {
+			return nil, pb.ErrorVerificationTokenInvalid("verification link is invalid or has expired")
+		}
+		if errors.Is(err, biz.ErrEmailAlreadyVerified) {
+			return nil, pb.ErrorEmailAlreadyVerified("email is already verified")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to verify email: %v", err)
+		return nil, pb.ErrorSystemError("failed to verify email")
+	}
+
+	return &pb.VerifyEmailReply{
+		Message: "Email verified successfully",
+	}, nil
+}
+
+func (s *AuthService) ResendVerification(ctx context.Context, req *pb.ResendVerificationRequest) (*pb.ResendVerificationReply, error) {
+	s.log.WithContext(ctx).Info("ResendVerification request")
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	err = s.verificationUC.ResendVerification(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, biz.ErrEmailAlreadyVerified) {
+			return nil, pb.ErrorEmailAlreadyVerified("email is already verified")
+		}
+		if errors.Is(err, biz.ErrUserNotFound) {
+			return nil, pb.ErrorUserNotFound("user not found")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to resend verification email: %v", err)
+		return nil, pb.ErrorSystemError("failed to send verification email")
+	}
+
+	return &pb.ResendVerificationReply{
+		Message: "Verification email sent",
+	}, nil
+}
+
+func (s *AuthService) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetReply, error) {
+	s.log.WithContext(ctx).Infof("RequestPasswordReset request for email: %s", req.Email)
+
+	if req.Email == "" {
+		return nil, pb.ErrorDataRequestInvalid("email is required")
+	}
+	if !s.authUC.ValidateEmail(req.Email) {
+		return nil, pb.ErrorInvalidEmailFormat("invalid email format")
+	}
+
+	// Luôn trả lời giống nhau, dù email có tồn tại hay không
+	if err := s.resetUC.RequestPasswordReset(ctx, req.Email, clientFromContext(ctx).IPAddress); err != nil {
+		if errors.Is(err, biz.ErrTooManyPasswordResetRequests) {
+			retryAfter := setRetryAfter(ctx, err)
+			return nil, pb.ErrorTooManyPasswordResetRequests("too many password reset requests, try again in %d seconds", retryAfter).
+				WithMetadata(map[string]string{"retry_after": strconv.Itoa(retryAfter)})
+		}
+		s.log.WithContext(ctx).Errorf("Failed to request password reset: %v", err)
+		return nil, pb.ErrorSystemError("failed to request password reset")
+	}
+
+	return &pb.RequestPasswordResetReply{
+		Message: "If the email is registered, a password reset link has been sent",
+	}, nil
+}
+
+func (s *AuthService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordReply, error) {
+	s.log.WithContext(ctx).Info("ResetPassword request")
+
+	if req.Token == "" || req.NewPassword == "" {
+		return nil, pb.ErrorDataRequestInvalid("token and new_password are required")
+	}
+
+	err := s.resetUC.ResetPassword(ctx, req.Token, req.NewPassword)
+	if err != nil {
+		if errors.Is(err, biz.ErrWeakPassword) {
+			return nil, pb.ErrorWeakPassword("new password must be at least 8 characters")
+		}
+		if errors.Is(err, biz.ErrInvalidPasswordResetToken) {
+			return nil, pb.ErrorPasswordResetTokenInvalid("reset link is invalid, expired or already used")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to reset password: %v", err)
+		return nil, pb.ErrorSystemError("failed to reset password")
+	}
+
+	return &pb.ResetPasswordReply{
+		Message: "Password has been reset, please login with your new password",
+	}, nil
+}
+
+func (s *AuthService) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPReply, error) {
+	s.log.WithContext(ctx).Info("EnrollTOTP request")
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	secret, uri, err := s.totpUC.Enroll(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, biz.ErrTOTPAlreadyEnabled) {
+			return nil, pb.ErrorTotpAlreadyEnabled("two-factor authentication is already enabled")
+		}
+		if errors.Is(err, biz.ErrUserNotFound) {
+			return nil, pb.ErrorUserNotFound("user not found")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to enroll TOTP: %v", err)
+		return nil, pb.ErrorSystemError("failed to enroll two-factor authentication")
+	}
+
+	return &pb.EnrollTOTPReply{
+		Secret:     secret,
+		OtpauthUri: uri,
+	}, nil
+}
+
+func (s *AuthService) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPReply, error) {
+	s.log.WithContext(ctx).Info("ConfirmTOTP request")
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	if req.Code == "" {
+		return nil, pb.ErrorDataRequestInvalid("code is required")
+	}
+
+	recoveryCodes, err := s.totpUC.Confirm(ctx, claims.UserID, req.Code)
+	if err != nil {
+		if errors.Is(err, biz.ErrTOTPNotEnrolled) {
+			return nil, pb.ErrorTotpNotEnrolled("call enroll before confirming two-factor authentication")
+		}
+		if errors.Is(err, biz.ErrTOTPAlreadyEnabled) {
+			return nil, pb.ErrorTotpAlreadyEnabled("two-factor authentication is already enabled")
+		}
+		if errors.Is(err, biz.ErrInvalidTOTPCode) {
+			return nil, pb.ErrorInvalidTotpCode("invalid code")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to confirm TOTP: %v", err)
+		return nil, pb.ErrorSystemError("failed to enable two-factor authentication")
+	}
+
+	return &pb.ConfirmTOTPReply{
+		RecoveryCodes: recoveryCodes,
+		Message:       "Two-factor authentication enabled. Store the recovery codes in a safe place",
+	}, nil
+}
+
+func (s *AuthService) VerifyLoginTOTP(ctx context.Context, req *pb.VerifyLoginTOTPRequest) (*pb.AuthReply, error) {
+	s.log.WithContext(ctx).Info("VerifyLoginTOTP request")
+
+	// Validate input
+	if req.ChallengeToken == "" || (req.Code == "" && req.RecoveryCode == "") {
+		return nil, pb.ErrorDataRequestInvalid("challenge_token and code or recovery_code are required")
+	}
+
+	user, err := s.totpUC.VerifyLoginChallenge(ctx, req.ChallengeToken, req.Code, req.RecoveryCode)
+	if err != nil {
+		if errors.Is(err, biz.ErrTooManyLoginAttempts) {
+			return nil, tooManyLoginAttempts(ctx, err)
+		}
+		if errors.Is(err, biz.ErrInvalidLoginChallenge) {
+			return nil, pb.ErrorLoginChallengeInvalid("login challenge is invalid or has expired, please login again")
+		}
+		if errors.Is(err, biz.ErrInvalidTOTPCode) {
+			return nil, pb.ErrorInvalidTotpCode("invalid code")
+		}
+		if errors.Is(err, biz.ErrTOTPNotEnrolled) {
+			return nil, pb.ErrorTotpNotEnrolled("two-factor authentication is not enabled")
+		}
+		if errors.Is(err, biz.ErrUserInactive) {
+			return nil, pb.ErrorUnauthorized("user account is inactive")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to verify login challenge: %v", err)
+		return nil, pb.ErrorSystemError("login failed")
+	}
+
+	// Start a new session and generate its token pair
+	tokens, err := s.issueTokens(ctx, user)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to generate tokens: %v", err)
+		return nil, pb.ErrorSystemError("failed to generate authentication tokens")
+	}
+
+	return &pb.AuthReply{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		User: &pb.AuthReply_User{
+			FullName:      user.FullName,
+			Email:         user.Email,
+			PhoneNumber:   user.PhoneNumber,
+			Role:          string(user.Role),
+			EmailVerified: user.EmailVerified,
+		},
+	}, nil
+}
+
+func (s *AuthService) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsReply, error) {
+	s.log.WithContext(ctx).Info("ListSessions request")
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	sessions, err := s.sessionUC.ListSessions(ctx, claims.UserID)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to list sessions: %v", err)
+		return nil, pb.ErrorSystemError("failed to list sessions")
+	}
+
+	pbSessions := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		pbSessions = append(pbSessions, s.toSessionReply(session, claims.SessionID))
+	}
+
+	return &pb.ListSessionsReply{
+		Sessions: pbSessions,
+	}, nil
+}
+
+func (s *AuthService) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionReply, error) {
+	s.log.WithContext(ctx).Infof("RevokeSession request: %s", req.Id)
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	if req.Id == "" {
+		return nil, pb.ErrorDataRequestInvalid("session id is required")
+	}
+
+	err = s.sessionUC.RevokeUserSession(ctx, claims.UserID, req.Id)
+	if err != nil {
+		if errors.Is(err, biz.ErrSessionNotFound) {
+			return nil, pb.ErrorSessionNotFound("session not found")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to revoke session: %v", err)
+		return nil, pb.ErrorSystemError("failed to revoke session")
+	}
+
+	return &pb.RevokeSessionReply{
+		Message: "Session revoked successfully",
+	}, nil
+}
+
+func (s *AuthService) RevokeAllOtherSessions(ctx context.Context, req *pb.RevokeAllOtherSessionsRequest) (*pb.RevokeAllOtherSessionsReply, error) {
+	s.log.WithContext(ctx).Info("RevokeAllOtherSessions request")
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	revoked, err := s.sessionUC.RevokeOtherSessions(ctx, claims.UserID, claims.SessionID, biz.SessionRevokeByUser)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to revoke other sessions: %v", err)
+		return nil, pb.ErrorSystemError("failed to revoke other sessions")
+	}
+
+	return &pb.RevokeAllOtherSessionsReply{
+		RevokedCount: int32(revoked),
+		Message:      "Other sessions revoked successfully",
+	}, nil
+}
+
+func (s *AuthService) StartOAuth(ctx context.Context, req *pb.StartOAuthRequest) (*pb.StartOAuthReply, error) {
+	s.log.WithContext(ctx).Infof("StartOAuth request: %s", req.Provider)
+
+	authURL, state, err := s.oauthUC.Start(ctx, req.Provider)
+	if err != nil {
+		if errors.Is(err, biz.ErrOAuthProviderNotFound) {
+			return nil, pb.ErrorOauthProviderNotFound("unknown provider %q", req.Provider)
+		}
+		s.log.WithContext(ctx).Errorf("Failed to start oauth login: %v", err)
+		return nil, pb.ErrorSystemError("failed to start login")
+	}
+
+	return &pb.StartOAuthReply{
+		AuthorizationUrl: authURL,
+		State:            state,
+	}, nil
+}
+
+func (s *AuthService) OAuthCallback(ctx context.Context, req *pb.OAuthCallbackRequest) (*pb.AuthReply, error) {
+	s.log.WithContext(ctx).Infof("OAuthCallback request: %s", req.Provider)
+
+	// User từ chối hoặc provider báo lỗi
+	if req.Error != "" {
+		return nil, pb.ErrorOauthExchangeFailed("sign in was cancelled or failed: %s", req.Error)
+	}
+	if req.Code == "" || req.State == "" {
+		return nil, pb.ErrorDataRequestInvalid("code and state are required")
+	}
+
+	user, err := s.oauthUC.Callback(ctx, req.Provider, req.Code, req.State)
+	if err != nil {
+		switch {
+		case errors.Is(err, biz.ErrOAuthProviderNotFound):
+			return nil, pb.ErrorOauthProviderNotFound("unknown provider %q", req.Provider)
+		case errors.Is(err, biz.ErrInvalidOAuthState):
+			return nil, pb.ErrorOauthStateInvalid("login request is invalid or has expired, please try again")
+		case errors.Is(err, biz.ErrOAuthExchangeFailed):
+			return nil, pb.ErrorOauthExchangeFailed("sign in with %s failed", req.Provider)
+		case errors.Is(err, biz.ErrOAuthEmailNotVerified):
+			return nil, pb.ErrorOauthEmailNotVerified("your %s email address is not verified", req.Provider)
+		case errors.Is(err, biz.ErrOAuthAccountConflict):
+			return nil, pb.ErrorOauthAccountConflict("an account with this email already exists, login with password and verify your email first")
+		case errors.Is(err, biz.ErrUserInactive):
+			return nil, pb.ErrorUnauthorized("user account is inactive")
+		case errors.Is(err, biz.ErrUserNotFound):
+			return nil, pb.ErrorUserNotFound("user not found")
+		}
+		s.log.WithContext(ctx).Errorf("OAuth login failed: %v", err)
+		return nil, pb.ErrorSystemError("login failed")
+	}
+
+	return s.completeLogin(ctx, user)
+}
+
+func (s *AuthService) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyReply, error) {
+	s.log.WithContext(ctx).Info("CreateAPIKey request")
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	// Validate input
+	if strings.TrimSpace(req.Name) == "" || len(req.Scopes) == 0 {
+		return nil, pb.ErrorDataRequestInvalid("name and scopes are required")
+	}
+	if req.ExpiresInDays < 0 {
+		return nil, pb.ErrorDataRequestInvalid("expires_in_days must not be negative")
+	}
+	var expiresAt *time.Time
+	if req.ExpiresInDays > 0 {
+		t := time.Now().AddDate(0, 0, int(req.ExpiresInDays))
+		expiresAt = &t
+	}
+
+	rawKey, key, err := s.apiKeyUC.CreateAPIKey(ctx, claims.UserID, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		if errors.Is(err, biz.ErrInvalidAPIKeyScope) {
+			return nil, pb.ErrorInvalidApiKeyScope("scopes must be some of: %s", strings.Join(biz.APIKeyScopes, ", "))
+		}
+		if errors.Is(err, biz.ErrTooManyAPIKeys) {
+			return nil, pb.ErrorTooManyApiKeys("at most %d api keys are allowed, revoke an unused key first", biz.MaxAPIKeysPerUser)
+		}
+		s.log.WithContext(ctx).Errorf("Failed to create api key: %v", err)
+		return nil, pb.ErrorSystemError("failed to create api key")
+	}
+
+	return &pb.CreateAPIKeyReply{
+		ApiKey: rawKey,
+		Key:    toAPIKeyReply(key),
+	}, nil
+}
+
+func (s *AuthService) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysReply, error) {
+	s.log.WithContext(ctx).Info("ListAPIKeys request")
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	keys, err := s.apiKeyUC.ListAPIKeys(ctx, claims.UserID)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to list api keys: %v", err)
+		return nil, pb.ErrorSystemError("failed to list api keys")
+	}
+
+	pbKeys := make([]*pb.APIKey, 0, len(keys))
+	for _, key := range keys {
+		pbKeys = append(pbKeys, toAPIKeyReply(key))
+	}
+
+	return &pb.ListAPIKeysReply{
+		Keys: pbKeys,
+	}, nil
+}
+
+func (s *AuthService) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyReply, error) {
+	s.log.WithContext(ctx).Infof("RevokeAPIKey request: %s", req.Id)
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	if req.Id == "" {
+		return nil, pb.ErrorDataRequestInvalid("api key id is required")
+	}
+
+	if err := s.apiKeyUC.RevokeAPIKey(ctx, claims.UserID, req.Id); err != nil {
+		if errors.Is(err, biz.ErrAPIKeyNotFound) {
+			return nil, pb.ErrorApiKeyNotFound("api key not found")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to revoke api key: %v", err)
+		return nil, pb.ErrorSystemError("failed to revoke api key")
+	}
+
+	return &pb.RevokeAPIKeyReply{
+		Message: "API key revoked successfully",
+	}, nil
+}
+
+func (s *AuthService) ExportMyData(ctx context.Context, req *pb.ExportMyDataRequest) (*pb.ExportMyDataReply, error) {
+	s.log.WithContext(ctx).Infof("ExportMyData request: format=%s", req.Format)
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	export, err := s.accountUC.ExportUserData(ctx, claims.UserID, req.Format)
+	if err != nil {
+		if errors.Is(err, biz.ErrInvalidExportFormat) {
+			return nil, pb.ErrorDataRequestInvalid("format must be json or zip")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to export user data: %v", err)
+		return nil, pb.ErrorSystemError("failed to export user data")
+	}
+
+	return &pb.ExportMyDataReply{
+		Filename:    export.Filename,
+		ContentType: export.ContentType,
+		Data:        export.Data,
+	}, nil
+}
+
+func (s *AuthService) DeleteMyAccount(ctx context.Context, req *pb.DeleteMyAccountRequest) (*pb.DeleteMyAccountReply, error) {
+	s.log.WithContext(ctx).Info("DeleteMyAccount request")
+
+	// Lấy claims từ context (được set bởi JWT middleware)
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get claims from context: %v", err)
+		return nil, pb.ErrorJwtTokenInvalid("failed to get user claims: %v", err)
+	}
+
+	// Xác nhận lại mật khẩu trước khi xóa tài khoản
+	if _, err := s.authUC.ConfirmPassword(ctx, claims.UserID, req.Password); err != nil {
+		if errors.Is(err, biz.ErrTooManyLoginAttempts) {
+			return nil, tooManyLoginAttempts(ctx, err)
+		}
+		if errors.Is(err, biz.ErrInvalidCredentials) {
+			return nil, pb.ErrorInvalidCredentials("password is incorrect")
+		}
+		if errors.Is(err, biz.ErrUserNotFound) {
+			return nil, pb.ErrorUserNotFound("user not found")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to confirm password: %v", err)
+		return nil, pb.ErrorSystemError("failed to delete account")
+	}
+
+	purgeAt, err := s.accountUC.DeleteAccount(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, biz.ErrUserNotFound) {
+			return nil, pb.ErrorUserNotFound("user not found")
+		}
+		if errors.Is(err, biz.ErrAccountDeleted) {
+			return nil, pb.ErrorForbidden("account is already scheduled for deletion")
+		}
+		s.log.WithContext(ctx).Errorf("Failed to delete account: %v", err)
+		return nil, pb.ErrorSystemError("failed to delete account")
+	}
+
+	return &pb.DeleteMyAccountReply{
+		PurgeAt: purgeAt.Format("2006-01-02T15:04:05Z07:00"),
+		Message: "Account deleted. Your data will be permanently removed after the grace period",
+	}, nil
+}
+
+// toSessionReply converts biz.Session to pb.Session
+func (s *AuthService) toSessionReply(session *biz.Session, currentSessionID string) *pb.Session {
+	return &pb.Session{
+		Id:         session.ID,
+		UserAgent:  session.UserAgent,
+		IpAddress:  session.IPAddress,
+		CreatedAt:  session.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		LastSeenAt: session.LastSeenAt.Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:  session.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		Current:    session.ID == currentSessionID,
+	}
+}
+
+// completeLogin finishes a first-factor login (password or social): accounts with 2FA
+// get a login challenge, the others a new session and its token pair
+func (s *AuthService) completeLogin(ctx context.Context, user *biz.User) (*pb.AuthReply, error) {
+	// Tài khoản bật 2FA: chưa phát hành token, trả về challenge cho bước 2
+	twoFactorEnabled, err := s.totpUC.IsEnabled(ctx, user.UserID)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to check 2FA: %v", err)
+		return nil, pb.ErrorSystemError("login failed")
+	}
+	if twoFactorEnabled {
+		challenge, err := s.totpUC.IssueLoginChallenge(ctx, user)
+		if err != nil {
+			s.log.WithContext(ctx).Errorf("Failed to issue login challenge: %v", err)
+			return nil, pb.ErrorSystemError("login failed")
+		}
+		return &pb.AuthReply{
+			TwoFactorRequired: true,
+			ChallengeToken:    challenge,
+		}, nil
+	}
+
+	// Start a new session and generate its token pair
+	tokens, err := s.issueTokens(ctx, user)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to generate tokens: %v", err)
+		return nil, pb.ErrorSystemError("failed to generate authentication tokens")
+	}
+
+	return &pb.AuthReply{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		User: &pb.AuthReply_User{
+			FullName:      user.FullName,
+			Email:         user.Email,
+			PhoneNumber:   user.PhoneNumber,
+			Role:          string(user.Role),
+			EmailVerified: user.EmailVerified,
+		},
+	}, nil
+}
+
+// toAPIKeyReply converts biz.APIKey to pb.APIKey (never includes the key itself)
+func toAPIKeyReply(key *biz.APIKey) *pb.APIKey {
+	reply := &pb.APIKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix(),
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if key.ExpiresAt != nil {
+		reply.ExpiresAt = key.ExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if key.LastUsedAt != nil {
+		reply.LastUsedAt = key.LastUsedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return reply
+}
+
+// issueTokens starts a new session (token family) for user and signs its first token pair
+func (s *AuthService) issueTokens(ctx context.Context, user *biz.User) (*auth.TokenPair, error) {
+	session, err := s.sessionUC.CreateSession(ctx, user.UserID, clientFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := auth.NewSessionTokenPair(
+		session.ID,
+		user.UserID,
+		user.Email,
+		user.FullName,
+		user.PhoneNumber,
+		string(user.Role),
+		s.keys,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionUC.SetTokens(ctx, session.ID, issuedTokens(tokens)); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// issuedTokens converts a signed token pair to biz.IssuedTokens
+func issuedTokens(tokens *auth.TokenPair) *biz.IssuedTokens {
+	return &biz.IssuedTokens{
+		RefreshTokenID:   tokens.RefreshTokenID,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
+		AccessTokenID:    tokens.AccessTokenID,
+		AccessExpiresAt:  tokens.AccessExpiresAt,
+	}
+}
+
+// tooManyLoginAttempts builds the lockout error with a retry-after hint,
+// in the error metadata and in the Retry-After header
+func tooManyLoginAttempts(ctx context.Context, err error) error {
+	retryAfter := setRetryAfter(ctx, err)
+	return pb.ErrorTooManyLoginAttempts("too many failed attempts, try again in %d seconds", retryAfter).
+		WithMetadata(map[string]string{"retry_after": strconv.Itoa(retryAfter)})
+}
+
+// setRetryAfter sets the Retry-After header of a lockout error and returns it in seconds
+func setRetryAfter(ctx context.Context, err error) int {
+	retryAfter := 60
+	var locked *biz.LoginLockedError
+	if errors.As(err, &locked) {
+		retryAfter = int(math.Ceil(locked.RetryAfter.Seconds()))
+	}
+
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		tr.ReplyHeader().Set("Retry-After", strconv.Itoa(retryAfter))
+	}
+	return retryAfter
+}
+
+// clientFromContext lấy user agent và IP của client từ request hiện tại
+func clientFromContext(ctx context.Context) *biz.SessionClient {
+	info := biz.RequestInfoFromContext(ctx)
+	return &biz.SessionClient{
+		UserAgent: info.UserAgent,
+		IPAddress: info.IPAddress,
+	}
+}
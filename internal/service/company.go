@@ -28,11 +28,16 @@ func (s *CompanyService) CreateCompany(ctx context.Context, req *pb.CreateCompan
 		FoundedYear: req.FoundedYear,
 	}
 
-	created, err := s.uc.CreateCompany(ctx, company)
+	actor, err := actorFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	created, err := s.uc.CreateCompany(ctx, actor, company)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
 	return s.companyToPb(created), nil
 }
 
@@ -49,26 +54,36 @@ func (s *CompanyService) UpdateCompany(ctx context.Context, req *pb.UpdateCompan
 		FoundedYear: req.FoundedYear,
 	}
 
-	updated, err := s.uc.UpdateCompany(ctx, company)
+	actor, err := actorFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	updated, err := s.uc.UpdateCompany(ctx, actor, company)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
 	return s.companyToPb(updated), nil
 }
 
 func (s *CompanyService) DeleteCompany(ctx context.Context, req *pb.DeleteCompanyRequest) (*pb.DeleteCompanyReply, error) {
-	if err := s.uc.DeleteCompany(ctx, req.Id); err != nil {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	if err := s.uc.DeleteCompany(ctx, actor, req.Id); err != nil {
+		return nil, jobError(err)
+	}
+
 	return &pb.DeleteCompanyReply{Success: true}, nil
 }
 
 func (s *CompanyService) GetCompany(ctx context.Context, req *pb.GetCompanyRequest) (*pb.CompanyReply, error) {
 	company, err := s.uc.GetCompany(ctx, req.Id)
 	if err != nil {
-		return nil, err
+		return nil, jobError(err)
 	}
 
 	return s.companyToPb(company), nil
@@ -99,6 +114,86 @@ func (s *CompanyService) ListCompanies(ctx context.Context, req *pb.ListCompanie
 	}, nil
 }
 
+func (s *CompanyService) InviteCompanyMember(ctx context.Context, req *pb.InviteCompanyMemberRequest) (*pb.CompanyMemberReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.uc.InviteMember(ctx, actor, req.CompanyId, req.Email, biz.MemberRole(req.Role))
+	if err != nil {
+		return nil, jobError(err)
+	}
+
+	return s.memberToPb(member), nil
+}
+
+func (s *CompanyService) AcceptCompanyInvitation(ctx context.Context, req *pb.AcceptCompanyInvitationRequest) (*pb.CompanyMemberReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.uc.AcceptInvitation(ctx, actor, req.CompanyId)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
+	return s.memberToPb(member), nil
+}
+
+func (s *CompanyService) RemoveCompanyMember(ctx context.Context, req *pb.RemoveCompanyMemberRequest) (*pb.RemoveCompanyMemberReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.RemoveMember(ctx, actor, req.CompanyId, req.UserId); err != nil {
+		return nil, jobError(err)
+	}
+
+	return &pb.RemoveCompanyMemberReply{Success: true}, nil
+}
+
+func (s *CompanyService) ListCompanyMembers(ctx context.Context, req *pb.ListCompanyMembersRequest) (*pb.ListCompanyMembersReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.uc.ListMembers(ctx, actor, req.CompanyId)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
+	results := make([]*pb.CompanyMemberReply, 0, len(members))
+	for _, member := range members {
+		results = append(results, s.memberToPb(member))
+	}
+
+	return &pb.ListCompanyMembersReply{Members: results}, nil
+}
+
+// Helper function to convert biz.CompanyMember to pb.CompanyMemberReply
+func (s *CompanyService) memberToPb(member *biz.CompanyMember) *pb.CompanyMemberReply {
+	reply := &pb.CompanyMemberReply{
+		Id:        member.ID,
+		CompanyId: member.CompanyID,
+		UserId:    member.UserID,
+		Email:     member.Email,
+		Role:      string(member.Role),
+		Status:    string(member.Status),
+		InvitedBy: member.InvitedBy,
+		CreatedAt: member.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if member.AcceptedAt != nil {
+		reply.AcceptedAt = member.AcceptedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return reply
+}
+
 // Helper function to convert biz.Company to pb.CompanyReply
 func (s *CompanyService) companyToPb(company *biz.Company) *pb.CompanyReply {
 	return &pb.CompanyReply{
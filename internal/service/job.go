@@ -5,17 +5,20 @@ import (
 	"JobblyBE/internal/biz"
 	"JobblyBE/pkg/middleware/auth"
 	"context"
+	"time"
 )
 
 type JobPostingService struct {
 	pb.UnimplementedJobPostingServer
 	jobPostingUseCase   *biz.JobPostingUseCase
 	userTrackingUseCase *biz.UserTrackingUseCase
+	savedJobUseCase     *biz.SavedJobUseCase
 }
 
-func NewJobPostingService(jobPostingUsecase *biz.JobPostingUseCase, userTrackingUseCase *biz.UserTrackingUseCase) *JobPostingService {
+func NewJobPostingService(jobPostingUsecase *biz.JobPostingUseCase, userTrackingUseCase *biz.UserTrackingUseCase, savedJobUseCase *biz.SavedJobUseCase) *JobPostingService {
 	return &JobPostingService{jobPostingUseCase: jobPostingUsecase,
-		userTrackingUseCase: userTrackingUseCase}
+		userTrackingUseCase: userTrackingUseCase,
+		savedJobUseCase:     savedJobUseCase}
 }
 
 func (s *JobPostingService) CreateJobPosting(ctx context.Context, req *pb.CreateJobPostingRequest) (*pb.JobPostingReply, error) {
@@ -35,12 +38,29 @@ func (s *JobPostingService) CreateJobPosting(ctx context.Context, req *pb.Create
 		Requirements:          req.Requirements,
 		Benefits:              req.Benefits,
 		JobTech:               req.JobTech,
+		Status:                biz.JobStatus(req.Status),
 	}
 
-	created, err := s.jobPostingUseCase.CreateJobPosting(ctx, job)
+	postedAt, err := parseJobTime("posted_at", req.PostedAt)
 	if err != nil {
 		return nil, err
 	}
+	expiresAt, err := parseJobTime("expires_at", req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	job.PostedAt = postedAt
+	job.ExpiresAt = expiresAt
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.jobPostingUseCase.CreateJobPosting(ctx, actor, job)
+	if err != nil {
+		return nil, jobError(err)
+	}
 
 	return s.jobToPb(created), nil
 }
@@ -63,40 +83,98 @@ func (s *JobPostingService) UpdateJobPosting(ctx context.Context, req *pb.Update
 		JobTech:               req.JobTech,
 	}
 
-	updated, err := s.jobPostingUseCase.UpdateJobPosting(ctx, job)
+	postedAt, err := parseJobTime("posted_at", req.PostedAt)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := parseJobTime("expires_at", req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	job.PostedAt = postedAt
+	job.ExpiresAt = expiresAt
+
+	actor, err := actorFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	updated, err := s.jobPostingUseCase.UpdateJobPosting(ctx, actor, job)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
 	return s.jobToPb(updated), nil
 }
 
 func (s *JobPostingService) DeleteJobPosting(ctx context.Context, req *pb.DeleteJobPostingRequest) (*pb.DeleteJobPostingReply, error) {
-	if err := s.jobPostingUseCase.DeleteJobPosting(ctx, req.Id); err != nil {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	if err := s.jobPostingUseCase.DeleteJobPosting(ctx, actor, req.Id); err != nil {
+		return nil, jobError(err)
+	}
+
 	return &pb.DeleteJobPostingReply{Message: "Job posting deleted successfully"}, nil
 }
 
 func (s *JobPostingService) GetJobPosting(ctx context.Context, req *pb.GetJobPostingRequest) (*pb.JobPostingReply, error) {
-	job, err := s.jobPostingUseCase.GetJobPosting(ctx, req.Id)
+	actor := optionalActorFromContext(ctx)
+	job, err := s.jobPostingUseCase.GetJobPosting(ctx, actor, req.Id)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
+	saved, err := s.savedJobUseCase.SavedJobIDs(ctx, actor, []*biz.JobPosting{job})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := s.jobToPb(job)
+	reply.IsSaved = saved[job.ID]
+	return reply, nil
+}
+
+func (s *JobPostingService) PublishJobPosting(ctx context.Context, req *pb.ChangeJobStatusRequest) (*pb.JobPostingReply, error) {
+	return s.changeJobStatus(ctx, req.Id, s.jobPostingUseCase.PublishJobPosting)
+}
+
+func (s *JobPostingService) PauseJobPosting(ctx context.Context, req *pb.ChangeJobStatusRequest) (*pb.JobPostingReply, error) {
+	return s.changeJobStatus(ctx, req.Id, s.jobPostingUseCase.PauseJobPosting)
+}
+
+func (s *JobPostingService) CloseJobPosting(ctx context.Context, req *pb.ChangeJobStatusRequest) (*pb.JobPostingReply, error) {
+	return s.changeJobStatus(ctx, req.Id, s.jobPostingUseCase.CloseJobPosting)
+}
+
+func (s *JobPostingService) changeJobStatus(ctx context.Context, id string, change func(context.Context, *biz.Actor, string) (*biz.JobPosting, error)) (*pb.JobPostingReply, error) {
+	actor, err := actorFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	job, err := change(ctx, actor, id)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
 	return s.jobToPb(job), nil
 }
 
 func (s *JobPostingService) ListJobPostings(ctx context.Context, req *pb.ListJobPostingsRequest) (*pb.ListJobPostingsReply, error) {
 
 	filter := &biz.JobFilter{
-		CompanyID: req.CompanyId,
-		Location:  req.Location,
-		JobType:   biz.JobType(req.JobType),
-		Level:     biz.Level(req.Level),
-		Keyword:   req.Keyword,
-		JobTech:   req.JobTech,
+		CompanyID:    req.CompanyId,
+		Location:     req.Location,
+		JobType:      biz.JobType(req.JobType),
+		Level:        biz.Level(req.Level),
+		Keyword:      req.Keyword,
+		JobTech:      req.JobTech,
+		Status:       biz.JobStatus(req.Status),
+		Sort:         biz.JobSort(req.Sort),
+		SalaryBucket: req.SalaryBucket,
 	}
 
 	claims, err := auth.GetClaimsFromContext(ctx)
@@ -107,21 +185,120 @@ func (s *JobPostingService) ListJobPostings(ctx context.Context, req *pb.ListJob
 		}
 	}
 
-	jobs, total, err := s.jobPostingUseCase.ListJobPostings(ctx, filter, int32(req.Page), int32(req.PageSize))
+	actor := optionalActorFromContext(ctx)
+	jobs, total, err := s.jobPostingUseCase.ListJobPostings(ctx, actor, filter, int32(req.Page), int32(req.PageSize))
+	if err != nil {
+		return nil, jobError(err)
+	}
+
+	saved, err := s.savedJobUseCase.SavedJobIDs(ctx, actor, jobs)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make([]*pb.JobPostingReply, 0, len(jobs))
 	for _, job := range jobs {
-		results = append(results, s.jobToPb(job))
+		reply := s.jobToPb(job)
+		reply.IsSaved = saved[job.ID]
+		results = append(results, reply)
 	}
 
-	return &pb.ListJobPostingsReply{
+	reply := &pb.ListJobPostingsReply{
 		Jobs:     results,
 		Total:    int32(total),
 		Page:     req.Page,
 		PageSize: req.PageSize,
+	}
+
+	if req.IncludeFacets {
+		facets, err := s.jobPostingUseCase.JobFacets(ctx, actor, filter)
+		if err != nil {
+			return nil, jobError(err)
+		}
+		reply.Facets = &pb.JobFacets{
+			Level:        facetCountsToPb(facets.Level),
+			JobType:      facetCountsToPb(facets.JobType),
+			Location:     facetCountsToPb(facets.Location),
+			JobTech:      facetCountsToPb(facets.JobTech),
+			Company:      facetCountsToPb(facets.Company),
+			SalaryBucket: facetCountsToPb(facets.SalaryBucket),
+		}
+	}
+
+	return reply, nil
+}
+
+func facetCountsToPb(counts []biz.FacetCount) []*pb.FacetCount {
+	results := make([]*pb.FacetCount, 0, len(counts))
+	for _, c := range counts {
+		results = append(results, &pb.FacetCount{Value: c.Value, Label: c.Label, Count: c.Count})
+	}
+	return results
+}
+
+func (s *JobPostingService) SaveJob(ctx context.Context, req *pb.SaveJobRequest) (*pb.JobPostingReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.savedJobUseCase.SaveJob(ctx, actor, req.Id)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
+	reply := s.jobToPb(job)
+	reply.IsSaved = true
+	return reply, nil
+}
+
+func (s *JobPostingService) UnsaveJob(ctx context.Context, req *pb.UnsaveJobRequest) (*pb.UnsaveJobReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.savedJobUseCase.UnsaveJob(ctx, actor, req.Id); err != nil {
+		return nil, jobError(err)
+	}
+
+	return &pb.UnsaveJobReply{Message: "Job removed from saved jobs"}, nil
+}
+
+func (s *JobPostingService) ListSavedJobs(ctx context.Context, req *pb.ListSavedJobsRequest) (*pb.ListSavedJobsReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, total, err := s.savedJobUseCase.ListSavedJobs(ctx, actor, req.Page, req.PageSize)
+	if err != nil {
+		return nil, jobError(err)
+	}
+
+	results := make([]*pb.SavedJobReply, 0, len(saved))
+	for _, item := range saved {
+		job := s.jobToPb(item.Job)
+		job.IsSaved = true
+		results = append(results, &pb.SavedJobReply{
+			Job:     job,
+			SavedAt: item.SavedAt.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return &pb.ListSavedJobsReply{
+		SavedJobs: results,
+		Total:     total,
+		Page:      page,
+		PageSize:  pageSize,
 	}, nil
 }
 
@@ -144,11 +321,19 @@ func (s *JobPostingService) jobToPb(job *biz.JobPosting) *pb.JobPostingReply {
 		Benefits:              job.Benefits,
 		JobTech:               job.JobTech,
 		CreatedAt:             job.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Status:                string(job.CurrentStatus(time.Now())),
 	}
 
 	if job.PostedAt != nil {
 		reply.PostedAt = job.PostedAt.Format("2006-01-02T15:04:05Z07:00")
 	}
+	if job.ExpiresAt != nil {
+		reply.ExpiresAt = job.ExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	for _, h := range job.Highlights {
+		reply.Highlights = append(reply.Highlights, &pb.JobHighlight{Field: h.Field, Snippet: h.Snippet})
+	}
 
 	if job.Company != nil {
 		reply.Company = &pb.CompanyInfo{
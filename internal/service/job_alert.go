@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "JobblyBE/api/job/v1"
+	"JobblyBE/internal/biz"
+)
+
+type JobAlertService struct {
+	pb.UnimplementedJobAlertServer
+	uc *biz.JobAlertUseCase
+}
+
+func NewJobAlertService(uc *biz.JobAlertUseCase) *JobAlertService {
+	return &JobAlertService{uc: uc}
+}
+
+func (s *JobAlertService) CreateSavedSearch(ctx context.Context, req *pb.CreateSavedSearchRequest) (*pb.SavedSearchReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := s.uc.CreateSavedSearch(ctx, actor, savedSearchFromPb(req.Name, req.Filter, req.Frequency, req.Channels))
+	if err != nil {
+		return nil, jobAlertError(err)
+	}
+
+	return s.savedSearchToPb(search), nil
+}
+
+func (s *JobAlertService) ListSavedSearches(ctx context.Context, req *pb.ListSavedSearchesRequest) (*pb.ListSavedSearchesReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searches, err := s.uc.ListSavedSearches(ctx, actor)
+	if err != nil {
+		return nil, jobAlertError(err)
+	}
+
+	results := make([]*pb.SavedSearchReply, 0, len(searches))
+	for _, search := range searches {
+		results = append(results, s.savedSearchToPb(search))
+	}
+
+	return &pb.ListSavedSearchesReply{SavedSearches: results}, nil
+}
+
+func (s *JobAlertService) GetSavedSearch(ctx context.Context, req *pb.GetSavedSearchRequest) (*pb.SavedSearchReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := s.uc.GetSavedSearch(ctx, actor, req.Id)
+	if err != nil {
+		return nil, jobAlertError(err)
+	}
+
+	return s.savedSearchToPb(search), nil
+}
+
+func (s *JobAlertService) UpdateSavedSearch(ctx context.Context, req *pb.UpdateSavedSearchRequest) (*pb.SavedSearchReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search := savedSearchFromPb(req.Name, req.Filter, req.Frequency, req.Channels)
+	search.ID = req.Id
+
+	updated, err := s.uc.UpdateSavedSearch(ctx, actor, search)
+	if err != nil {
+		return nil, jobAlertError(err)
+	}
+
+	return s.savedSearchToPb(updated), nil
+}
+
+func (s *JobAlertService) DeleteSavedSearch(ctx context.Context, req *pb.DeleteSavedSearchRequest) (*pb.DeleteSavedSearchReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteSavedSearch(ctx, actor, req.Id); err != nil {
+		return nil, jobAlertError(err)
+	}
+
+	return &pb.DeleteSavedSearchReply{Message: "Saved search deleted successfully"}, nil
+}
+
+func (s *JobAlertService) MuteSavedSearch(ctx context.Context, req *pb.MuteSavedSearchRequest) (*pb.SavedSearchReply, error) {
+	return s.setMuted(ctx, req.Id, true)
+}
+
+func (s *JobAlertService) UnmuteSavedSearch(ctx context.Context, req *pb.MuteSavedSearchRequest) (*pb.SavedSearchReply, error) {
+	return s.setMuted(ctx, req.Id, false)
+}
+
+func (s *JobAlertService) setMuted(ctx context.Context, id string, muted bool) (*pb.SavedSearchReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := s.uc.MuteSavedSearch(ctx, actor, id, muted)
+	if err != nil {
+		return nil, jobAlertError(err)
+	}
+
+	return s.savedSearchToPb(search), nil
+}
+
+func (s *JobAlertService) UnsubscribeJobAlert(ctx context.Context, req *pb.UnsubscribeJobAlertRequest) (*pb.UnsubscribeJobAlertReply, error) {
+	search, err := s.uc.Unsubscribe(ctx, req.Token)
+	if err != nil {
+		return nil, jobAlertError(err)
+	}
+
+	return &pb.UnsubscribeJobAlertReply{
+		Message:         "You will no longer receive alerts for this saved search",
+		SavedSearchName: search.Name,
+	}, nil
+}
+
+// jobAlertError maps saved search biz errors to API errors
+func jobAlertError(err error) error {
+	switch {
+	case errors.Is(err, biz.ErrSavedSearchNotFound):
+		return pb.ErrorSavedSearchNotFound("saved search not found")
+	case errors.Is(err, biz.ErrInvalidSavedSearch):
+		return pb.ErrorInvalidSavedSearch("name (at most %d characters), a non-empty filter, frequency and channels are required", biz.MaxSavedSearchNameLength)
+	case errors.Is(err, biz.ErrTooManySavedSearches):
+		return pb.ErrorTooManySavedSearches("a user can have at most %d saved searches", biz.MaxSavedSearches)
+	case errors.Is(err, biz.ErrInvalidUnsubscribeToken):
+		return pb.ErrorInvalidUnsubscribeToken("invalid unsubscribe link")
+	}
+	return err
+}
+
+func savedSearchFromPb(name string, filter *pb.SavedSearchFilter, frequency string, channels []string) *biz.SavedSearch {
+	search := &biz.SavedSearch{
+		Name:      name,
+		Frequency: biz.AlertFrequency(frequency),
+		Filter:    &biz.JobFilter{},
+	}
+	if filter != nil {
+		search.Filter = &biz.JobFilter{
+			CompanyID: filter.CompanyId,
+			Location:  filter.Location,
+			JobType:   biz.JobType(filter.JobType),
+			Level:     biz.Level(filter.Level),
+			Keyword:   filter.Keyword,
+			JobTech:   filter.JobTech,
+		}
+	}
+	for _, c := range channels {
+		search.Channels = append(search.Channels, biz.AlertChannel(c))
+	}
+	return search
+}
+
+// Helper function to convert biz.SavedSearch to pb.SavedSearchReply
+func (s *JobAlertService) savedSearchToPb(search *biz.SavedSearch) *pb.SavedSearchReply {
+	reply := &pb.SavedSearchReply{
+		Id:        search.ID,
+		Name:      search.Name,
+		Frequency: string(search.Frequency),
+		Muted:     search.Muted,
+		CreatedAt: search.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: search.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if f := search.Filter; f != nil {
+		reply.Filter = &pb.SavedSearchFilter{
+			CompanyId: f.CompanyID,
+			Location:  f.Location,
+			JobType:   string(f.JobType),
+			Level:     string(f.Level),
+			Keyword:   f.Keyword,
+			JobTech:   f.JobTech,
+		}
+	}
+	for _, c := range search.Channels {
+		reply.Channels = append(reply.Channels, string(c))
+	}
+	if search.LastNotifiedAt != nil {
+		reply.LastNotifiedAt = search.LastNotifiedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return reply
+}
+
+type NotificationService struct {
+	pb.UnimplementedNotificationServer
+	uc *biz.NotificationUseCase
+}
+
+func NewNotificationService(uc *biz.NotificationUseCase) *NotificationService {
+	return &NotificationService{uc: uc}
+}
+
+func (s *NotificationService) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, total, unread, err := s.uc.ListNotifications(ctx, actor, req.UnreadOnly, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.NotificationReply, 0, len(notifications))
+	for _, n := range notifications {
+		reply := &pb.NotificationReply{
+			Id:        n.ID,
+			Type:      string(n.Type),
+			Title:     n.Title,
+			Body:      n.Body,
+			Data:      n.Data,
+			Read:      n.ReadAt != nil,
+			CreatedAt: n.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if n.ReadAt != nil {
+			reply.ReadAt = n.ReadAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		results = append(results, reply)
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return &pb.ListNotificationsReply{
+		Notifications: results,
+		Total:         total,
+		Unread:        unread,
+		Page:          page,
+		PageSize:      pageSize,
+	}, nil
+}
+
+func (s *NotificationService) MarkNotificationRead(ctx context.Context, req *pb.MarkNotificationReadRequest) (*pb.MarkNotificationReadReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.MarkNotificationRead(ctx, actor, req.Id); err != nil {
+		if errors.Is(err, biz.ErrNotificationNotFound) {
+			return nil, pb.ErrorNotificationNotFound("notification not found")
+		}
+		return nil, err
+	}
+
+	return &pb.MarkNotificationReadReply{Message: "Notification marked as read"}, nil
+}
+
+func (s *NotificationService) MarkAllNotificationsRead(ctx context.Context, req *pb.MarkAllNotificationsReadRequest) (*pb.MarkNotificationReadReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.MarkAllNotificationsRead(ctx, actor); err != nil {
+		return nil, err
+	}
+
+	return &pb.MarkNotificationReadReply{Message: "All notifications marked as read"}, nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+
+	pb "JobblyBE/api/job/v1"
+	"JobblyBE/internal/biz"
+)
+
+type HiringPipelineService struct {
+	pb.UnimplementedHiringPipelineServer
+	uc *biz.ApplicationUseCase
+}
+
+func NewHiringPipelineService(uc *biz.ApplicationUseCase) *HiringPipelineService {
+	return &HiringPipelineService{uc: uc}
+}
+
+func (s *HiringPipelineService) GetHiringPipeline(ctx context.Context, req *pb.GetHiringPipelineRequest) (*pb.HiringPipelineReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := s.uc.GetHiringPipeline(ctx, actor, req.CompanyId)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	return s.pipelineToPb(pipeline), nil
+}
+
+func (s *HiringPipelineService) UpdateHiringPipeline(ctx context.Context, req *pb.UpdateHiringPipelineRequest) (*pb.HiringPipelineReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stages := make([]*biz.PipelineStage, 0, len(req.Stages))
+	for _, stage := range req.Stages {
+		stages = append(stages, &biz.PipelineStage{
+			Key:         stage.Key,
+			Name:        stage.Name,
+			Transitions: stage.Transitions,
+		})
+	}
+
+	pipeline, err := s.uc.UpdateHiringPipeline(ctx, actor, req.CompanyId, stages)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	return s.pipelineToPb(pipeline), nil
+}
+
+func (s *HiringPipelineService) GetJobPipelineStats(ctx context.Context, req *pb.GetJobPipelineStatsRequest) (*pb.JobPipelineStatsReply, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.uc.GetJobPipelineStats(ctx, actor, req.JobId)
+	if err != nil {
+		return nil, applicationError(err)
+	}
+
+	reply := &pb.JobPipelineStatsReply{
+		JobId:     stats.JobID,
+		Withdrawn: stats.Withdrawn,
+		Total:     stats.Total,
+	}
+	for _, stage := range stats.Stages {
+		reply.Stages = append(reply.Stages, &pb.StageCount{
+			Stage: stage.Stage,
+			Name:  stage.Name,
+			Count: stage.Count,
+		})
+	}
+
+	return reply, nil
+}
+
+// Helper function to convert biz.HiringPipeline to pb.HiringPipelineReply
+func (s *HiringPipelineService) pipelineToPb(p *biz.HiringPipeline) *pb.HiringPipelineReply {
+	reply := &pb.HiringPipelineReply{
+		CompanyId: p.CompanyID,
+		IsDefault: p.UpdatedAt == nil,
+		UpdatedBy: p.UpdatedBy,
+	}
+	for _, stage := range p.Stages {
+		reply.Stages = append(reply.Stages, &pb.PipelineStage{
+			Key:         stage.Key,
+			Name:        stage.Name,
+			Transitions: stage.Transitions,
+		})
+	}
+	if p.UpdatedAt != nil {
+		reply.UpdatedAt = p.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return reply
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	pb "JobblyBE/api/admin/v1"
+	authv1 "JobblyBE/api/auth/v1"
+	"JobblyBE/pkg/scheduler"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type SchedulerService struct {
+	pb.UnimplementedSchedulerServer
+	scheduler *scheduler.Scheduler
+	log       *log.Helper
+}
+
+func NewSchedulerService(s *scheduler.Scheduler, logger log.Logger) *SchedulerService {
+	return &SchedulerService{
+		scheduler: s,
+		log:       log.NewHelper(logger),
+	}
+}
+
+func (s *SchedulerService) ListSchedulerTasks(ctx context.Context, req *pb.ListSchedulerTasksRequest) (*pb.ListSchedulerTasksReply, error) {
+	statuses, err := s.scheduler.Status(ctx)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to get scheduler status: %v", err)
+		return nil, authv1.ErrorSystemError("failed to get scheduler status")
+	}
+
+	tasks := make([]*pb.SchedulerTask, 0, len(statuses))
+	for _, status := range statuses {
+		tasks = append(tasks, toSchedulerTask(status))
+	}
+
+	return &pb.ListSchedulerTasksReply{Tasks: tasks}, nil
+}
+
+// toSchedulerTask converts scheduler.TaskStatus to pb.SchedulerTask
+func toSchedulerTask(status *scheduler.TaskStatus) *pb.SchedulerTask {
+	task := &pb.SchedulerTask{
+		Name:     status.Name,
+		Schedule: status.Schedule,
+		Disabled: status.Disabled,
+		Running:  status.Running,
+		Owner:    status.Owner,
+	}
+	if !status.NextRunAt.IsZero() {
+		task.NextRunAt = status.NextRunAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	if run := status.LastRun; run != nil {
+		task.LastRun = &pb.SchedulerRun{
+			Owner:       run.Owner,
+			ScheduledAt: run.Slot.Format("2006-01-02T15:04:05Z07:00"),
+			StartedAt:   run.StartedAt.Format("2006-01-02T15:04:05Z07:00"),
+			FinishedAt:  run.FinishedAt.Format("2006-01-02T15:04:05Z07:00"),
+			DurationMs:  run.FinishedAt.Sub(run.StartedAt).Milliseconds(),
+			Processed:   int32(run.Processed),
+			Status:      "SUCCEEDED",
+			Error:       run.Error,
+		}
+		if !run.Succeeded() {
+			task.LastRun.Status = "FAILED"
+		}
+	}
+
+	return task
+}
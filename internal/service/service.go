@@ -9,4 +9,11 @@ var ProviderSet = wire.NewSet(
 	NewJobPostingService,
 	NewCompanyService,
 	NewResumeService,
+	NewUserAdminService,
+	NewAuditLogService,
+	NewSchedulerService,
+	NewApplicationService,
+	NewHiringPipelineService,
+	NewJobAlertService,
+	NewNotificationService,
 )
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "JobblyBE/api/admin/v1"
+	authv1 "JobblyBE/api/auth/v1"
+	"JobblyBE/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type UserAdminService struct {
+	pb.UnimplementedUserAdminServer
+	userAdminUC *biz.UserAdminUseCase
+	log         *log.Helper
+}
+
+func NewUserAdminService(userAdminUC *biz.UserAdminUseCase, logger log.Logger) *UserAdminService {
+	return &UserAdminService{
+		userAdminUC: userAdminUC,
+		log:         log.NewHelper(logger),
+	}
+}
+
+func (s *UserAdminService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersReply, error) {
+	s.log.WithContext(ctx).Infof("ListUsers request: page=%d, page_size=%d", req.Page, req.PageSize)
+
+	filter := &biz.UserFilter{
+		Keyword: req.Keyword,
+		Role:    biz.Role(req.Role),
+	}
+	switch req.Status {
+	case "":
+	case "active", "inactive":
+		active := req.Status == "active"
+		filter.Active = &active
+	default:
+		return nil, authv1.ErrorDataRequestInvalid("status must be active or inactive")
+	}
+
+	users, total, err := s.userAdminUC.ListUsers(ctx, filter, req.Page, req.PageSize)
+	if err != nil {
+		return nil, s.adminError(ctx, err)
+	}
+
+	pbUsers := make([]*pb.AdminUserReply, 0, len(users))
+	for _, user := range users {
+		pbUsers = append(pbUsers, toAdminUserReply(user))
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	return &pb.ListUsersReply{
+		Users:    pbUsers,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+func (s *UserAdminService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.AdminUserReply, error) {
+	s.log.WithContext(ctx).Infof("GetUser request: %s", req.Id)
+
+	user, err := s.userAdminUC.GetUser(ctx, req.Id)
+	if err != nil {
+		return nil, s.adminError(ctx, err)
+	}
+
+	return toAdminUserReply(user), nil
+}
+
+func (s *UserAdminService) SetUserActive(ctx context.Context, req *pb.SetUserActiveRequest) (*pb.AdminUserReply, error) {
+	s.log.WithContext(ctx).Infof("SetUserActive request: %s -> %v", req.Id, req.Active)
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userAdminUC.SetActive(ctx, actor, req.Id, req.Active, req.Reason)
+	if err != nil {
+		return nil, s.adminError(ctx, err)
+	}
+
+	return toAdminUserReply(user), nil
+}
+
+func (s *UserAdminService) ChangeUserRole(ctx context.Context, req *pb.ChangeUserRoleRequest) (*pb.AdminUserReply, error) {
+	s.log.WithContext(ctx).Infof("ChangeUserRole request: %s -> %s", req.Id, req.Role)
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userAdminUC.ChangeRole(ctx, actor, req.Id, biz.Role(req.Role), req.Reason)
+	if err != nil {
+		return nil, s.adminError(ctx, err)
+	}
+
+	return toAdminUserReply(user), nil
+}
+
+func (s *UserAdminService) ForceLogoutUser(ctx context.Context, req *pb.ForceLogoutUserRequest) (*pb.ForceLogoutUserReply, error) {
+	s.log.WithContext(ctx).Infof("ForceLogoutUser request: %s", req.Id)
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.userAdminUC.ForceLogout(ctx, actor, req.Id, req.Reason)
+	if err != nil {
+		return nil, s.adminError(ctx, err)
+	}
+
+	return &pb.ForceLogoutUserReply{
+		RevokedSessions: int32(revoked),
+		Message:         "User sessions revoked successfully",
+	}, nil
+}
+
+func (s *UserAdminService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserReply, error) {
+	s.log.WithContext(ctx).Infof("DeleteUser request: %s", req.Id)
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userAdminUC.DeleteUser(ctx, actor, req.Id, req.Reason); err != nil {
+		return nil, s.adminError(ctx, err)
+	}
+
+	return &pb.DeleteUserReply{
+		Message: "User deleted successfully",
+	}, nil
+}
+
+func (s *UserAdminService) Impersonate(ctx context.Context, req *pb.ImpersonateRequest) (*pb.ImpersonateReply, error) {
+	s.log.WithContext(ctx).Infof("Impersonate request: %s", req.Id)
+
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, authv1.ErrorDataRequestInvalid("reason is required")
+	}
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	impersonation, err := s.userAdminUC.Impersonate(ctx, actor, req.Id, req.Reason)
+	if err != nil {
+		return nil, s.adminError(ctx, err)
+	}
+
+	return &pb.ImpersonateReply{
+		AccessToken: impersonation.AccessToken,
+		ExpiresAt:   impersonation.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		User:        toAdminUserReply(impersonation.User),
+	}, nil
+}
+
+// adminError maps user admin biz errors to API errors
+func (s *UserAdminService) adminError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, biz.ErrUserNotFound):
+		return authv1.ErrorUserNotFound("user not found")
+	case errors.Is(err, biz.ErrInvalidRole):
+		return authv1.ErrorInvalidRole("role must be USER, RECRUITER or ADMIN")
+	case errors.Is(err, biz.ErrCannotModifySelf):
+		return authv1.ErrorCannotModifySelf("admins can not deactivate, demote or delete themselves")
+	case errors.Is(err, biz.ErrCannotImpersonate):
+		return authv1.ErrorCannotImpersonate("admins can not impersonate themselves or other admins")
+	case errors.Is(err, biz.ErrUserInactive):
+		return authv1.ErrorForbidden("user account is inactive")
+	}
+	s.log.WithContext(ctx).Errorf("User admin operation failed: %v", err)
+	return authv1.ErrorSystemError("user admin operation failed")
+}
+
+// toAdminUserReply converts biz.User to pb.AdminUserReply
+func toAdminUserReply(user *biz.User) *pb.AdminUserReply {
+	reply := &pb.AdminUserReply{
+		Id:            user.UserID,
+		FullName:      user.FullName,
+		Email:         user.Email,
+		PhoneNumber:   user.PhoneNumber,
+		Role:          string(user.Role),
+		Active:        user.Active,
+		EmailVerified: user.EmailVerified,
+		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if user.LastLogin != nil {
+		reply.LastLogin = user.LastLogin.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return reply
+}
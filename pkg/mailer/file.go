@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// FileMailer không gửi email thật: ghi email ra file .eml trong dir,
+// hoặc chỉ log ra nếu dir rỗng. Dùng cho local development.
+type FileMailer struct {
+	dir  string
+	from string
+	log  *log.Helper
+}
+
+// NewFileMailer creates a mailer writing messages to dir (or to the log when dir is empty)
+func NewFileMailer(c Config, logger log.Logger) *FileMailer {
+	return &FileMailer{
+		dir:  c.Dir,
+		from: c.From,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Send writes msg to a file or the log
+func (m *FileMailer) Send(ctx context.Context, msg *Message) error {
+	if len(msg.To) == 0 {
+		return ErrNoRecipient
+	}
+
+	if m.dir == "" {
+		m.log.WithContext(ctx).Infof("mail to %s: %s\n%s", strings.Join(msg.To, ", "), msg.Subject, msg.TextBody)
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create mail dir: %w", err)
+	}
+
+	name := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102T150405.000000000"), strings.NewReplacer("@", "_at_", "/", "_").Replace(msg.To[0]))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, buildMessage(m.from, msg), 0o644); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+
+	m.log.WithContext(ctx).Infof("mail to %s written to %s", strings.Join(msg.To, ", "), path)
+	return nil
+}
@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrNoRecipient = errors.New("mail has no recipient")
+
+// Message là một email cần gửi
+type Message struct {
+	To       []string
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// Mailer gửi email. Có thể thay thế implementation (SMTP, file/log, ...)
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Driver names
+const (
+	DriverSMTP = "smtp"
+	DriverFile = "file"
+	DriverLog  = "log"
+)
+
+// Config cấu hình chung cho các mailer
+type Config struct {
+	Driver   string
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	Dir      string // thư mục lưu email của file driver
+	Timeout  time.Duration
+}
+
+// New creates the mailer selected by c.Driver. Unknown drivers fall back to the log mailer.
+func New(c Config, logger log.Logger) Mailer {
+	switch c.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(c)
+	case DriverFile:
+		return NewFileMailer(c, logger)
+	default:
+		c.Dir = ""
+		return NewFileMailer(c, logger)
+	}
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var ErrImpersonationForbidden = errors.Forbidden("AUTH_IMPERSONATION_FORBIDDEN", "this operation is not allowed while impersonating a user")
+
+// LogImpersonation logs every request made with an impersonation token,
+// with both the admin (actor) and the impersonated user (subject).
+// Đặt trước Authorize để cả các request bị từ chối cũng được ghi log.
+func LogImpersonation(keys *KeySet, logger log.Logger) middleware.Middleware {
+	helper := log.NewHelper(logger)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			// Chỉ đọc claims để ghi log, việc xác thực do Authorize đảm nhận
+			token, err := extractToken(ctx)
+			if err != nil {
+				return handler(ctx, req)
+			}
+			claims, err := ValidateAccessToken(token, keys)
+			if err != nil || !claims.IsImpersonated() {
+				return handler(ctx, req)
+			}
+
+			var operation string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				operation = tr.Operation()
+			}
+
+			reply, err := handler(ctx, req)
+
+			code := int32(200)
+			if err != nil {
+				code = errors.FromError(err).Code
+			}
+			helper.WithContext(ctx).Infow(
+				"msg", "impersonated request",
+				"operation", operation,
+				"actor_id", claims.Actor.UserID,
+				"actor_email", claims.Actor.Email,
+				"subject_id", claims.UserID,
+				"token_id", claims.ID,
+				"code", code,
+			)
+
+			return reply, err
+		}
+	}
+}
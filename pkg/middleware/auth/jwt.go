@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -12,6 +14,10 @@ import (
 const (
 	AccessTokenDuration  = 24 * time.Hour     // Access token expires in 1 hour
 	RefreshTokenDuration = 7 * 24 * time.Hour // Refresh token expires in 7 days
+
+	EmailVerificationTokenDuration = 24 * time.Hour   // Link xác thực email hết hạn sau 1 ngày
+	LoginChallengeTokenDuration    = 5 * time.Minute  // Thời gian nhập mã 2FA sau khi đúng mật khẩu
+	ImpersonationTokenDuration     = 15 * time.Minute // Token admin mạo danh user, không có refresh token
 )
 
 var (
@@ -19,14 +25,17 @@ var (
 	ErrExpiredToken     = errors.New("token has expired")
 	ErrInvalidTokenType = errors.New("invalid token type")
 	ErrMissingClaims    = errors.New("missing claims in token")
+	ErrRevokedToken     = errors.New("token has been revoked")
 )
 
 // TokenType định nghĩa loại token
 type TokenType string
 
 const (
-	AccessToken  TokenType = "access"
-	RefreshToken TokenType = "refresh"
+	AccessToken            TokenType = "access"
+	RefreshToken           TokenType = "refresh"
+	EmailVerificationToken TokenType = "email_verification"
+	LoginChallengeToken    TokenType = "login_challenge"
 )
 
 // JWTClaims chứa thông tin trong JWT token
@@ -37,36 +46,39 @@ type JWTClaims struct {
 	PhoneNumber string    `json:"phone_number"`
 	Role        string    `json:"role"`
 	TokenType   TokenType `json:"token_type"`
+	SessionID   string    `json:"sid,omitempty"` // token family (một lần đăng nhập)
+	// Chỉ có khi xác thực bằng API key (TokenType = api_key), không bao giờ nằm trong JWT
+	APIKeyID string   `json:"-"`
+	Scopes   []string `json:"-"`
+	// Actor là admin đang mạo danh user (RFC 8693 "act"), nil với token thường
+	Actor *ActorClaim `json:"act,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// ActorClaim identifies who is acting on behalf of the token subject
+type ActorClaim struct {
+	UserID string `json:"sub"`
+	Email  string `json:"email,omitempty"`
+}
+
+// IsImpersonated reports whether the token was issued to an admin acting as the user
+func (c *JWTClaims) IsImpersonated() bool {
+	return c != nil && c.Actor != nil
+}
+
 // TokenPair chứa access token và refresh token
 type TokenPair struct {
-	AccessToken  string
-	RefreshToken string
+	AccessToken      string
+	RefreshToken     string
+	AccessTokenID    string
+	RefreshTokenID   string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
 }
 
 // GenerateAccessToken tạo access token mới
-func GenerateAccessToken(userID, email, fullName, phoneNumber, role, secret string, duration time.Duration) (string, error) {
-	now := time.Now()
-	claims := JWTClaims{
-		UserID:      userID,
-		Email:       email,
-		FullName:    fullName,
-		PhoneNumber: phoneNumber,
-		Role:        role,
-		TokenType:   AccessToken,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-			Issuer:    "jobbly-auth-service",
-			Subject:   userID,
-		},
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(secret))
+func GenerateAccessToken(userID, email, fullName, phoneNumber, role string, keys *KeySet, duration time.Duration) (string, error) {
+	tokenString, _, err := generateToken("", userID, email, fullName, phoneNumber, role, keys, AccessToken, duration)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign access token: %w", err)
 	}
@@ -75,60 +87,133 @@ func GenerateAccessToken(userID, email, fullName, phoneNumber, role, secret stri
 }
 
 // GenerateRefreshToken tạo refresh token mới
-func GenerateRefreshToken(userID, email, fullName, phoneNumber, role, secret string, duration time.Duration) (string, error) {
-	now := time.Now()
-	claims := JWTClaims{
+func GenerateRefreshToken(userID, email, fullName, phoneNumber, role string, keys *KeySet, duration time.Duration) (string, error) {
+	tokenString, _, err := generateToken("", userID, email, fullName, phoneNumber, role, keys, RefreshToken, duration)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign refresh token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// GenerateEmailVerificationToken tạo token cho link xác thực email, trả về cả claims (jti)
+func GenerateEmailVerificationToken(userID, email string, keys *KeySet) (string, *JWTClaims, error) {
+	tokenString, claims, err := generateToken("", userID, email, "", "", "", keys, EmailVerificationToken, EmailVerificationTokenDuration)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to sign email verification token: %w", err)
+	}
+
+	return tokenString, claims, nil
+}
+
+// GenerateLoginChallengeToken tạo challenge token cho bước 2 của đăng nhập (2FA).
+// now được truyền vào để có thể dùng fake clock.
+func GenerateLoginChallengeToken(userID, email string, keys *KeySet, now time.Time) (string, *JWTClaims, error) {
+	tokenString, claims, err := generateTokenAt(now, "", userID, email, "", "", "", keys, LoginChallengeToken, LoginChallengeTokenDuration)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to sign login challenge token: %w", err)
+	}
+
+	return tokenString, claims, nil
+}
+
+// GenerateImpersonationToken tạo access token ngắn hạn cho admin actor hành động thay user.
+// Token không thuộc session nào nên không thể refresh.
+func GenerateImpersonationToken(userID, email, fullName, phoneNumber, role string, actor *ActorClaim, keys *KeySet) (string, *JWTClaims, error) {
+	claims := newClaims(time.Now(), "", userID, email, fullName, phoneNumber, role, AccessToken, ImpersonationTokenDuration)
+	claims.Actor = actor
+
+	tokenString, err := keys.sign(claims)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to sign impersonation token: %w", err)
+	}
+
+	return tokenString, claims, nil
+}
+
+// generateToken tạo và ký token, trả về cả claims đã dùng
+func generateToken(sessionID, userID, email, fullName, phoneNumber, role string, keys *KeySet, tokenType TokenType, duration time.Duration) (string, *JWTClaims, error) {
+	return generateTokenAt(time.Now(), sessionID, userID, email, fullName, phoneNumber, role, keys, tokenType, duration)
+}
+
+// generateTokenAt tạo và ký token với thời điểm phát hành now
+func generateTokenAt(now time.Time, sessionID, userID, email, fullName, phoneNumber, role string, keys *KeySet, tokenType TokenType, duration time.Duration) (string, *JWTClaims, error) {
+	claims := newClaims(now, sessionID, userID, email, fullName, phoneNumber, role, tokenType, duration)
+
+	tokenString, err := keys.sign(claims)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return tokenString, claims, nil
+}
+
+// newClaims tạo claims cho token phát hành tại thời điểm now
+func newClaims(now time.Time, sessionID, userID, email, fullName, phoneNumber, role string, tokenType TokenType, duration time.Duration) *JWTClaims {
+	return &JWTClaims{
 		UserID:      userID,
 		Email:       email,
 		FullName:    fullName,
 		PhoneNumber: phoneNumber,
 		Role:        role,
-		TokenType:   RefreshToken,
+		TokenType:   tokenType,
+		SessionID:   sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "jobbly-auth-service",
 			Subject:   userID,
+			ID:        newTokenID(),
 		},
 	}
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(secret))
-	if err != nil {
-		return "", fmt.Errorf("failed to sign refresh token: %w", err)
+// newTokenID sinh jti ngẫu nhiên cho mỗi token, dùng làm khóa khi thu hồi token
+func newTokenID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to generate token id: %v", err))
 	}
-
-	return tokenString, nil
+	return hex.EncodeToString(b)
 }
 
 // NewTokenPair tạo cả access token và refresh token
-func NewTokenPair(userID, email, fullName, phoneNumber, role, secret string) (*TokenPair, error) {
-	accessToken, err := GenerateAccessToken(userID, email, fullName, phoneNumber, role, secret, AccessTokenDuration)
+func NewTokenPair(userID, email, fullName, phoneNumber, role string, keys *KeySet) (*TokenPair, error) {
+	return NewSessionTokenPair("", userID, email, fullName, phoneNumber, role, keys)
+}
+
+// NewSessionTokenPair tạo cặp token thuộc về session (token family) sessionID
+func NewSessionTokenPair(sessionID, userID, email, fullName, phoneNumber, role string, keys *KeySet) (*TokenPair, error) {
+	accessToken, accessClaims, err := generateToken(sessionID, userID, email, fullName, phoneNumber, role, keys, AccessToken, AccessTokenDuration)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to sign access token: %w", err)
 	}
 
-	refreshToken, err := GenerateRefreshToken(userID, email, fullName, phoneNumber, role, secret, RefreshTokenDuration)
+	refreshToken, refreshClaims, err := generateToken(sessionID, userID, email, fullName, phoneNumber, role, keys, RefreshToken, RefreshTokenDuration)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to sign refresh token: %w", err)
 	}
 
 	return &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		AccessTokenID:    accessClaims.ID,
+		RefreshTokenID:   refreshClaims.ID,
+		AccessExpiresAt:  accessClaims.ExpiresAt.Time,
+		RefreshExpiresAt: refreshClaims.ExpiresAt.Time,
 	}, nil
 }
 
-// ValidateToken validates và parse JWT token
-func ValidateToken(tokenString, secret string, expectedTokenType TokenType) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Kiểm tra signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(secret), nil
-	})
+// ValidateToken validates và parse JWT token, key verify được chọn theo kid
+func ValidateToken(tokenString string, keys *KeySet, expectedTokenType TokenType) (*JWTClaims, error) {
+	return ValidateTokenAt(tokenString, keys, expectedTokenType, time.Now())
+}
+
+// ValidateTokenAt validates JWT token như ValidateToken nhưng kiểm tra thời hạn tại thời điểm now
+func ValidateTokenAt(tokenString string, keys *KeySet, expectedTokenType TokenType, now time.Time) (*JWTClaims, error) {
+	// Key được chọn theo header kid, thuật toán phải khớp với loại key
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, keys.keyfunc, jwt.WithTimeFunc(func() time.Time { return now }))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -151,13 +236,13 @@ func ValidateToken(tokenString, secret string, expectedTokenType TokenType) (*JW
 }
 
 // ValidateAccessToken validates access token
-func ValidateAccessToken(tokenString, secret string) (*JWTClaims, error) {
-	return ValidateToken(tokenString, secret, AccessToken)
+func ValidateAccessToken(tokenString string, keys *KeySet) (*JWTClaims, error) {
+	return ValidateToken(tokenString, keys, AccessToken)
 }
 
 // ValidateRefreshToken validates refresh token và trả về user ID
-func ValidateRefreshToken(tokenString, secret string) (string, error) {
-	claims, err := ValidateToken(tokenString, secret, RefreshToken)
+func ValidateRefreshToken(tokenString string, keys *KeySet) (string, error) {
+	claims, err := ValidateToken(tokenString, keys, RefreshToken)
 	if err != nil {
 		return "", err
 	}
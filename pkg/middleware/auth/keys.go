@@ -0,0 +1,284 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrNoSigningKey      = errors.New("no signing key configured")
+	ErrUnknownKeyID      = errors.New("unknown key id")
+	ErrUnsupportedKey    = errors.New("unsupported key type, expected RSA or Ed25519")
+	ErrDuplicateKeyID    = errors.New("duplicate key id")
+	ErrEmptyLegacySecret = errors.New("legacy jwt secret must not be empty")
+)
+
+// verificationKey là public key dùng để verify token có header kid tương ứng
+type verificationKey struct {
+	id     string
+	method jwt.SigningMethod
+	public crypto.PublicKey
+}
+
+// KeySet chứa key ký token (RS256 hoặc EdDSA) và các key còn hiệu lực để verify.
+// Khi xoay key: thêm key mới làm verification key trên mọi instance, sau đó đổi
+// signing key, và chỉ gỡ key cũ khi mọi token ký bằng nó đã hết hạn.
+type KeySet struct {
+	signingID     string
+	signingMethod jwt.SigningMethod
+	signer        crypto.Signer
+	keys          map[string]*verificationKey
+	order         []string
+
+	// legacySecret verify (và ký, nếu không có signing key) token HS256 không có kid
+	legacySecret []byte
+}
+
+// NewKeySet creates an empty key set
+func NewKeySet() *KeySet {
+	return &KeySet{keys: make(map[string]*verificationKey)}
+}
+
+// NewHMACKeySet creates a key set that signs and verifies HS256 tokens with secret.
+// Chỉ dùng cho môi trường local hoặc trong giai đoạn chuyển sang key bất đối xứng.
+func NewHMACKeySet(secret string) *KeySet {
+	ks := NewKeySet()
+	ks.legacySecret = []byte(secret)
+	return ks
+}
+
+// SetLegacySecret cho phép verify token HS256 cũ (không có kid) trong lúc chuyển đổi
+func (ks *KeySet) SetLegacySecret(secret string) error {
+	if secret == "" {
+		return ErrEmptyLegacySecret
+	}
+	ks.legacySecret = []byte(secret)
+	return nil
+}
+
+// SetSigningKey sets the private key used to sign new tokens, it is also a verification key.
+// An empty kid is replaced by the RFC 7638 thumbprint of the key.
+func (ks *KeySet) SetSigningKey(kid string, key crypto.Signer) error {
+	kid, err := ks.addKey(kid, key.Public())
+	if err != nil {
+		return err
+	}
+
+	ks.signingID = kid
+	ks.signingMethod = ks.keys[kid].method
+	ks.signer = key
+	return nil
+}
+
+// AddVerificationKey adds a public key that is still accepted when verifying tokens
+func (ks *KeySet) AddVerificationKey(kid string, key crypto.PublicKey) error {
+	_, err := ks.addKey(kid, key)
+	return err
+}
+
+// addKey registers a public key under kid and returns the kid used
+func (ks *KeySet) addKey(kid string, key crypto.PublicKey) (string, error) {
+	method, err := signingMethodFor(key)
+	if err != nil {
+		return "", err
+	}
+	if kid == "" {
+		if kid, err = Thumbprint(key); err != nil {
+			return "", err
+		}
+	}
+	if _, ok := ks.keys[kid]; ok {
+		return "", fmt.Errorf("%w: %s", ErrDuplicateKeyID, kid)
+	}
+
+	ks.keys[kid] = &verificationKey{id: kid, method: method, public: key}
+	ks.order = append(ks.order, kid)
+	return kid, nil
+}
+
+// SigningKeyID returns the kid written in the header of new tokens
+func (ks *KeySet) SigningKeyID() string {
+	return ks.signingID
+}
+
+// sign ký claims bằng signing key (hoặc HS256 với legacy secret nếu chưa cấu hình key)
+func (ks *KeySet) sign(claims jwt.Claims) (string, error) {
+	if ks.signer != nil {
+		token := jwt.NewWithClaims(ks.signingMethod, claims)
+		token.Header["kid"] = ks.signingID
+		return token.SignedString(ks.signer)
+	}
+	if len(ks.legacySecret) > 0 {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(ks.legacySecret)
+	}
+	return "", ErrNoSigningKey
+}
+
+// keyfunc chọn key verify theo header kid, thuật toán phải khớp với loại key
+func (ks *KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		// Token HS256 phát hành trước khi có key bất đối xứng
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok && len(ks.legacySecret) > 0 {
+			return ks.legacySecret, nil
+		}
+		return nil, fmt.Errorf("%w: missing kid", ErrUnknownKeyID)
+	}
+
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeyID, kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.public, nil
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public verification keys (legacy HS256 secret is never published)
+func (ks *KeySet) JWKS() JWKS {
+	set := JWKS{Keys: make([]JWK, 0, len(ks.order))}
+	for _, kid := range ks.order {
+		key := ks.keys[kid]
+		jwk := JWK{Kid: kid, Use: "sig", Alg: key.method.Alg()}
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = b64(pub.N.Bytes())
+			jwk.E = b64(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = b64(pub)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// Thumbprint computes the RFC 7638 JWK thumbprint of a public key
+func Thumbprint(key crypto.PublicKey) (string, error) {
+	var canonical string
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		canonical = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, b64(big.NewInt(int64(pub.E)).Bytes()), b64(pub.N.Bytes()))
+	case ed25519.PublicKey:
+		canonical = fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`, b64(pub))
+	default:
+		return "", ErrUnsupportedKey
+	}
+
+	sum := sha256.Sum256([]byte(canonical))
+	return b64(sum[:]), nil
+}
+
+// signingMethodFor trả về thuật toán ký tương ứng với loại key
+func signingMethodFor(key crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("rsa key must be at least 2048 bits, got %d", pub.N.BitLen())
+		}
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	}
+	return nil, ErrUnsupportedKey
+}
+
+// LoadPrivateKeyFile reads an RSA or Ed25519 private key from a PEM file (PKCS#8 or PKCS#1)
+func LoadPrivateKeyFile(path string) (crypto.Signer, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		switch k := key.(type) {
+		case *rsa.PrivateKey:
+			return k, nil
+		case ed25519.PrivateKey:
+			return k, nil
+		}
+		return nil, fmt.Errorf("%s: %w", path, ErrUnsupportedKey)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("%s: not a PKCS#8 or PKCS#1 private key", path)
+}
+
+// LoadPublicKeyFile reads an RSA or Ed25519 public key from a PEM file.
+// A private key file is accepted too, its public half is used.
+func LoadPublicKeyFile(path string) (crypto.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		switch k := key.(type) {
+		case *rsa.PublicKey:
+			return k, nil
+		case ed25519.PublicKey:
+			return k, nil
+		}
+		return nil, fmt.Errorf("%s: %w", path, ErrUnsupportedKey)
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	signer, err := LoadPrivateKeyFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: not a PEM public key", path)
+	}
+	return signer.Public(), nil
+}
+
+// readPEM reads the first PEM block of a file
+func readPEM(path string) (*pem.Block, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data found", path)
+	}
+	return block, nil
+}
+
+// b64 encodes bytes as unpadded base64url
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -14,10 +14,12 @@ var (
 	ErrInvalidAuthHeader = errors.Unauthorized("AUTH_INVALID_HEADER", "invalid authorization header format")
 	ErrTokenValidation   = errors.Unauthorized("AUTH_TOKEN_INVALID", "token validation failed")
 	ErrTokenExpired      = errors.Unauthorized("AUTH_TOKEN_EXPIRED", "token has expired")
+	ErrTokenRevoked      = errors.Unauthorized("AUTH_TOKEN_REVOKED", "token has been revoked")
 )
 
 // JWTAuth returns a JWT authentication middleware
-func JWTAuth(secret string) middleware.Middleware {
+// Tokens revoked in store (e.g. after logout) are rejected
+func JWTAuth(keys *KeySet, store RevocationStore) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			// Check if claims already set by OptionalJWTAuth
@@ -28,19 +30,9 @@ func JWTAuth(secret string) middleware.Middleware {
 			}
 
 			// No claims found - token is required for this endpoint
-			// Lấy token từ header
-			token, tokenErr := extractToken(ctx)
-			if tokenErr != nil {
-				return nil, tokenErr
-			}
-
-			// Validate token
-			claims, err = ValidateAccessToken(token, secret)
+			claims, err = authenticate(ctx, keys, store, nil)
 			if err != nil {
-				if err == ErrExpiredToken {
-					return nil, ErrTokenExpired
-				}
-				return nil, errors.Unauthorized("AUTH_TOKEN_INVALID", err.Error())
+				return nil, err
 			}
 
 			// Lưu claims vào context để sử dụng trong service
@@ -53,36 +45,17 @@ func JWTAuth(secret string) middleware.Middleware {
 }
 
 // JWTAuthWithRoles returns a JWT authentication middleware with role-based access control
-func JWTAuthWithRoles(secret string, allowedRoles ...string) middleware.Middleware {
+func JWTAuthWithRoles(keys *KeySet, store RevocationStore, allowedRoles ...string) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			// Lấy token từ header
-			token, err := extractToken(ctx)
+			claims, err := authenticate(ctx, keys, store, nil)
 			if err != nil {
 				return nil, err
 			}
 
-			// Validate token
-			claims, err := ValidateAccessToken(token, secret)
-			if err != nil {
-				if err == ErrExpiredToken {
-					return nil, ErrTokenExpired
-				}
-				return nil, errors.Unauthorized("AUTH_TOKEN_INVALID", err.Error())
-			}
-
 			// Kiểm tra role nếu có chỉ định
-			if len(allowedRoles) > 0 {
-				hasValidRole := false
-				for _, role := range allowedRoles {
-					if claims.Role == role {
-						hasValidRole = true
-						break
-					}
-				}
-				if !hasValidRole {
-					return nil, errors.Forbidden("AUTH_INSUFFICIENT_PERMISSION", "insufficient permissions for this resource")
-				}
+			if !hasRole(claims, allowedRoles) {
+				return nil, ErrInsufficientPermission
 			}
 
 			// Lưu claims vào context để sử dụng trong service
@@ -94,40 +67,102 @@ func JWTAuthWithRoles(secret string, allowedRoles ...string) middleware.Middlewa
 	}
 }
 
+// authenticate extracts and validates the request's credentials: a Bearer access token
+// (checked against revocation) or, when apiKeys is set, an ApiKey
+func authenticate(ctx context.Context, keys *KeySet, store RevocationStore, apiKeys APIKeyValidator) (*JWTClaims, error) {
+	// Lấy token từ header
+	scheme, token, err := extractCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(scheme, APIKeyScheme) {
+		if apiKeys == nil {
+			return nil, ErrInvalidAuthHeader
+		}
+		claims, err := apiKeys.ValidateAPIKey(ctx, token)
+		if err != nil || claims == nil {
+			// Lỗi store cũng coi như key không hợp lệ (fail closed)
+			return nil, ErrInvalidAPIKey
+		}
+		claims.TokenType = APIKeyToken
+		return claims, nil
+	}
+
+	// Validate token
+	claims, err := ValidateAccessToken(token, keys)
+	if err != nil {
+		if err == ErrExpiredToken {
+			return nil, ErrTokenExpired
+		}
+		return nil, errors.Unauthorized("AUTH_TOKEN_INVALID", err.Error())
+	}
+
+	// Kiểm tra token đã bị thu hồi chưa
+	if err := checkRevokedErr(ctx, store, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// checkRevokedErr maps revocation check results to API errors
+// A failing store is treated as invalid token (fail closed)
+func checkRevokedErr(ctx context.Context, store RevocationStore, claims *JWTClaims) error {
+	if err := CheckRevoked(ctx, store, claims); err != nil {
+		if err == ErrRevokedToken {
+			return ErrTokenRevoked
+		}
+		return ErrTokenValidation
+	}
+	return nil
+}
+
 // extractToken extracts JWT token from Authorization header
 func extractToken(ctx context.Context) (string, error) {
+	scheme, token, err := extractCredentials(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(scheme, "bearer") {
+		return "", ErrInvalidAuthHeader
+	}
+	return token, nil
+}
+
+// extractCredentials splits the Authorization header into scheme (Bearer or ApiKey) and credentials
+func extractCredentials(ctx context.Context) (string, string, error) {
 	// Get transport info from context
 	tr, ok := transport.FromServerContext(ctx)
 	if !ok {
-		return "", ErrMissingToken
+		return "", "", ErrMissingToken
 	}
 
 	// Get Authorization header
 	authHeader := tr.RequestHeader().Get("Authorization")
 	if authHeader == "" {
-		return "", ErrMissingToken
+		return "", "", ErrMissingToken
 	}
 
-	// Parse Bearer token
-	// Expected format: "Bearer <token>"
+	// Expected format: "Bearer <token>" or "ApiKey <key>"
 	parts := strings.SplitN(authHeader, " ", 2)
-	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-		return "", ErrInvalidAuthHeader
+	if len(parts) != 2 || (!strings.EqualFold(parts[0], "bearer") && !strings.EqualFold(parts[0], APIKeyScheme)) {
+		return "", "", ErrInvalidAuthHeader
 	}
 
 	token := strings.TrimSpace(parts[1])
 	if token == "" {
-		return "", ErrMissingToken
+		return "", "", ErrMissingToken
 	}
 
-	return token, nil
+	return parts[0], token, nil
 }
 
 // OptionalJWTAuth returns a middleware that parses JWT if present but doesn't require it
 // This allows both authenticated and anonymous users to access the same endpoint
 // If token is present and valid, claims will be set to context
-// If token is missing or invalid, request continues without claims
-func OptionalJWTAuth(secret string) middleware.Middleware {
+// If token is missing, invalid or revoked, request continues without claims
+func OptionalJWTAuth(keys *KeySet, store RevocationStore) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
 			// Try to extract token (don't fail if missing)
@@ -138,8 +173,8 @@ func OptionalJWTAuth(secret string) middleware.Middleware {
 			}
 
 			// Try to validate token (don't fail if invalid)
-			claims, err := ValidateAccessToken(token, secret)
-			if err == nil {
+			claims, err := ValidateAccessToken(token, keys)
+			if err == nil && CheckRevoked(ctx, store, claims) == nil {
 				// Token is valid - set claims to context
 				ctx = SetClaimsToContext(ctx, claims)
 			}
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RevocationStore lưu danh sách token đã bị thu hồi (theo jti).
+// Mỗi entry chỉ cần tồn tại đến khi token hết hạn tự nhiên.
+type RevocationStore interface {
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// SessionRevocationChecker is implemented by revocation stores that also know the sessions
+// (claim sid) of access tokens. CheckRevoked then rejects every token of a revoked session,
+// kể cả access token phát hành trước lần refresh cuối.
+type SessionRevocationChecker interface {
+	IsSessionRevoked(ctx context.Context, sessionID string) (bool, error)
+}
+
+// MemoryRevocationStore is an in-memory RevocationStore.
+// Suitable for tests and single-instance local development only.
+type MemoryRevocationStore struct {
+	mu      sync.RWMutex
+	entries map[string]time.Time
+	now     func() time.Time
+}
+
+// NewMemoryRevocationStore creates a new in-memory revocation store
+func NewMemoryRevocationStore() *MemoryRevocationStore {
+	return &MemoryRevocationStore{
+		entries: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+// Revoke marks a token ID as revoked until expiresAt
+func (s *MemoryRevocationStore) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	if jti == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[jti] = expiresAt
+
+	// Drop entries whose tokens have already expired
+	now := s.now()
+	for id, exp := range s.entries {
+		if !exp.After(now) {
+			delete(s.entries, id)
+		}
+	}
+
+	return nil
+}
+
+// IsRevoked reports whether a token ID has been revoked and is not yet expired
+func (s *MemoryRevocationStore) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	if jti == "" {
+		return false, nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	exp, ok := s.entries[jti]
+	if !ok {
+		return false, nil
+	}
+	return exp.After(s.now()), nil
+}
+
+// RevokeClaims thu hồi token tương ứng với claims cho đến khi token hết hạn
+func RevokeClaims(ctx context.Context, store RevocationStore, claims *JWTClaims) error {
+	if store == nil || claims == nil || claims.ID == "" {
+		return nil
+	}
+
+	expiresAt := time.Now().Add(RefreshTokenDuration)
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
+	return store.Revoke(ctx, claims.ID, expiresAt)
+}
+
+// CheckRevoked trả về ErrRevokedToken nếu token đã bị thu hồi.
+// Token không có jti (phát hành trước khi có cơ chế thu hồi) được bỏ qua.
+func CheckRevoked(ctx context.Context, store RevocationStore, claims *JWTClaims) error {
+	if store == nil || claims == nil || claims.ID == "" {
+		return nil
+	}
+
+	revoked, err := store.IsRevoked(ctx, claims.ID)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return ErrRevokedToken
+	}
+
+	if checker, ok := store.(SessionRevocationChecker); ok && claims.SessionID != "" {
+		if revoked, err = checker.IsSessionRevoked(ctx, claims.SessionID); err != nil {
+			return err
+		}
+		if revoked {
+			return ErrRevokedToken
+		}
+	}
+
+	return nil
+}
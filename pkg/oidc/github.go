@@ -0,0 +1,103 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// GitHub defaults, overridable in Config for GitHub Enterprise or tests
+const (
+	githubAuthURL  = "https://github.com/login/oauth/authorize"
+	githubTokenURL = "https://github.com/login/oauth/access_token"
+	githubAPIURL   = "https://api.github.com"
+)
+
+// githubProvider is GitHub OAuth2 (no OpenID Connect), the identity comes from the REST API
+type githubProvider struct {
+	config Config
+	client *http.Client
+	apiURL string
+}
+
+func newGitHubProvider(c Config, client *http.Client) *githubProvider {
+	if c.AuthURL == "" {
+		c.AuthURL = githubAuthURL
+	}
+	if c.TokenURL == "" {
+		c.TokenURL = githubTokenURL
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"read:user", "user:email"}
+	}
+
+	// UserInfoURL được dùng làm base URL của API (ví dụ GitHub Enterprise)
+	apiURL := githubAPIURL
+	if c.UserInfoURL != "" {
+		apiURL = strings.TrimRight(c.UserInfoURL, "/")
+	}
+
+	return &githubProvider{config: c, client: client, apiURL: apiURL}
+}
+
+// AuthCodeURL returns the GitHub authorization URL with PKCE challenge
+func (p *githubProvider) AuthCodeURL(ctx context.Context, req *AuthRequest) (string, error) {
+	return authCodeURL(p.config.AuthURL, p.config, req, nil)
+}
+
+// githubUser is the /user response
+type githubUser struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+// githubEmail is an item of the /user/emails response
+type githubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+// Exchange trades the code for an access token and reads the user and primary email
+func (p *githubProvider) Exchange(ctx context.Context, code string, req *AuthRequest) (*Identity, error) {
+	token, err := exchangeCode(ctx, p.client, p.config.TokenURL, p.config, code, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var user githubUser
+	if err := getJSON(ctx, p.client, p.apiURL+"/user", token.AccessToken, &user); err != nil {
+		return nil, err
+	}
+	if user.ID == 0 {
+		return nil, ErrExchangeFailed
+	}
+
+	// Email công khai trên profile có thể chưa verify, luôn lấy primary email từ /user/emails
+	var emails []githubEmail
+	if err := getJSON(ctx, p.client, p.apiURL+"/user/emails", token.AccessToken, &emails); err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Subject: strconv.FormatInt(user.ID, 10),
+		Name:    user.Name,
+	}
+	if identity.Name == "" {
+		identity.Name = user.Login
+	}
+	for _, e := range emails {
+		if e.Primary {
+			identity.Email = e.Email
+			identity.EmailVerified = e.Verified
+			break
+		}
+	}
+	if identity.Email == "" {
+		return nil, ErrNoEmail
+	}
+
+	return identity, nil
+}
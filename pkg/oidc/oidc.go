@@ -0,0 +1,226 @@
+// Package oidc is a small OAuth2 / OpenID Connect client for social login:
+// authorization-code flow with PKCE (S256), ID token verification against the
+// provider's JWKS, and a GitHub variant (OAuth2 only, identity from its REST API).
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Provider types
+const (
+	TypeOIDC   = "oidc"
+	TypeGitHub = "github"
+)
+
+var (
+	ErrExchangeFailed = errors.New("oauth code exchange failed")
+	ErrInvalidIDToken = errors.New("invalid id token")
+	ErrNoEmail        = errors.New("provider returned no email")
+)
+
+// Config describes one provider
+type Config struct {
+	Type         string // oidc (default) | github
+	Issuer       string // OIDC issuer, endpoints are discovered from it
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	// Optional endpoint overrides (otherwise discovered or provider defaults)
+	AuthURL     string
+	TokenURL    string
+	JWKSURL     string
+	UserInfoURL string
+}
+
+// Identity is the user as seen by the provider
+type Identity struct {
+	Subject       string // ID ổn định của user tại provider
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// AuthRequest holds the per-login secrets that must survive until the callback
+type AuthRequest struct {
+	State        string
+	CodeVerifier string
+	Nonce        string
+}
+
+// NewAuthRequest generates a random state, PKCE code verifier and nonce
+func NewAuthRequest() *AuthRequest {
+	return &AuthRequest{
+		State:        randomString(),
+		CodeVerifier: randomString(),
+		Nonce:        randomString(),
+	}
+}
+
+// CodeChallenge returns the S256 PKCE challenge of the code verifier
+func (r *AuthRequest) CodeChallenge() string {
+	sum := sha256.Sum256([]byte(r.CodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Provider runs the authorization-code flow against one identity provider
+type Provider interface {
+	// AuthCodeURL returns the URL the user is sent to for signing in
+	AuthCodeURL(ctx context.Context, req *AuthRequest) (string, error)
+	// Exchange trades the callback code for the user's identity
+	Exchange(ctx context.Context, code string, req *AuthRequest) (*Identity, error)
+}
+
+// NewProvider creates a provider from its config.
+// OIDC endpoints are discovered lazily so a provider being down does not block startup.
+func NewProvider(c Config, client *http.Client) (Provider, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if c.ClientID == "" || c.RedirectURL == "" {
+		return nil, errors.New("oauth provider needs client_id and redirect_url")
+	}
+
+	switch c.Type {
+	case "", TypeOIDC:
+		if c.Issuer == "" {
+			return nil, errors.New("oidc provider needs an issuer")
+		}
+		if len(c.Scopes) == 0 {
+			c.Scopes = []string{"openid", "email", "profile"}
+		}
+		return &oidcProvider{config: c, client: client, jwks: newKeyCache(client)}, nil
+	case TypeGitHub:
+		return newGitHubProvider(c, client), nil
+	}
+
+	return nil, fmt.Errorf("unknown oauth provider type %q", c.Type)
+}
+
+// authCodeURL builds the authorization URL shared by every provider type
+func authCodeURL(authURL string, c Config, req *AuthRequest, extra url.Values) (string, error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", err
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.ClientID)
+	q.Set("redirect_uri", c.RedirectURL)
+	q.Set("scope", strings.Join(c.Scopes, " "))
+	q.Set("state", req.State)
+	q.Set("code_challenge", req.CodeChallenge())
+	q.Set("code_challenge_method", "S256")
+	for k, v := range extra {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// tokenResponse is the token endpoint response (RFC 6749 section 5)
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// exchangeCode calls the token endpoint with the code and PKCE verifier
+func exchangeCode(ctx context.Context, client *http.Client, tokenURL string, c Config, code string, req *AuthRequest) (*tokenResponse, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.RedirectURL},
+		"client_id":     {c.ClientID},
+		"code_verifier": {req.CodeVerifier},
+	}
+	if c.ClientSecret != "" {
+		form.Set("client_secret", c.ClientSecret)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpReq.Header.Set("Accept", "application/json")
+
+	var token tokenResponse
+	status, err := doJSON(client, httpReq, &token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExchangeFailed, err)
+	}
+	if token.Error != "" {
+		return nil, fmt.Errorf("%w: %s %s", ErrExchangeFailed, token.Error, token.ErrorDescription)
+	}
+	if status != http.StatusOK || token.AccessToken == "" {
+		return nil, fmt.Errorf("%w: status %d", ErrExchangeFailed, status)
+	}
+
+	return &token, nil
+}
+
+// getJSON performs an authenticated GET and decodes the JSON response
+func getJSON(ctx context.Context, client *http.Client, endpoint, accessToken string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	status, err := doJSON(client, req, out)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", endpoint, status)
+	}
+	return nil
+}
+
+// doJSON sends req and decodes a JSON body (max 1 MB) into out
+func doJSON(client *http.Client, req *http.Request, out interface{}) (int, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return resp.StatusCode, fmt.Errorf("decode %s response: %w", req.URL.Path, err)
+	}
+	return resp.StatusCode, nil
+}
+
+// randomString returns 32 random bytes as base64url (43 chars, valid PKCE verifier)
+func randomString() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to generate random string: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,95 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"JobblyBE/pkg/oidc"
+	"JobblyBE/pkg/oidc/oidctest"
+)
+
+const redirectURL = "http://localhost:3000/oauth/callback"
+
+// authorize follows the stub /authorize and returns the code and state of the callback
+func authorize(t *testing.T, authURL string) (string, string) {
+	t.Helper()
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize status = %d", resp.StatusCode)
+	}
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func TestOIDCExchange(t *testing.T) {
+	srv := oidctest.NewServer("client", "secret")
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		tamper  func(req *oidc.AuthRequest) // sửa AuthRequest lưu lại trước khi exchange
+		reuse   bool                        // dùng code lần thứ hai
+		wantErr error
+	}{
+		{name: "valid"},
+		{name: "nonce mismatch", tamper: func(req *oidc.AuthRequest) { req.Nonce = "other-nonce" }, wantErr: oidc.ErrInvalidIDToken},
+		{name: "missing nonce", tamper: func(req *oidc.AuthRequest) { req.Nonce = "" }, wantErr: oidc.ErrInvalidIDToken},
+		{name: "wrong code verifier", tamper: func(req *oidc.AuthRequest) { req.CodeVerifier = "other-verifier" }, wantErr: oidc.ErrExchangeFailed},
+		{name: "code reused", reuse: true, wantErr: oidc.ErrExchangeFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			provider, err := oidc.NewProvider(oidc.Config{
+				Issuer:       srv.Issuer(),
+				ClientID:     "client",
+				ClientSecret: "secret",
+				RedirectURL:  redirectURL,
+			}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := oidc.NewAuthRequest()
+			authURL, err := provider.AuthCodeURL(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			code, state := authorize(t, authURL)
+			if state != req.State {
+				t.Fatalf("callback state = %q, want %q", state, req.State)
+			}
+
+			saved := *req
+			if tt.tamper != nil {
+				tt.tamper(&saved)
+			}
+			if tt.reuse {
+				if _, err := provider.Exchange(ctx, code, &saved); err != nil {
+					t.Fatalf("first exchange: %v", err)
+				}
+			}
+
+			identity, err := provider.Exchange(ctx, code, &saved)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Exchange() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (identity.Subject != "oidctest-user" || identity.Email != "oidctest@example.com" || !identity.EmailVerified) {
+				t.Errorf("Exchange() identity = %+v", identity)
+			}
+		})
+	}
+}
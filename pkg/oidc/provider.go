@@ -0,0 +1,182 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// discovery is the subset of the OpenID provider metadata we use
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// oidcProvider is a generic OpenID Connect provider (Google, Keycloak, ...)
+type oidcProvider struct {
+	config Config
+	client *http.Client
+	jwks   *keyCache
+
+	mu         sync.Mutex
+	discovered bool
+}
+
+// endpoints fills the endpoints missing from the config by OIDC discovery (once)
+func (p *oidcProvider) endpoints(ctx context.Context) (Config, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	c := p.config
+	if p.discovered || (c.AuthURL != "" && c.TokenURL != "" && c.JWKSURL != "") {
+		return c, nil
+	}
+
+	var d discovery
+	wellKnown := strings.TrimRight(c.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, p.client, wellKnown, "", &d); err != nil {
+		return c, fmt.Errorf("oidc discovery: %w", err)
+	}
+	if d.Issuer != c.Issuer {
+		return c, fmt.Errorf("oidc discovery: issuer mismatch %q != %q", d.Issuer, c.Issuer)
+	}
+
+	if c.AuthURL == "" {
+		c.AuthURL = d.AuthorizationEndpoint
+	}
+	if c.TokenURL == "" {
+		c.TokenURL = d.TokenEndpoint
+	}
+	if c.JWKSURL == "" {
+		c.JWKSURL = d.JWKSURI
+	}
+	if c.UserInfoURL == "" {
+		c.UserInfoURL = d.UserInfoEndpoint
+	}
+
+	p.config = c
+	p.discovered = true
+	return c, nil
+}
+
+// AuthCodeURL returns the authorization URL with PKCE challenge and nonce
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, req *AuthRequest) (string, error) {
+	c, err := p.endpoints(ctx)
+	if err != nil {
+		return "", err
+	}
+	return authCodeURL(c.AuthURL, c, req, map[string][]string{"nonce": {req.Nonce}})
+}
+
+// idTokenClaims are the ID token claims we read
+type idTokenClaims struct {
+	Nonce         string  `json:"nonce"`
+	Email         string  `json:"email"`
+	EmailVerified boolish `json:"email_verified"`
+	Name          string  `json:"name"`
+	jwt.RegisteredClaims
+}
+
+// userInfo is the userinfo endpoint response
+type userInfo struct {
+	Subject       string  `json:"sub"`
+	Email         string  `json:"email"`
+	EmailVerified boolish `json:"email_verified"`
+	Name          string  `json:"name"`
+}
+
+// Exchange trades the code for tokens and verifies the ID token
+func (p *oidcProvider) Exchange(ctx context.Context, code string, req *AuthRequest) (*Identity, error) {
+	c, err := p.endpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := exchangeCode(ctx, p.client, c.TokenURL, c, code, req)
+	if err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("%w: token response has no id_token", ErrInvalidIDToken)
+	}
+
+	var claims idTokenClaims
+	_, err = jwt.ParseWithClaims(token.IDToken, &claims,
+		func(t *jwt.Token) (interface{}, error) {
+			kid, _ := t.Header["kid"].(string)
+			return p.jwks.key(ctx, c.JWKSURL, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "EdDSA"}),
+		jwt.WithIssuer(c.Issuer),
+		jwt.WithAudience(c.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if claims.Nonce != req.Nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing sub", ErrInvalidIDToken)
+	}
+
+	identity := &Identity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+	}
+
+	// Một số provider chỉ trả email qua userinfo endpoint
+	if identity.Email == "" && c.UserInfoURL != "" {
+		var info userInfo
+		if err := getJSON(ctx, p.client, c.UserInfoURL, token.AccessToken, &info); err != nil {
+			return nil, err
+		}
+		if info.Subject != identity.Subject {
+			return nil, fmt.Errorf("%w: userinfo subject mismatch", ErrInvalidIDToken)
+		}
+		identity.Email = info.Email
+		identity.EmailVerified = bool(info.EmailVerified)
+		if identity.Name == "" {
+			identity.Name = info.Name
+		}
+	}
+	if identity.Email == "" {
+		return nil, ErrNoEmail
+	}
+
+	return identity, nil
+}
+
+// boolish decodes a JSON bool that some providers send as a string ("true")
+type boolish bool
+
+func (b *boolish) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case bool:
+		*b = boolish(x)
+	case string:
+		*b = boolish(strings.EqualFold(x, "true"))
+	case nil:
+		*b = false
+	default:
+		return errors.New("email_verified: expected bool")
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day, hour, min, sec int) time.Time {
+	return time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+}
+
+func TestScheduleNext(t *testing.T) {
+	// 2026-01-31 là thứ bảy, 2026-02-01 là chủ nhật
+	tests := []struct {
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{"0 8 * * 1", date(2026, 1, 31, 10, 0, 0), date(2026, 2, 2, 8, 0, 0)},
+		{"0 8 * * 1", date(2026, 2, 2, 7, 59, 30), date(2026, 2, 2, 8, 0, 0)},
+		{"0 8 * * 1", date(2026, 2, 2, 8, 0, 0), date(2026, 2, 9, 8, 0, 0)},
+		{"*/5 * * * *", date(2026, 1, 31, 10, 2, 30), date(2026, 1, 31, 10, 5, 0)},
+		{"*/5 * * * *", date(2026, 1, 31, 10, 55, 0), date(2026, 1, 31, 11, 0, 0)},
+		{"*/5 * * * *", date(2026, 12, 31, 23, 58, 0), date(2027, 1, 1, 0, 0, 0)},
+		{"5/20 * * * *", date(2026, 1, 31, 10, 6, 0), date(2026, 1, 31, 10, 25, 0)},
+		{"15,45 9-17 * * 1-5", date(2026, 1, 30, 17, 50, 0), date(2026, 2, 2, 9, 15, 0)},
+		{"30 9 1 * *", date(2026, 1, 15, 0, 0, 0), date(2026, 2, 1, 9, 30, 0)},
+		{"0 0 31 * *", date(2026, 2, 1, 0, 0, 0), date(2026, 3, 31, 0, 0, 0)},
+		{"0 0 29 2 *", date(2026, 3, 1, 0, 0, 0), date(2028, 2, 29, 0, 0, 0)},
+		{"0 0 15 * *", date(2026, 2, 10, 0, 0, 0), date(2026, 2, 15, 0, 0, 0)},
+		// Day-of-month và day-of-week cùng bị giới hạn: khớp một trong hai là đủ
+		{"0 0 15 * 1", date(2026, 2, 3, 0, 0, 0), date(2026, 2, 9, 0, 0, 0)},
+		{"0 0 15 * 1", date(2026, 2, 10, 0, 0, 0), date(2026, 2, 15, 0, 0, 0)},
+		// 7 cũng là chủ nhật
+		{"0 0 * * 7", date(2026, 1, 31, 12, 0, 0), date(2026, 2, 1, 0, 0, 0)},
+		{"@hourly", date(2026, 1, 31, 10, 0, 0), date(2026, 1, 31, 11, 0, 0)},
+		{"@daily", date(2026, 1, 31, 10, 0, 0), date(2026, 2, 1, 0, 0, 0)},
+		{"@weekly", date(2026, 1, 31, 10, 0, 0), date(2026, 2, 1, 0, 0, 0)},
+		{"@monthly", date(2026, 1, 31, 10, 0, 0), date(2026, 2, 1, 0, 0, 0)},
+		{"@every 5m", date(2026, 1, 31, 10, 2, 30), date(2026, 1, 31, 10, 5, 0)},
+		{"@every 1h", date(2026, 1, 31, 10, 0, 0), date(2026, 1, 31, 11, 0, 0)},
+		// 30/2 không bao giờ tới
+		{"0 0 30 2 *", date(2026, 1, 1, 0, 0, 0), time.Time{}},
+	}
+
+	for _, tt := range tests {
+		s, err := Parse(tt.spec)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.spec, err)
+		}
+		if got := s.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("Parse(%q).Next(%v) = %v, want %v", tt.spec, tt.from, got, tt.want)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	specs := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"@yearly",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@every 500ms",
+		"@every soon",
+	}
+	for _, spec := range specs {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) error = nil, want error", spec)
+		}
+	}
+}
+
+func TestParseField(t *testing.T) {
+	bits := func(values ...int) uint64 {
+		var b uint64
+		for _, v := range values {
+			b |= 1 << uint(v)
+		}
+		return b
+	}
+
+	tests := []struct {
+		field    string
+		min, max int
+		want     uint64
+		wantErr  bool
+	}{
+		{field: "*", min: 0, max: 59, want: 1<<60 - 1},
+		{field: "7", min: 0, max: 59, want: bits(7)},
+		{field: "1-3", min: 0, max: 59, want: bits(1, 2, 3)},
+		{field: "*/15", min: 0, max: 59, want: bits(0, 15, 30, 45)},
+		{field: "5/20", min: 0, max: 59, want: bits(5, 25, 45)},
+		{field: "10-20/5", min: 0, max: 59, want: bits(10, 15, 20)},
+		{field: "1,3-4", min: 1, max: 12, want: bits(1, 3, 4)},
+		{field: "*", min: 1, max: 31, want: (1<<32 - 1) &^ 1},
+		{field: "0", min: 1, max: 31, wantErr: true},
+		{field: "32", min: 1, max: 31, wantErr: true},
+		{field: "1-", min: 0, max: 59, wantErr: true},
+		{field: "*/x", min: 0, max: 59, wantErr: true},
+		{field: "1,,2", min: 0, max: 59, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseField(tt.field, tt.min, tt.max)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseField(%q, %d, %d) error = %v, wantErr %v", tt.field, tt.min, tt.max, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseField(%q, %d, %d) = %b, want %b", tt.field, tt.min, tt.max, got, tt.want)
+		}
+	}
+}
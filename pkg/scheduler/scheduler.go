@@ -0,0 +1,285 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var _ transport.Server = (*Scheduler)(nil)
+
+// DefaultLeaseTTL là thời gian giữ lease mặc định, cũng là timeout của một lần chạy
+const DefaultLeaseTTL = 10 * time.Minute
+
+// TaskFunc runs one occurrence of a task scheduled at now
+// and returns the number of processed items
+type TaskFunc func(ctx context.Context, now time.Time) (int, error)
+
+// Task là một công việc chạy nền theo lịch
+type Task struct {
+	Name     string
+	Schedule string // cron spec, xem Parse
+	LeaseTTL time.Duration
+	Disabled bool
+	Run      TaskFunc
+}
+
+// Run is the outcome of a task run
+type Run struct {
+	Owner      string
+	Slot       time.Time // thời điểm theo lịch của lần chạy
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Processed  int
+	Error      string
+}
+
+// Succeeded reports whether the run finished without error
+func (r *Run) Succeeded() bool {
+	return r.Error == ""
+}
+
+// Lease is the lock state of a task shared by every replica
+type Lease struct {
+	Owner       string
+	LockedUntil time.Time
+	LastRun     *Run
+}
+
+// LeaseStore lưu lease của các task để mỗi lần chạy theo lịch chỉ được một replica thực hiện
+type LeaseStore interface {
+	// Acquire takes the lease of task for the run scheduled at slot until ttl expires.
+	// Trả về false nếu replica khác đang giữ lease hoặc đã chạy slot này.
+	Acquire(ctx context.Context, task, owner string, slot time.Time, ttl time.Duration) (bool, error)
+	// Release frees the lease and stores the outcome of the run
+	Release(ctx context.Context, task, owner string, run *Run) error
+	// Leases returns the lease of every task by name
+	Leases(ctx context.Context) (map[string]*Lease, error)
+}
+
+// TaskStatus is the status of a task reported to administrators
+type TaskStatus struct {
+	Name      string
+	Schedule  string
+	Disabled  bool
+	NextRunAt time.Time // zero khi task bị tắt
+	Running   bool
+	Owner     string // replica đang chạy / chạy lần cuối
+	LastRun   *Run
+}
+
+type task struct {
+	Task
+	schedule Schedule
+
+	mu      sync.Mutex
+	nextRun time.Time
+}
+
+// Scheduler runs tasks on their schedule. Implements transport.Server
+// để được chạy / dừng cùng các server khác của kratos.App.
+type Scheduler struct {
+	store LeaseStore
+	owner string
+	log   *log.Helper
+
+	tasks  []*task
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Option configures a Scheduler
+type Option func(*Scheduler)
+
+// WithOwner sets the name of this replica in the leases (mặc định hostname-pid)
+func WithOwner(owner string) Option {
+	return func(s *Scheduler) {
+		s.owner = owner
+	}
+}
+
+// New creates a scheduler storing its leases in store
+func New(store LeaseStore, logger log.Logger, opts ...Option) *Scheduler {
+	host, _ := os.Hostname()
+	s := &Scheduler{
+		store: store,
+		owner: fmt.Sprintf("%s-%d", host, os.Getpid()),
+		log:   log.NewHelper(logger),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Register adds a task. Phải gọi trước Start.
+func (s *Scheduler) Register(t Task) error {
+	if t.Name == "" || t.Run == nil {
+		return errors.New("scheduler: task needs a name and a run function")
+	}
+	for _, existing := range s.tasks {
+		if existing.Name == t.Name {
+			return fmt.Errorf("scheduler: task %s already registered", t.Name)
+		}
+	}
+	schedule, err := Parse(t.Schedule)
+	if err != nil {
+		return err
+	}
+	if t.LeaseTTL <= 0 {
+		t.LeaseTTL = DefaultLeaseTTL
+	}
+
+	s.tasks = append(s.tasks, &task{Task: t, schedule: schedule})
+	return nil
+}
+
+// Start runs every enabled task on its schedule until Stop is called
+func (s *Scheduler) Start(ctx context.Context) error {
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, t := range s.tasks {
+		if t.Disabled {
+			s.log.Infof("scheduler: task %s is disabled", t.Name)
+			continue
+		}
+		s.wg.Add(1)
+		go s.loop(ctx, t)
+	}
+	s.log.Infof("scheduler: started %d task(s) as %s", len(s.tasks), s.owner)
+	return nil
+}
+
+// Stop stops scheduling and waits for the running tasks (tối đa tới deadline của ctx)
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		s.log.Info("scheduler: stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Status returns the status of every task, sorted by name
+func (s *Scheduler) Status(ctx context.Context) ([]*TaskStatus, error) {
+	leases, err := s.store.Leases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	statuses := make([]*TaskStatus, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		status := &TaskStatus{
+			Name:     t.Name,
+			Schedule: t.Schedule,
+			Disabled: t.Disabled,
+		}
+		if !t.Disabled {
+			t.mu.Lock()
+			status.NextRunAt = t.nextRun
+			t.mu.Unlock()
+		}
+		if lease, ok := leases[t.Name]; ok {
+			status.Running = lease.LockedUntil.After(now)
+			status.Owner = lease.Owner
+			status.LastRun = lease.LastRun
+		}
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses, nil
+}
+
+// loop waits for the next slot of t and runs it, until ctx is canceled
+func (s *Scheduler) loop(ctx context.Context, t *task) {
+	defer s.wg.Done()
+
+	slot := time.Now()
+	for {
+		slot = t.schedule.Next(slot)
+		if slot.IsZero() {
+			s.log.Warnf("scheduler: task %s has no next run", t.Name)
+			return
+		}
+		t.mu.Lock()
+		t.nextRun = slot
+		t.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(slot))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runSlot(ctx, t, slot)
+
+		// Bỏ qua các slot đã trôi qua trong lúc chạy
+		if now := time.Now(); now.After(slot) {
+			slot = now
+		}
+	}
+}
+
+// runSlot runs t for slot if this replica gets the lease
+func (s *Scheduler) runSlot(ctx context.Context, t *task, slot time.Time) {
+	acquired, err := s.store.Acquire(ctx, t.Name, s.owner, slot, t.LeaseTTL)
+	if err != nil {
+		s.log.Errorf("scheduler: failed to acquire lease of %s: %v", t.Name, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	run := &Run{Owner: s.owner, Slot: slot, StartedAt: time.Now()}
+	runCtx, cancel := context.WithTimeout(ctx, t.LeaseTTL)
+	processed, err := s.safeRun(runCtx, t, slot)
+	cancel()
+	run.FinishedAt = time.Now()
+	run.Processed = processed
+	if err != nil {
+		run.Error = err.Error()
+		s.log.Errorf("scheduler: task %s failed after %s: %v", t.Name, run.FinishedAt.Sub(run.StartedAt), err)
+	} else {
+		s.log.Infof("scheduler: task %s processed %d item(s) in %s", t.Name, processed, run.FinishedAt.Sub(run.StartedAt))
+	}
+
+	// Ghi kết quả cả khi app đang dừng
+	releaseCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.store.Release(releaseCtx, t.Name, s.owner, run); err != nil {
+		s.log.Errorf("scheduler: failed to release lease of %s: %v", t.Name, err)
+	}
+}
+
+// safeRun runs t and turns a panic into an error
+func (s *Scheduler) safeRun(ctx context.Context, t *task, slot time.Time) (processed int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return t.Run(ctx, slot)
+}
@@ -0,0 +1,49 @@
+// Package textnorm normalizes text for diacritic-insensitive search:
+// bỏ dấu tiếng Việt (kể cả đ/Đ), gộp khoảng trắng và chuyển về chữ thường.
+// "  Hà   Nội " và "ha noi" có cùng dạng chuẩn "ha noi".
+package textnorm
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize returns the normalized form of s
+func Normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for _, r := range norm.NFD.String(s) {
+		if unicode.Is(unicode.Mn, r) {
+			continue // dấu thanh và dấu mũ sau khi tách NFD
+		}
+		if unicode.IsSpace(r) {
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(FoldRune(r))
+	}
+	return b.String()
+}
+
+// FoldRune returns the normalized form of a single precomposed rune.
+// Không đổi số ký tự nên vị trí trong chuỗi gốc (dạng NFC) được giữ nguyên.
+func FoldRune(r rune) rune {
+	switch r {
+	case 'đ', 'Đ':
+		return 'd'
+	}
+	if r > unicode.MaxASCII {
+		for _, d := range norm.NFD.String(string(r)) {
+			r = d // ký tự gốc đứng đầu dạng NFD
+			break
+		}
+	}
+	return unicode.ToLower(r)
+}
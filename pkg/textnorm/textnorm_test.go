@@ -0,0 +1,62 @@
+package textnorm
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"Hà Nội", "ha noi"},
+		{"  Hà   Nội ", "ha noi"},
+		{"Hà\tNội\n", "ha noi"},
+		{"TP. Hồ Chí Minh", "tp. ho chi minh"},
+		{"Đà Nẵng", "da nang"},
+		{"đường", "duong"},
+		{"Lập trình viên Backend", "lap trinh vien backend"},
+		{"Nghệ An", "nghe an"},
+		{"Thừa Thiên Huế", "thua thien hue"},
+		{"Golang/C++", "golang/c++"},
+		// Dạng NFD (dấu tách rời) cho cùng kết quả với NFC
+		{"Ha\u0300 No\u0302\u0323i", "ha noi"},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeIdempotent(t *testing.T) {
+	for _, s := range []string{"Hà Nội", "  Đà   Nẵng ", "Kỹ sư phần mềm"} {
+		once := Normalize(s)
+		if twice := Normalize(once); twice != once {
+			t.Errorf("Normalize(Normalize(%q)) = %q, want %q", s, twice, once)
+		}
+	}
+}
+
+func TestFoldRune(t *testing.T) {
+	tests := []struct {
+		in   rune
+		want rune
+	}{
+		{'a', 'a'},
+		{'A', 'a'},
+		{'đ', 'd'},
+		{'Đ', 'd'},
+		{'ệ', 'e'},
+		{'Ấ', 'a'},
+		{'ư', 'u'},
+		{'Ơ', 'o'},
+		{'ỹ', 'y'},
+		{'1', '1'},
+		{'-', '-'},
+	}
+	for _, tt := range tests {
+		if got := FoldRune(tt.in); got != tt.want {
+			t.Errorf("FoldRune(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// rfcSecret là key SHA-1 "12345678901234567890" của RFC 6238 Appendix B
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeRFC6238(t *testing.T) {
+	// Giá trị mong đợi là 8 chữ số của RFC, Code trả về Digits chữ số cuối
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("Code(T=%d) error = %v", tt.unix, err)
+		}
+		if want := tt.want[len(tt.want)-Digits:]; got != want {
+			t.Errorf("Code(T=%d) = %s, want %s", tt.unix, got, want)
+		}
+	}
+}
+
+func TestValidateWindow(t *testing.T) {
+	// Code của step chứa T=1111111109, step này kết thúc ở giây 1111111109
+	issued := time.Unix(1111111109, 0)
+	step := Step(issued)
+	code, err := Code(rfcSecret, issued)
+	if err != nil {
+		t.Fatalf("Code() error = %v", err)
+	}
+	stepStart := func(s int64) time.Time { return time.Unix(s*Period, 0) }
+
+	tests := []struct {
+		name string
+		at   time.Time
+		ok   bool
+	}{
+		{"same step", issued, true},
+		{"first second of next step", stepStart(step + 1), true},
+		{"last second of next step", stepStart(step + 2).Add(-time.Second), true},
+		{"first second two steps later", stepStart(step + 2), false},
+		{"first second of previous step", stepStart(step - 1), true},
+		{"last second two steps earlier", stepStart(step - 1).Add(-time.Second), false},
+	}
+
+	for _, tt := range tests {
+		got, ok := Validate(rfcSecret, code, tt.at, 1)
+		if ok != tt.ok {
+			t.Errorf("%s: Validate() ok = %v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if ok && got != step {
+			t.Errorf("%s: Validate() step = %d, want %d", tt.name, got, step)
+		}
+	}
+
+	if _, ok := Validate(rfcSecret, code, stepStart(step+1), 0); ok {
+		t.Error("Validate() with skew 0 accepted the previous step")
+	}
+}
+
+func TestValidateRejectsMalformedInput(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, err := Code(rfcSecret, now)
+	if err != nil {
+		t.Fatalf("Code() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+	}{
+		{"short code", rfcSecret, code[1:]},
+		{"long code", rfcSecret, code + "0"},
+		{"invalid secret", "not base32!", code},
+		{"empty secret", "", code},
+	}
+	for _, tt := range tests {
+		if _, ok := Validate(tt.secret, tt.code, now, 1); ok {
+			t.Errorf("%s: Validate() ok = true, want false", tt.name)
+		}
+	}
+
+	if _, ok := Validate(rfcSecret, " "+code+" ", now, 0); !ok {
+		t.Error("Validate() rejected a code with surrounding spaces")
+	}
+}
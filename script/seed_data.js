@@ -1,5 +1,6 @@
 // MongoDB seed script for companies and job postings
 // Run with: mongosh <database_name> seed_data.js
+// Then fill the normalized search fields: go run ./cmd/backfill-normalized -conf ./configs
 use("jobly");
 
 // Clear existing data (optional - remove if you want to keep existing data)